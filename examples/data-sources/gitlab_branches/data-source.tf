@@ -0,0 +1,10 @@
+# All branches of a project
+data "gitlab_branches" "all" {
+  project = "foo/bar"
+}
+
+# Only branches beginning with `feature/`
+data "gitlab_branches" "features" {
+  project = "foo/bar"
+  search  = "^feature/"
+}
@@ -0,0 +1,3 @@
+data "gitlab_deploy_keys" "example" {
+  project = "foo/bar"
+}
@@ -1 +1,6 @@
 data "gitlab_instance_variables" "vars" {}
+
+# Using a key filter
+data "gitlab_instance_variables" "registry_vars" {
+  key_regex = "^REGISTRY_"
+}
@@ -0,0 +1,4 @@
+data "gitlab_pipeline_trigger" "example" {
+  project             = "foo/bar"
+  pipeline_trigger_id = 1
+}
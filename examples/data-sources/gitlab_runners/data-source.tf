@@ -0,0 +1,12 @@
+# All online runners of a project having the `docker` tag
+data "gitlab_runners" "docker" {
+  project  = "foo/bar/baz"
+  status   = "online"
+  tag_list = ["docker"]
+}
+
+# All paused instance runners, requires administrator privileges
+data "gitlab_runners" "paused" {
+  type   = "instance_type"
+  paused = true
+}
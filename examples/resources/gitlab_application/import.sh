@@ -0,0 +1,6 @@
+# A GitLab Application can be imported using its ID, e.g.
+terraform import gitlab_application.oidc 1
+
+# NOTE: the `secret` and `scopes` resource attributes are not available for imported resources as this information cannot be read from the GitLab API.
+#       Therefore, the `scopes` of an imported application are not compared to the configured `scopes`
+#       and an imported application is not replaced if its `scopes` are changed.
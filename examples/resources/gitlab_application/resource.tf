@@ -0,0 +1,6 @@
+resource "gitlab_application" "oidc" {
+  name         = "company_oidc"
+  redirect_uri = "https://mycompany.com/oauth/callback"
+  scopes       = ["openid", "profile", "email"]
+  confidential = true
+}
@@ -0,0 +1,22 @@
+resource "gitlab_project" "example" {
+  name                   = "example"
+  initialize_with_readme = true
+}
+
+resource "gitlab_commit" "example" {
+  project        = gitlab_project.example.id
+  branch         = "main"
+  commit_message = "Add configuration"
+
+  action {
+    action    = "create"
+    file_path = "config/app.yml"
+    content   = "name: example"
+  }
+
+  action {
+    action        = "move"
+    previous_path = "README.md"
+    file_path     = "docs/README.md"
+  }
+}
@@ -0,0 +1,2 @@
+# GitLab compliance frameworks can be imported using an id made up of `group:framework_id`, e.g.
+terraform import gitlab_compliance_framework.hipaa compliance:1
@@ -0,0 +1,12 @@
+resource "gitlab_compliance_framework" "hipaa" {
+  namespace_id                     = "compliance"
+  name                             = "HIPAA"
+  description                      = "Health Insurance Portability and Accountability Act"
+  color                            = "#87BEEF"
+  pipeline_configuration_full_path = ".hipaa.yml@compliance/pipelines"
+}
+
+resource "gitlab_project_compliance_framework" "hipaa" {
+  project                 = "compliance/patients"
+  compliance_framework_id = gitlab_compliance_framework.hipaa.framework_id
+}
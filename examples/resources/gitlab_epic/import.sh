@@ -0,0 +1,2 @@
+# GitLab epics can be imported with a key composed of `<group>:<epic_id>`, where `epic_id` is the instance-wide ID of the epic, e.g.
+terraform import gitlab_epic.example "12345:42"
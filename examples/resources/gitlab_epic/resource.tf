@@ -0,0 +1,13 @@
+resource "gitlab_group" "example" {
+  name = "example"
+  path = "example"
+}
+
+resource "gitlab_epic" "example" {
+  group       = gitlab_group.example.id
+  title       = "Example epic"
+  description = "An example epic"
+  labels      = ["example"]
+  start_date  = "2022-04-01"
+  due_date    = "2022-04-30"
+}
@@ -0,0 +1,2 @@
+# GitLab epic issues can be imported with a key composed of `<group>:<epic_iid>:<issue_id>`, e.g.
+terraform import gitlab_epic_issue.example "12345:1:42"
@@ -0,0 +1,15 @@
+resource "gitlab_epic" "example" {
+  group = "12345"
+  title = "Example epic"
+}
+
+resource "gitlab_project_issue" "example" {
+  project = "67890"
+  title   = "Example issue"
+}
+
+resource "gitlab_epic_issue" "example" {
+  group    = gitlab_epic.example.group
+  epic_iid = gitlab_epic.example.iid
+  issue_id = gitlab_project_issue.example.issue_id
+}
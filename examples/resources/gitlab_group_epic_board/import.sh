@@ -0,0 +1,2 @@
+# GitLab group epic boards can be imported using an id made up of `<group-id>:<epic-board-id>`, e.g.
+terraform import gitlab_group_epic_board.this 12345:42
@@ -0,0 +1,13 @@
+resource "gitlab_group_epic_board" "this" {
+  group = "example"
+  name  = "Priorities"
+
+  # The IDs of the group labels of the lists, in the order of the lists on the board
+  lists {
+    label_id = 11
+  }
+
+  lists {
+    label_id = 12
+  }
+}
@@ -1,2 +1,2 @@
-# GitLab group ldap links can be imported using an id made up of `group_id:ldap_provider:cn`, e.g.
+# GitLab group ldap links can be imported using an id made up of `group_id:ldap_provider:cn` or `group_id:ldap_provider:filter`, e.g.
 terraform import gitlab_group_ldap_link.test "12345:ldapmain:testuser"
@@ -4,3 +4,10 @@ resource "gitlab_group_ldap_link" "test" {
   group_access  = "developer"
   ldap_provider = "ldapmain"
 }
+
+resource "gitlab_group_ldap_link" "engineering" {
+  group_id      = "12345"
+  filter        = "(department=engineering)"
+  group_access  = "developer"
+  ldap_provider = "ldapmain"
+}
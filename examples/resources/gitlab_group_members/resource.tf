@@ -0,0 +1,14 @@
+resource "gitlab_group_members" "example" {
+  group_id = "12345"
+
+  member {
+    user_id      = 1
+    access_level = "owner"
+  }
+
+  member {
+    user_id      = 1337
+    access_level = "developer"
+    expires_at   = "2030-12-31"
+  }
+}
@@ -0,0 +1,2 @@
+# Gitlab group milestone can be imported with a key composed of `<group>:<milestone_id>`, e.g.
+terraform import gitlab_group_milestone.example "12345:11"
@@ -0,0 +1,12 @@
+resource "gitlab_group" "example" {
+  name = "example"
+  path = "example"
+}
+
+resource "gitlab_group_milestone" "example" {
+  group       = gitlab_group.example.id
+  title       = "v1.0"
+  description = "First stable release"
+  start_date  = "2022-04-01"
+  due_date    = "2022-04-30"
+}
@@ -0,0 +1,4 @@
+# You can import the push rules of a group using `terraform import <resource> <group_id>`.
+#
+# For example:
+terraform import gitlab_group_push_rules.foo 1234
@@ -0,0 +1,11 @@
+resource "gitlab_group" "foo" {
+  name = "example"
+  path = "example"
+}
+
+resource "gitlab_group_push_rules" "foo" {
+  group                = gitlab_group.foo.id
+  commit_message_regex = "^(feat|fix|docs|chore): "
+  prevent_secrets      = true
+  deny_delete_tag      = true
+}
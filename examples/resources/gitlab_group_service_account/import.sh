@@ -0,0 +1,2 @@
+# GitLab group service accounts can be imported using an id made up of `group:user_id`, e.g.
+terraform import gitlab_group_service_account.deploy "12345:42"
@@ -0,0 +1,10 @@
+resource "gitlab_group" "example" {
+  name = "example"
+  path = "example"
+}
+
+resource "gitlab_group_service_account" "deploy" {
+  group    = gitlab_group.example.id
+  name     = "Deployment bot"
+  username = "example-deploy-bot"
+}
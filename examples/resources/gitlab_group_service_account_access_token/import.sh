@@ -0,0 +1,3 @@
+# GitLab group service account access tokens can be imported using an id made up of `group:user_id:token_id`, e.g.
+# The `token` attribute is not available for imported resources.
+terraform import gitlab_group_service_account_access_token.deploy "12345:42:1337"
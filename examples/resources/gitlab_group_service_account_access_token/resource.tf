@@ -0,0 +1,7 @@
+resource "gitlab_group_service_account_access_token" "deploy" {
+  group      = gitlab_group_service_account.deploy.group
+  user_id    = gitlab_group_service_account.deploy.user_id
+  name       = "deploy"
+  scopes     = ["read_api", "read_registry"]
+  expires_at = "2030-01-01"
+}
@@ -1,2 +1,3 @@
+# Instance variables cannot be scoped to an environment, so they are identified by their key only.
 # GitLab instance variables can be imported using an id made up of `variablename`, e.g.
 terraform import gitlab_instance_variable.example instance_variable_key
@@ -0,0 +1,2 @@
+# GitLab project compliance frameworks can be imported using the project ID or full path, e.g.
+terraform import gitlab_project_compliance_framework.sox 12345
@@ -0,0 +1,13 @@
+resource "gitlab_project_compliance_framework" "sox" {
+  project                 = "12345"
+  compliance_framework_id = "gid://gitlab/ComplianceManagement::Framework/1"
+}
+
+# Assign multiple compliance frameworks, requires GitLab 17.3
+resource "gitlab_project_compliance_framework" "multiple" {
+  project = "foo/bar"
+  compliance_framework_ids = [
+    "gid://gitlab/ComplianceManagement::Framework/1",
+    "gid://gitlab/ComplianceManagement::Framework/2",
+  ]
+}
@@ -0,0 +1,2 @@
+# GitLab project forks can be imported using the ID of the forked project, e.g.
+terraform import gitlab_project_fork.example 42
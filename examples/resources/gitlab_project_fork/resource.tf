@@ -0,0 +1,8 @@
+resource "gitlab_project_fork" "example" {
+  project   = "example/upstream"
+  namespace = "my-group"
+  name      = "upstream-fork"
+  path      = "upstream-fork"
+
+  unlink_on_destroy = true
+}
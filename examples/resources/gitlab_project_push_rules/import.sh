@@ -0,0 +1,4 @@
+# You can import the push rules of a project using `terraform import <resource> <project_id>`.
+#
+# For example:
+terraform import gitlab_project_push_rules.foo 1234
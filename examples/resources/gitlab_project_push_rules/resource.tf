@@ -0,0 +1,13 @@
+resource "gitlab_project" "foo" {
+  name        = "Example"
+  description = "My example project"
+}
+
+resource "gitlab_project_push_rules" "foo" {
+  project              = gitlab_project.foo.id
+  commit_message_regex = "^(feat|fix|docs|chore): "
+  branch_name_regex    = "^(feature|hotfix)/"
+  prevent_secrets      = true
+  member_check         = true
+  max_file_size        = 10
+}
@@ -0,0 +1,21 @@
+# Create a project
+resource "gitlab_project" "example" {
+  name                   = "example"
+  description            = "An example project"
+  initialize_with_readme = true
+}
+
+# Create a release and its tag from the default branch
+resource "gitlab_release" "example" {
+  project     = gitlab_project.example.id
+  tag_name    = "v1.0.0"
+  ref         = gitlab_project.example.default_branch
+  name        = "Version 1.0.0"
+  description = "The first release."
+
+  assets {
+    name      = "binary"
+    url       = "https://example.com/v1.0.0/binary"
+    link_type = "package"
+  }
+}
@@ -28,3 +28,12 @@ resource "gitlab_repository_file" "readme" {
   author_name    = "Terraform"
   commit_message = "feature: add readme file"
 }
+
+resource "gitlab_repository_file" "logo" {
+  project        = gitlab_project.this.id
+  file_path      = "logo.png"
+  branch         = "main"
+  encoding       = "base64"
+  content        = filebase64("${path.module}/logo.png")
+  commit_message = "feature: add logo"
+}
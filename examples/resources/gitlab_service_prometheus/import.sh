@@ -0,0 +1,2 @@
+# You can import a gitlab_service_prometheus state using the project ID, e.g.
+terraform import gitlab_service_prometheus.prometheus 1
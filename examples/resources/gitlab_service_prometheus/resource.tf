@@ -0,0 +1,11 @@
+resource "gitlab_project" "awesome_project" {
+  name             = "awesome_project"
+  description      = "My awesome project."
+  visibility_level = "public"
+}
+
+resource "gitlab_service_prometheus" "prometheus" {
+  project              = gitlab_project.awesome_project.id
+  api_url              = "https://prometheus.example.com"
+  manual_configuration = true
+}
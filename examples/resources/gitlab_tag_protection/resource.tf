@@ -3,3 +3,14 @@ resource "gitlab_tag_protection" "TagProtect" {
   tag                 = "TagProtected"
   create_access_level = "developer"
 }
+
+# Only allow maintainers and a specific user to create version tags
+resource "gitlab_tag_protection" "versions" {
+  project             = "12345"
+  tag                 = "v*"
+  create_access_level = "maintainer"
+
+  allowed_to_create {
+    user_id = 42
+  }
+}
@@ -0,0 +1,6 @@
+resource "gitlab_user_impersonation_token" "example" {
+  user_id    = 42
+  name       = "Example impersonation token"
+  scopes     = ["api"]
+  expires_at = "2027-01-01"
+}
@@ -0,0 +1,2 @@
+# A GitLab runner can be imported using the runner's ID. The runner token is not available when importing.
+terraform import gitlab_user_runner.example 1
@@ -0,0 +1,31 @@
+resource "gitlab_project" "awesome_project" {
+  name = "awesome_project"
+}
+
+# Create a project runner and register it with `gitlab-runner register --token`
+resource "gitlab_user_runner" "project_runner" {
+  runner_type = "project_type"
+  project_id  = gitlab_project.awesome_project.id
+
+  description  = "My project runner"
+  tag_list     = ["docker", "linux"]
+  run_untagged = false
+}
+
+resource "gitlab_group" "awesome_group" {
+  name = "awesome_group"
+  path = "awesome_group"
+}
+
+# Create a group runner
+resource "gitlab_user_runner" "group_runner" {
+  runner_type = "group_type"
+  group_id    = gitlab_group.awesome_group.id
+  locked      = true
+}
+
+# Create an instance runner, which requires administrator privileges
+resource "gitlab_user_runner" "instance_runner" {
+  runner_type  = "instance_type"
+  access_level = "ref_protected"
+}
@@ -1,2 +1,5 @@
 # You can import a user ssh key using an id made up of `{user-id}:{key}`, e.g.
 terraform import gitlab_user_sshkey.example 42:1
+
+# Alternatively, you can import a ssh key for the current user using an id made up of `{key}`, e.g.
+terraform import gitlab_user_sshkey.example_user 1
@@ -8,3 +8,9 @@ resource "gitlab_user_sshkey" "example" {
   key        = "ssh-rsa AAAA..."
   expires_at = "2016-01-21T00:00:00.000Z"
 }
+
+# Manages a SSH key for the current user
+resource "gitlab_user_sshkey" "example_user" {
+  title = "example-key"
+  key   = "ssh-rsa AAAA..."
+}
@@ -65,6 +65,10 @@ var validProjectEnvironmentStates = []string{
 	"available", "stopped",
 }
 
+var validProjectEnvironmentTiers = []string{
+	"production", "staging", "testing", "development", "other",
+}
+
 var accessLevelNameToValue = map[string]gitlab.AccessLevelValue{
 	"no one":     gitlab.NoPermissions,
 	"minimal":    gitlab.MinimalAccessPermissions,
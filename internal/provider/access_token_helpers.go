@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/xanzy/go-gitlab"
+)
+
+// resourceDataGetOk is implemented by both `*schema.ResourceData` and `*schema.ResourceDiff`.
+type resourceDataGetOk interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// accessTokenRotationConfiguration returns the `expiration_days` and `rotate_before_days` of the
+// `rotation_configuration` block. The last return value is false if the block is not set.
+func accessTokenRotationConfiguration(d resourceDataGetOk) (int, int, bool) {
+	v, ok := d.GetOk("rotation_configuration")
+	if !ok || len(v.([]interface{})) != 1 || v.([]interface{})[0] == nil {
+		return 0, 0, false
+	}
+
+	rotationConfiguration := v.([]interface{})[0].(map[string]interface{})
+	return rotationConfiguration["expiration_days"].(int), rotationConfiguration["rotate_before_days"].(int), true
+}
+
+// accessTokenValidateRotationConfiguration returns an error if a token with the given rotation configuration
+// would be within its rotation window right after it has been created or rotated, thus rotated on every apply.
+func accessTokenValidateRotationConfiguration(expirationDays int, rotateBeforeDays int) error {
+	if rotateBeforeDays >= expirationDays {
+		return fmt.Errorf("rotate_before_days (%d) must be less than expiration_days (%d) of the rotation_configuration, otherwise the token is rotated on every apply", rotateBeforeDays, expirationDays)
+	}
+	return nil
+}
+
+// accessTokenRotationExpiresAt returns the expiration date of a token which is created or rotated at the given time.
+func accessTokenRotationExpiresAt(now time.Time, expirationDays int) gitlab.ISOTime {
+	year, month, day := now.UTC().Date()
+	return gitlab.ISOTime(time.Date(year, month, day+expirationDays, 0, 0, 0, 0, time.UTC))
+}
+
+// accessTokenNeedsRotation returns true if a token with the given expiration date is within the rotation window
+// at the given time. Tokens without an expiration date are never rotated.
+func accessTokenNeedsRotation(expiresAt string, rotateBeforeDays int, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+
+	expiresAtDate, err := time.Parse(iso8601, expiresAt)
+	if err != nil {
+		return false
+	}
+
+	return !now.UTC().Before(expiresAtDate.AddDate(0, 0, -rotateBeforeDays))
+}
+
+// accessTokenValidateExpiresAt returns an error if a token with the given expiration date can't be created at the
+// given time, because the date isn't in the future or exceeds the maximum token lifetime in days. A maximum
+// lifetime of 0 means that the lifetime isn't limited.
+func accessTokenValidateExpiresAt(expiresAt string, maxLifetimeDays int, now time.Time) error {
+	expiresAtDate, err := time.Parse(iso8601, expiresAt)
+	if err != nil {
+		return fmt.Errorf("invalid expires_at date %q: %v", expiresAt, err)
+	}
+
+	year, month, day := now.UTC().Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if !expiresAtDate.After(today) {
+		return fmt.Errorf("expires_at %s must be in the future", expiresAt)
+	}
+
+	if maxLifetimeDays > 0 && expiresAtDate.After(today.AddDate(0, 0, maxLifetimeDays)) {
+		return fmt.Errorf("expires_at %s exceeds the maximum access token lifetime of %d days enforced by the GitLab instance, it must not be later than %s", expiresAt, maxLifetimeDays, today.AddDate(0, 0, maxLifetimeDays).Format(iso8601))
+	}
+
+	return nil
+}
+
+// accessTokenMaxLifetimeDays returns the maximum access token lifetime in days enforced by the GitLab instance,
+// or 0 if it isn't limited or can't be read. Only administrators can read the application settings, for all
+// other users the maximum lifetime is enforced by the API once the token is created.
+func accessTokenMaxLifetimeDays(ctx context.Context, client *gitlab.Client) int {
+	isAdmin, err := isCurrentUserAdmin(ctx, client)
+	if err != nil {
+		log.Printf("[DEBUG] failed to read the current user, skipping the validation of the maximum access token lifetime: %v", err)
+		return 0
+	}
+	if !isAdmin {
+		return 0
+	}
+
+	settings, _, err := client.Settings.GetSettings(gitlab.WithContext(ctx))
+	if err != nil {
+		log.Printf("[DEBUG] failed to read the maximum access token lifetime, skipping its validation: %v", err)
+		return 0
+	}
+	return settings.MaxPersonalAccessTokenLifetime
+}
+
+// accessTokenCreateDiagnostics converts an error of creating an access token into diagnostics,
+// with a hint if the expiration date has likely been rejected by the instance.
+func accessTokenCreateDiagnostics(err error) diag.Diagnostics {
+	if !strings.Contains(err.Error(), "expires_at") {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "failed to create access token with the given expires_at",
+		Detail:   fmt.Sprintf("%v\n\nThe GitLab instance may enforce a maximum access token lifetime which is shorter than the requested expiry. Choose an earlier expires_at date or ask an administrator for the maximum lifetime.", err),
+	}}
+}
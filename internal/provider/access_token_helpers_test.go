@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGitlab_accessTokenNeedsRotation(t *testing.T) {
+	now := time.Date(2023, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		ExpiresAt        string
+		RotateBeforeDays int
+		NeedsRotation    bool
+	}{
+		{
+			ExpiresAt:        "",
+			RotateBeforeDays: 7,
+			NeedsRotation:    false,
+		},
+		{
+			ExpiresAt:        "2023-03-20",
+			RotateBeforeDays: 7,
+			NeedsRotation:    false,
+		},
+		{
+			ExpiresAt:        "2023-03-17",
+			RotateBeforeDays: 7,
+			NeedsRotation:    true,
+		},
+		{
+			ExpiresAt:        "2023-03-11",
+			RotateBeforeDays: 1,
+			NeedsRotation:    true,
+		},
+		{
+			ExpiresAt:        "2023-03-01",
+			RotateBeforeDays: 1,
+			NeedsRotation:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		if needsRotation := accessTokenNeedsRotation(tc.ExpiresAt, tc.RotateBeforeDays, now); needsRotation != tc.NeedsRotation {
+			t.Fatalf("got %t expected %t for expires_at %q and rotate_before_days %d", needsRotation, tc.NeedsRotation, tc.ExpiresAt, tc.RotateBeforeDays)
+		}
+	}
+}
+
+func TestGitlab_accessTokenRotationExpiresAt(t *testing.T) {
+	now := time.Date(2023, time.March, 30, 23, 30, 0, 0, time.FixedZone("UTC-2", -2*60*60))
+
+	if expiresAt := accessTokenRotationExpiresAt(now, 7).String(); expiresAt != "2023-04-07" {
+		t.Fatalf("got %q expected %q", expiresAt, "2023-04-07")
+	}
+}
+
+func TestGitlab_accessTokenValidateExpiresAt(t *testing.T) {
+	now := time.Date(2023, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		ExpiresAt       string
+		MaxLifetimeDays int
+		ExpectError     bool
+	}{
+		{
+			ExpiresAt:   "2023-03-09",
+			ExpectError: true,
+		},
+		{
+			ExpiresAt:   "2023-03-10",
+			ExpectError: true,
+		},
+		{
+			ExpiresAt:   "2023-03-11",
+			ExpectError: false,
+		},
+		{
+			ExpiresAt:   "2099-01-01",
+			ExpectError: false,
+		},
+		{
+			ExpiresAt:       "2023-04-09",
+			MaxLifetimeDays: 30,
+			ExpectError:     false,
+		},
+		{
+			ExpiresAt:       "2023-04-10",
+			MaxLifetimeDays: 30,
+			ExpectError:     true,
+		},
+		{
+			ExpiresAt:   "not-a-date",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := accessTokenValidateExpiresAt(tc.ExpiresAt, tc.MaxLifetimeDays, now)
+		if (err != nil) != tc.ExpectError {
+			t.Fatalf("got error %v for %q with maximum lifetime %d, expected error: %t", err, tc.ExpiresAt, tc.MaxLifetimeDays, tc.ExpectError)
+		}
+	}
+}
+
+func TestGitlab_accessTokenMaxLifetimeDays(t *testing.T) {
+	cases := []struct {
+		Name                    string
+		IsAdmin                 bool
+		ExpectedMaxLifetimeDays int
+	}{
+		{
+			Name:                    "admin",
+			IsAdmin:                 true,
+			ExpectedMaxLifetimeDays: 30,
+		},
+		{
+			Name:                    "non-admin",
+			IsAdmin:                 false,
+			ExpectedMaxLifetimeDays: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			settingsRequested := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+				if tc.IsAdmin {
+					w.Write([]byte(`{"id": 1, "is_admin": true}`))
+				} else {
+					w.Write([]byte(`{"id": 1}`))
+				}
+			})
+			mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+				settingsRequested = true
+				if !tc.IsAdmin {
+					w.WriteHeader(http.StatusForbidden)
+					w.Write([]byte(`{"message": "403 Forbidden"}`))
+					return
+				}
+				w.Write([]byte(`{"max_personal_access_token_lifetime": 30}`))
+			})
+			client := newTestGitlabClient(t, mux)
+
+			if maxLifetimeDays := accessTokenMaxLifetimeDays(context.Background(), client); maxLifetimeDays != tc.ExpectedMaxLifetimeDays {
+				t.Fatalf("got maximum lifetime %d, expected %d", maxLifetimeDays, tc.ExpectedMaxLifetimeDays)
+			}
+			if settingsRequested != tc.IsAdmin {
+				t.Fatalf("application settings requested: %t, expected: %t", settingsRequested, tc.IsAdmin)
+			}
+		})
+	}
+}
+
+func TestGitlab_accessTokenValidateRotationConfiguration(t *testing.T) {
+	cases := []struct {
+		ExpirationDays   int
+		RotateBeforeDays int
+		ExpectError      bool
+	}{
+		{
+			ExpirationDays:   10,
+			RotateBeforeDays: 2,
+			ExpectError:      false,
+		},
+		{
+			ExpirationDays:   10,
+			RotateBeforeDays: 9,
+			ExpectError:      false,
+		},
+		{
+			ExpirationDays:   10,
+			RotateBeforeDays: 10,
+			ExpectError:      true,
+		},
+		{
+			ExpirationDays:   10,
+			RotateBeforeDays: 15,
+			ExpectError:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := accessTokenValidateRotationConfiguration(tc.ExpirationDays, tc.RotateBeforeDays)
+		if (err != nil) != tc.ExpectError {
+			t.Fatalf("got error %v for expiration_days %d and rotate_before_days %d, expected error: %t", err, tc.ExpirationDays, tc.RotateBeforeDays, tc.ExpectError)
+		}
+	}
+}
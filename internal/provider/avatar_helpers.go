@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// avatarSchema returns the `avatar`, `avatar_hash` and `avatar_url` attributes shared by the resources with an avatar.
+// It's meant to be used together with `avatarHashCustomizeDiff`.
+func avatarSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"avatar": {
+			Description: "A local path to the avatar image to upload. **Note**: not available for imported resources.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"avatar_hash": {
+			Description:  "The SHA-256 hash of the avatar image, e.g. `filesha256(\"path/to/avatar.png\")`. It's computed from the `avatar` image if it's not given. **Note**: this is used to trigger an update of the avatar.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			RequiredWith: []string{"avatar"},
+		},
+		"avatar_url": {
+			Description: "The URL of the avatar image.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+// avatarHashCustomizeDiff sets the `avatar_hash` to the SHA-256 hash of the `avatar` image file,
+// so that the avatar is only uploaded again once the image changes. A configured `avatar_hash`,
+// e.g. from `filesha256()`, is kept as is.
+func avatarHashCustomizeDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	if config := rd.GetRawConfig(); config.IsKnown() && !config.IsNull() && !config.GetAttr("avatar_hash").IsNull() {
+		return nil
+	}
+
+	if !rd.NewValueKnown("avatar") {
+		return rd.SetNewComputed("avatar_hash")
+	}
+
+	avatarPath := rd.Get("avatar").(string)
+	if avatarPath == "" {
+		if rd.Get("avatar_hash").(string) != "" {
+			return rd.SetNew("avatar_hash", "")
+		}
+		return nil
+	}
+
+	hash, err := avatarHash(avatarPath)
+	if err != nil {
+		return err
+	}
+	return rd.SetNew("avatar_hash", hash)
+}
+
+// avatarHash returns the hex encoded SHA-256 hash of the avatar image file, like `filesha256()`.
+func avatarHash(avatarPath string) (string, error) {
+	avatarFile, err := openAvatar(avatarPath)
+	if err != nil {
+		return "", err
+	}
+	defer avatarFile.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, avatarFile); err != nil {
+		return "", fmt.Errorf("Unable to read avatar file %s: %s", avatarPath, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// openAvatar opens the avatar image file to upload it.
+// The caller has to close the file once the request has been sent.
+func openAvatar(avatarPath string) (*os.File, error) {
+	avatarFile, err := os.Open(avatarPath)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open avatar file %s: %s", avatarPath, err)
+	}
+	return avatarFile, nil
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAvatarHash = "8d29d9c393facb9d86314eb347a03fde503f2c0422bf55af7df086deb126107e"
+
+func TestGitlab_avatarHash(t *testing.T) {
+	hash, err := avatarHash("testdata/avatar.png")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if hash != testAvatarHash {
+		t.Fatalf("got hash %q, expected %q", hash, testAvatarHash)
+	}
+
+	if _, err := avatarHash("testdata/does-not-exist.png"); err == nil {
+		t.Fatal("expected an error for a missing avatar file")
+	}
+}
+
+func TestGitlab_avatarHashCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		Name             string
+		StateAvatar      string
+		StateAvatarHash  string
+		ConfigAvatar     cty.Value
+		ConfigAvatarHash cty.Value
+		ExpectedDiff     bool
+		ExpectedHash     string
+	}{
+		{
+			Name:             "unchanged avatar without configured hash",
+			StateAvatar:      "testdata/avatar.png",
+			StateAvatarHash:  testAvatarHash,
+			ConfigAvatar:     cty.StringVal("testdata/avatar.png"),
+			ConfigAvatarHash: cty.NullVal(cty.String),
+			ExpectedDiff:     false,
+		},
+		{
+			Name:             "changed avatar image without configured hash",
+			StateAvatar:      "testdata/avatar.png",
+			StateAvatarHash:  "outdated",
+			ConfigAvatar:     cty.StringVal("testdata/avatar.png"),
+			ConfigAvatarHash: cty.NullVal(cty.String),
+			ExpectedDiff:     true,
+			ExpectedHash:     testAvatarHash,
+		},
+		{
+			Name:             "configured hash",
+			StateAvatar:      "testdata/avatar.png",
+			StateAvatarHash:  testAvatarHash,
+			ConfigAvatar:     cty.StringVal("testdata/avatar.png"),
+			ConfigAvatarHash: cty.StringVal("configured"),
+			ExpectedDiff:     true,
+			ExpectedHash:     "configured",
+		},
+		{
+			Name:             "removed avatar",
+			StateAvatar:      "testdata/avatar.png",
+			StateAvatarHash:  testAvatarHash,
+			ConfigAvatar:     cty.NullVal(cty.String),
+			ConfigAvatarHash: cty.NullVal(cty.String),
+			ExpectedDiff:     true,
+			ExpectedHash:     "",
+		},
+	}
+
+	for _, resourceName := range []string{"gitlab_group", "gitlab_project"} {
+		for _, tc := range cases {
+			t.Run(resourceName+"/"+tc.Name, func(t *testing.T) {
+				r := allResources[resourceName]()
+				coreSchema := r.CoreConfigSchema()
+				attributes := map[string]cty.Value{}
+				for name, attribute := range coreSchema.Attributes {
+					attributes[name] = cty.NullVal(attribute.Type)
+				}
+				attributes["name"] = cty.StringVal("foo")
+				attributes["avatar"] = tc.ConfigAvatar
+				attributes["avatar_hash"] = tc.ConfigAvatarHash
+				rawConfig := cty.ObjectVal(attributes)
+
+				state := &terraform.InstanceState{
+					ID: "42",
+					Attributes: map[string]string{
+						"id":          "42",
+						"name":        "foo",
+						"avatar":      tc.StateAvatar,
+						"avatar_hash": tc.StateAvatarHash,
+					},
+					RawConfig: rawConfig,
+				}
+
+				diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(rawConfig, coreSchema), nil)
+				if err != nil {
+					t.Fatalf("failed to diff: %v", err)
+				}
+
+				var avatarHashDiff *terraform.ResourceAttrDiff
+				if diff != nil {
+					avatarHashDiff = diff.Attributes["avatar_hash"]
+				}
+				if (avatarHashDiff != nil) != tc.ExpectedDiff {
+					t.Fatalf("expected avatar_hash diff %t, got %+v", tc.ExpectedDiff, avatarHashDiff)
+				}
+				if avatarHashDiff != nil && avatarHashDiff.New != tc.ExpectedHash {
+					t.Fatalf("got avatar_hash %q, expected %q", avatarHashDiff.New, tc.ExpectedHash)
+				}
+			})
+		}
+	}
+}
@@ -4,9 +4,16 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/xanzy/go-gitlab"
 )
@@ -14,14 +21,55 @@ import (
 // Config is per-provider, specifies where to connect to gitlab
 type Config struct {
 	Token         string
+	OAuthToken    string
+	JobToken      string
 	BaseURL       string
 	Insecure      bool
 	CACertFile    string
-	ClientCert    string
-	ClientKey     string
 	EarlyAuthFail bool
+
+	// CACert, ClientCert and ClientKey contain either PEM encoded data or the path to a file containing it.
+	CACert     string
+	ClientCert string
+	ClientKey  string
+
+	// RetryMaxAttempts is the maximum number of attempts for a single request.
+	// If it is 0, the default retry behavior of the GitLab client is used.
+	RetryMaxAttempts int
+	RetryBaseDelay   time.Duration
+
+	// RequestLimit is the number of remaining requests of the GitLab rate limit at which
+	// requests are delayed until the rate limit resets. If it is 0, requests are never delayed.
+	RequestLimit int
+
+	// Headers are added to each request, unless the GitLab client sets them itself.
+	Headers map[string]string
+
+	// DefaultPerPage is the page size of list requests which don't set one explicitly.
+	// If it is 0, the default page size of the GitLab API is used.
+	DefaultPerPage int
+}
+
+// ProviderSettings are provider level settings which are not used to create the GitLab client,
+// but change the behavior of resources.
+type ProviderSettings struct {
+	// DefaultVariableMasked is the value of the `masked` attribute of variables which do not set it explicitly.
+	DefaultVariableMasked bool
+}
+
+// ProviderMeta is the meta value of a configured provider which is passed to its resources and data sources.
+type ProviderMeta struct {
+	Client   *gitlab.Client
+	Settings ProviderSettings
+
+	// version caches the version of the GitLab instance, see `gitLabVersion`.
+	versionMu sync.Mutex
+	version   string
 }
 
+// retryMaxDelay caps the exponential backoff between two retry attempts.
+const retryMaxDelay = 30 * time.Second
+
 // Client returns a *gitlab.Client to interact with the configured gitlab instance
 func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 	// Configure TLS/SSL
@@ -39,16 +87,41 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 		tlsConfig.RootCAs = caCertPool
 	}
 
+	if c.CACert != "" {
+		caCert, err := readPEMOrFile(c.CACert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("failed to parse CA certificate: no PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = caCertPool
+	}
+
 	// If configured as insecure, turn off SSL verification
 	if c.Insecure {
 		tlsConfig.InsecureSkipVerify = true
 	}
 
 	// add client cert and key to connection
-	if c.ClientCert != "" && c.ClientKey != "" {
-		clientPair, err := tls.LoadX509KeyPair(c.ClientCert, c.ClientKey)
+	if (c.ClientCert == "") != (c.ClientKey == "") {
+		return nil, errors.New("client certificate and client key must be configured together")
+	}
+	if c.ClientCert != "" {
+		clientCert, err := readPEMOrFile(c.ClientCert)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to read client certificate: %w", err)
+		}
+		clientKey, err := readPEMOrFile(c.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client key: %w", err)
+		}
+
+		clientPair, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
 		}
 		tlsConfig.Certificates = []tls.Certificate{clientPair}
 	}
@@ -57,10 +130,19 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 	t.TLSClientConfig = tlsConfig
 	t.MaxIdleConnsPerHost = 100
 
+	var transport http.RoundTripper = t
+	if len(c.Headers) > 0 {
+		transport = newHeaderTransport(transport, c.Headers)
+	}
+	transport = logging.NewTransport("GitLab", transport)
+	if c.RequestLimit > 0 {
+		transport = newRateLimitTransport(transport, c.RequestLimit)
+	}
+
 	opts := []gitlab.ClientOptionFunc{
 		gitlab.WithHTTPClient(
 			&http.Client{
-				Transport: logging.NewTransport("GitLab", t),
+				Transport: transport,
 			},
 		),
 	}
@@ -69,18 +151,64 @@ func (c *Config) Client(ctx context.Context) (*gitlab.Client, error) {
 		opts = append(opts, gitlab.WithBaseURL(c.BaseURL))
 	}
 
-	// The OAuth method is also compatible with project/group/personal access and job tokens because they are all usable as Bearer tokens.
-	// Although the job token API access is very limited.
+	if c.DefaultPerPage > 0 {
+		opts = append(opts, gitlab.WithRequestOptions(withDefaultPerPage(c.DefaultPerPage)))
+	}
+
+	if c.RetryMaxAttempts > 0 {
+		maxDelay := retryMaxDelay
+		if c.RetryBaseDelay > maxDelay {
+			maxDelay = c.RetryBaseDelay
+		}
+		opts = append(opts,
+			gitlab.WithCustomRetryMax(c.RetryMaxAttempts-1),
+			gitlab.WithCustomRetryWaitMinMax(c.RetryBaseDelay, maxDelay),
+			// The default backoff of the retryablehttp package is exponential and honors the `Retry-After` header.
+			gitlab.WithCustomBackoff(retryablehttp.DefaultBackoff),
+		)
+	}
+
+	// The OAuth method is also compatible with project/group/personal access tokens because they are all usable as Bearer tokens.
+	// Job tokens are sent with their own header, their API access is very limited.
 	// see https://docs.gitlab.com/ee/api#authentication
-	client, err := gitlab.NewOAuthClient(c.Token, opts...)
+	var client *gitlab.Client
+	var err error
+	switch {
+	case c.JobToken != "":
+		client, err = gitlab.NewJobClient(c.JobToken, opts...)
+	case c.OAuthToken != "":
+		client, err = gitlab.NewOAuthClient(c.OAuthToken, opts...)
+	case c.Token != "":
+		client, err = gitlab.NewOAuthClient(c.Token, opts...)
+	default:
+		return nil, errors.New("one of `token`, `oauth_token` or `job_token` must be configured")
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// Test the credentials by checking we can get information about the authenticated user.
-	if c.EarlyAuthFail {
-		_, _, err = client.Users.CurrentUser(gitlab.WithContext(ctx))
+	// Job tokens can't access the current user.
+	if c.EarlyAuthFail && c.JobToken != "" {
+		log.Printf("[DEBUG] skipping the early auth check, because it is not supported with a CI job token")
+	}
+	if c.EarlyAuthFail && c.JobToken == "" {
+		if _, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx)); err != nil {
+			if is401(err) {
+				return nil, fmt.Errorf("the GitLab token was rejected, make sure that it is valid, not expired and has the required scopes (set `early_auth_check = false` to skip this check): %w", err)
+			}
+			return nil, fmt.Errorf("failed to verify the GitLab credentials and connectivity (set `early_auth_check = false` to skip this check): %w", err)
+		}
 	}
 
-	return client, err
+	return client, nil
+}
+
+// readPEMOrFile returns the given value if it contains PEM encoded data,
+// otherwise the contents of the file at the given path.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
 }
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfig_retryOnTooManyRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:            "glpat-test",
+		BaseURL:          server.URL,
+		RetryMaxAttempts: 3,
+		RetryBaseDelay:   time.Millisecond,
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	version, _, err := client.Version.GetVersion()
+	if err != nil {
+		t.Fatalf("expected request to succeed after retries, got: %v", err)
+	}
+
+	if version.Version != "15.4.0-ee" {
+		t.Fatalf("got version %q, expected %q", version.Version, "15.4.0-ee")
+	}
+
+	if requests != 3 {
+		t.Fatalf("got %d requests, expected %d", requests, 3)
+	}
+}
+
+func TestConfig_retryGivesUpAfterMaxAttempts(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:            "glpat-test",
+		BaseURL:          server.URL,
+		RetryMaxAttempts: 2,
+		RetryBaseDelay:   time.Millisecond,
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Version.GetVersion(); err == nil {
+		t.Fatal("expected request to fail after exhausting all attempts")
+	}
+
+	if requests != 2 {
+		t.Fatalf("got %d requests, expected %d", requests, 2)
+	}
+}
+
+func TestConfig_authenticationModes(t *testing.T) {
+	cases := []struct {
+		Name           string
+		Config         Config
+		ExpectedHeader string
+		ExpectedValue  string
+	}{
+		{
+			Name:           "token",
+			Config:         Config{Token: "glpat-test"},
+			ExpectedHeader: "Authorization",
+			ExpectedValue:  "Bearer glpat-test",
+		},
+		{
+			Name:           "oauth token",
+			Config:         Config{OAuthToken: "oauth-test"},
+			ExpectedHeader: "Authorization",
+			ExpectedValue:  "Bearer oauth-test",
+		},
+		{
+			Name:           "job token",
+			Config:         Config{JobToken: "job-test", EarlyAuthFail: true},
+			ExpectedHeader: "JOB-TOKEN",
+			ExpectedValue:  "job-test",
+		},
+		{
+			Name:           "job token takes precedence over token",
+			Config:         Config{Token: "glpat-from-env", JobToken: "job-test"},
+			ExpectedHeader: "JOB-TOKEN",
+			ExpectedValue:  "job-test",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var gotHeaders http.Header
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotHeaders = r.Header.Clone()
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+			}))
+			defer server.Close()
+
+			config := tc.Config
+			config.BaseURL = server.URL
+
+			client, err := config.Client(context.Background())
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			if _, _, err := client.Version.GetVersion(); err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+
+			if got := gotHeaders.Get(tc.ExpectedHeader); got != tc.ExpectedValue {
+				t.Fatalf("got header %s %q, expected %q", tc.ExpectedHeader, got, tc.ExpectedValue)
+			}
+			if tc.ExpectedHeader != "Authorization" && gotHeaders.Get("Authorization") != "" {
+				t.Fatalf("expected no Authorization header, got %q", gotHeaders.Get("Authorization"))
+			}
+		})
+	}
+}
+
+func TestConfig_requiresToken(t *testing.T) {
+	config := Config{BaseURL: "https://gitlab.example.com/api/v4/"}
+
+	if _, err := config.Client(context.Background()); err == nil {
+		t.Fatal("expected an error without any token")
+	}
+}
+
+func TestConfig_earlyAuthCheck(t *testing.T) {
+	cases := []struct {
+		Name           string
+		UserStatusCode int
+		ExpectedError  string
+	}{
+		{
+			Name:           "valid token",
+			UserStatusCode: http.StatusOK,
+		},
+		{
+			Name:           "invalid token",
+			UserStatusCode: http.StatusUnauthorized,
+			ExpectedError:  "the GitLab token was rejected",
+		},
+		{
+			Name:           "forbidden",
+			UserStatusCode: http.StatusForbidden,
+			ExpectedError:  "failed to verify the GitLab credentials",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.UserStatusCode)
+				if tc.UserStatusCode == http.StatusOK {
+					_, _ = w.Write([]byte(`{"id": 1, "username": "root"}`))
+				} else {
+					_, _ = fmt.Fprintf(w, `{"message": %q}`, http.StatusText(tc.UserStatusCode))
+				}
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			config := Config{
+				Token:         "glpat-test",
+				BaseURL:       server.URL,
+				EarlyAuthFail: true,
+			}
+
+			client, err := config.Client(context.Background())
+			if tc.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %v", err)
+				}
+				if client == nil {
+					t.Fatal("expected a client")
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Fatalf("expected error containing %q, got: %v", tc.ExpectedError, err)
+			}
+			if client != nil {
+				t.Fatal("expected no client to be returned")
+			}
+		})
+	}
+}
+
+func TestConfig_mutualTLS(t *testing.T) {
+	caCert, caKey := testTLSCertificate(t, nil, nil, "Test CA")
+	serverCert, serverKey := testTLSCertificate(t, caCert, caKey, "127.0.0.1")
+	clientCert, clientKey := testTLSCertificate(t, caCert, caKey, "terraform")
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caCert.Raw})
+	clientCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientCert.Raw})
+	clientKeyPEM := testTLSPrivateKeyPEM(t, clientKey)
+
+	dir := t.TempDir()
+	caCertFile := filepath.Join(dir, "ca.pem")
+	clientCertFile := filepath.Join(dir, "client.pem")
+	clientKeyFile := filepath.Join(dir, "client-key.pem")
+	for path, data := range map[string][]byte{caCertFile: caCertPEM, clientCertFile: clientCertPEM, clientKeyFile: clientKeyPEM} {
+		if err := os.WriteFile(path, data, 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.Raw}, PrivateKey: serverKey}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	cases := []struct {
+		Name        string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Name:   "inline PEM",
+			Config: Config{CACert: string(caCertPEM), ClientCert: string(clientCertPEM), ClientKey: string(clientKeyPEM)},
+		},
+		{
+			Name:   "file paths",
+			Config: Config{CACert: caCertFile, ClientCert: clientCertFile, ClientKey: clientKeyFile},
+		},
+		{
+			Name:   "CA certificate file",
+			Config: Config{CACertFile: caCertFile, ClientCert: clientCertFile, ClientKey: string(clientKeyPEM)},
+		},
+		{
+			Name:        "without client certificate",
+			Config:      Config{CACert: string(caCertPEM)},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			config := tc.Config
+			config.Token = "glpat-test"
+			config.BaseURL = server.URL
+
+			client, err := config.Client(context.Background())
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			_, _, err = client.Version.GetVersion()
+			if tc.ExpectError && err == nil {
+				t.Fatal("expected the TLS handshake to fail without a client certificate")
+			}
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("expected request to succeed, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestConfig_clientCertificateRequiresKey(t *testing.T) {
+	clientCert, _ := testTLSCertificate(t, nil, nil, "terraform")
+
+	config := Config{
+		Token:      "glpat-test",
+		ClientCert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientCert.Raw})),
+	}
+
+	if _, err := config.Client(context.Background()); err == nil {
+		t.Fatal("expected an error for a client certificate without a client key")
+	}
+}
+
+// testTLSCertificate creates a certificate for the given common name, which is signed by the given parent
+// or self-signed if the parent is nil. A self-signed certificate is a CA.
+func testTLSCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, commonName string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return cert, key
+}
+
+func testTLSPrivateKeyPEM(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
@@ -29,7 +29,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 
 	readFunc := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*gitlab.Client)
+		client := meta.(*ProviderMeta).Client
 		getter := createGetter(client)
 		log.Printf("[DEBUG] read Custom Attribute %s", d.Id())
 
@@ -38,9 +38,14 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 			return diag.FromErr(err)
 		}
 
-		customAttribute, _, err := getter(id, key)
+		customAttribute, _, err := getter(id, key, gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			if is404(err) {
+				log.Printf("[DEBUG] Custom Attribute %s not found, removing from state", d.Id())
+				d.SetId("")
+				return nil
+			}
+			return customAttributeErrorDiagnostics("read", d.Id(), err)
 		}
 
 		setToState(d, id, customAttribute)
@@ -48,7 +53,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 
 	setFunc := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*gitlab.Client)
+		client := meta.(*ProviderMeta).Client
 		setter := createSetter(client)
 
 		id := d.Get(idName).(int)
@@ -61,7 +66,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 
 		customAttribute, _, err := setter(id, *options, gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return customAttributeErrorDiagnostics("set", buildId(id, options.Key), err)
 		}
 
 		d.SetId(buildId(id, customAttribute.Key))
@@ -69,7 +74,7 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 
 	deleteFunc := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-		client := meta.(*gitlab.Client)
+		client := meta.(*ProviderMeta).Client
 		deleter := createDeleter(client)
 		log.Printf("[DEBUG] delete Custom Attribute %s", d.Id())
 
@@ -79,8 +84,8 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 		}
 
 		_, err = deleter(id, key, gitlab.WithContext(ctx))
-		if err != nil {
-			return diag.FromErr(err)
+		if err != nil && !is404(err) {
+			return customAttributeErrorDiagnostics("delete", d.Id(), err)
 		}
 
 		return nil
@@ -100,11 +105,13 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 			idName: {
 				Description: fmt.Sprintf("The id of the %s.", idName),
 				Type:        schema.TypeInt,
+				ForceNew:    true,
 				Required:    true,
 			},
 			"key": {
 				Description: "Key for the Custom Attribute.",
 				Type:        schema.TypeString,
+				ForceNew:    true,
 				Required:    true,
 			},
 			"value": {
@@ -116,6 +123,19 @@ func CreateCustomAttributeResource(idName string, createGetter CreateGetter, cre
 	}
 }
 
+// customAttributeErrorDiagnostics returns the diagnostics for a failed custom attribute API call.
+// Custom attributes can only be managed by administrators, which is pointed out for 403 responses.
+func customAttributeErrorDiagnostics(action string, id string, err error) diag.Diagnostics {
+	if is403(err) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to %s Custom Attribute %s: forbidden", action, id),
+			Detail:   fmt.Sprintf("Custom attributes can only be managed with the token of an administrator: %v", err),
+		}}
+	}
+	return diag.Errorf("failed to %s Custom Attribute %s: %v", action, id, err)
+}
+
 func parseId(id string) (int, string, error) {
 	parts := strings.SplitN(id, ":", 2)
 	if len(parts) != 2 {
@@ -74,7 +74,7 @@ var _ = registerDataSource("gitlab_branch", func() *schema.Resource {
 })
 
 func dataSourceGitlabBranchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] read gitlab branch %s", name)
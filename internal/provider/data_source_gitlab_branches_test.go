@@ -0,0 +1,53 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataGitlabBranches_search(t *testing.T) {
+	project := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_branch" "feature" {
+						for_each = toset(["feature/a", "feature/b", "other"])
+
+						name    = each.key
+						ref     = "main"
+						project = "%[1]d"
+					}
+
+					data "gitlab_branches" "all" {
+						project = "%[1]d"
+
+						depends_on = [gitlab_branch.feature]
+					}
+
+					data "gitlab_branches" "feature" {
+						project = "%[1]d"
+						search  = "^feature/"
+
+						depends_on = [gitlab_branch.feature]
+					}
+				`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					// main, feature/a, feature/b and other
+					resource.TestCheckResourceAttr("data.gitlab_branches.all", "branches.#", "4"),
+					resource.TestCheckResourceAttr("data.gitlab_branches.feature", "branches.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_branches.feature", "branches.0.name", "feature/a"),
+					resource.TestCheckResourceAttr("data.gitlab_branches.feature", "branches.1.name", "feature/b"),
+					resource.TestCheckResourceAttrPair("data.gitlab_branches.feature", "branches.0.commit.0.id", "gitlab_branch.feature[\"feature/a\"]", "commit.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -23,7 +23,7 @@ var _ = registerDataSource("gitlab_cluster_agent", func() *schema.Resource {
 })
 
 func dataSourceGitlabClusterAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	agentID := d.Get("agent_id").(int)
@@ -38,12 +38,11 @@ var _ = registerDataSource("gitlab_cluster_agents", func() *schema.Resource {
 })
 
 func dataSourceGitlabClusterAgentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.ListAgentsOptions{
-		PerPage: 20,
-		Page:    1,
+		Page: 1,
 	}
 
 	var clusterAgents []*gitlab.Agent
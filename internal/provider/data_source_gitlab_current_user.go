@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/xanzy/go-gitlab"
 )
 
 var _ = registerDataSource("gitlab_current_user", func() *schema.Resource {
@@ -68,10 +67,10 @@ var _ = registerDataSource("gitlab_current_user", func() *schema.Resource {
 })
 
 func dataSourceGitlabCurrentUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	query := GraphQLQuery{
-		`query {currentUser {name, bot, groupCount, id, namespace{id}, publicEmail, username}}`,
+		Query: `query {currentUser {name, bot, groupCount, id, namespace{id}, publicEmail, username}}`,
 	}
 	log.Printf("[DEBUG] executing GraphQL Query %s to retrieve current user", query.Query)
 
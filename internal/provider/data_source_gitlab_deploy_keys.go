@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_deploy_keys", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_deploy_keys`" + ` data source allows to retrieve a list of deploy keys enabled for a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/deploy_keys.html#list-deploy-keys-for-project)`,
+
+		ReadContext: dataSourceGitlabDeployKeysRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"deploy_keys": {
+				Description: "The list of deploy keys enabled for the project.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the deploy key.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the deploy key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The deploy key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"can_push": {
+							Description: "Whether the deploy key can push to the repository of the project.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "The creation date of the deploy key. In RFC3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabDeployKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+
+	options := &gitlab.ListProjectDeployKeysOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	log.Printf("[DEBUG] list gitlab deploy keys of project %s", project)
+
+	var deployKeys []*gitlab.ProjectDeployKey
+	for options.Page != 0 {
+		paginatedDeployKeys, resp, err := client.DeployKeys.ListProjectDeployKeys(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.Errorf("failed to list deploy keys of project %s: %s", project, err)
+		}
+		deployKeys = append(deployKeys, paginatedDeployKeys...)
+
+		options.Page = resp.NextPage
+	}
+
+	d.SetId(project)
+	if err := d.Set("deploy_keys", flattenGitlabProjectDeployKeys(deployKeys)); err != nil {
+		return diag.Errorf("error setting deploy_keys: %s", err)
+	}
+	return nil
+}
+
+func flattenGitlabProjectDeployKeys(keys []*gitlab.ProjectDeployKey) []interface{} {
+	result := []interface{}{}
+	for _, deployKey := range keys {
+		values := map[string]interface{}{
+			"id":       deployKey.ID,
+			"title":    deployKey.Title,
+			"key":      deployKey.Key,
+			"can_push": deployKey.CanPush,
+		}
+		if deployKey.CreatedAt != nil {
+			values["created_at"] = deployKey.CreatedAt.Format(time.RFC3339)
+		}
+		result = append(result, values)
+	}
+	return result
+}
@@ -97,12 +97,27 @@ var _ = registerDataSource("gitlab_group", func() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"shared_runners_setting": {
+				Description: "Enable or disable shared runners for the subgroups and projects of the group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"subgroup_count": {
+				Description: "The number of direct subgroups of the group.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"project_count": {
+				Description: "The number of projects directly in the group. Projects of subgroups and projects shared with the group are not counted.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 		},
 	}
 })
 
 func dataSourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	var group *gitlab.Group
 	var err error
@@ -114,13 +129,13 @@ func dataSourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta
 
 	if groupIDOk {
 		// Get group by id
-		group, _, err = client.Groups.GetGroup(groupIDData.(int), nil, gitlab.WithContext(ctx))
+		group, _, err = client.Groups.GetGroup(groupIDData.(int), &gitlab.GetGroupOptions{WithProjects: gitlab.Bool(false)}, gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
 	} else if fullPathOk {
 		// Get group by full path
-		group, _, err = client.Groups.GetGroup(fullPathData.(string), nil, gitlab.WithContext(ctx))
+		group, _, err = client.Groups.GetGroup(fullPathData.(string), &gitlab.GetGroupOptions{WithProjects: gitlab.Bool(false)}, gitlab.WithContext(ctx))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -128,6 +143,23 @@ func dataSourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("one and only one of group_id or full_path must be set")
 	}
 
+	// NOTE: only a single item is requested, the counts are taken from the pagination headers.
+	_, subGroupsResp, err := client.Groups.ListSubGroups(group.ID, &gitlab.ListSubGroupsOptions{ListOptions: gitlab.ListOptions{PerPage: 1}}, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to list subgroups of group %d: %v", group.ID, err)
+	}
+
+	// NOTE: only projects directly in the group are counted, like the subgroups.
+	projectsOptions := &gitlab.ListGroupProjectsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: 1},
+		IncludeSubGroups: gitlab.Bool(false),
+		WithShared:       gitlab.Bool(false),
+	}
+	_, projectsResp, err := client.Groups.ListGroupProjects(group.ID, projectsOptions, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to list projects of group %d: %v", group.ID, err)
+	}
+
 	d.Set("group_id", group.ID)
 	d.Set("full_path", group.FullPath)
 	d.Set("name", group.Name)
@@ -142,6 +174,9 @@ func dataSourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("runners_token", group.RunnersToken)
 	d.Set("default_branch_protection", group.DefaultBranchProtection)
 	d.Set("prevent_forking_outside_group", group.PreventForkingOutsideGroup)
+	d.Set("shared_runners_setting", group.SharedRunnersSetting)
+	d.Set("subgroup_count", subGroupsResp.TotalItems)
+	d.Set("project_count", projectsResp.TotalItems)
 
 	d.SetId(fmt.Sprintf("%d", group.ID))
 
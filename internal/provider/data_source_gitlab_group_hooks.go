@@ -34,12 +34,11 @@ var _ = registerDataSource("gitlab_group_hooks", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupHooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group := d.Get("group").(string)
 	options := gitlab.ListGroupHooksOptions{
-		PerPage: 20,
-		Page:    1,
+		Page: 1,
 	}
 
 	var hooks []*gitlab.GroupHook
@@ -96,7 +96,7 @@ var _ = registerDataSource("gitlab_group_membership", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	var group *gitlab.Group
 	var err error
@@ -127,8 +127,7 @@ func dataSourceGitlabGroupMembershipRead(ctx context.Context, d *schema.Resource
 	// Get group memberships
 	listOptions := &gitlab.ListGroupMembersOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 20,
-			Page:    1,
+			Page: 1,
 		},
 	}
 
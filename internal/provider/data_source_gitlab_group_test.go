@@ -23,6 +23,9 @@ func TestAccDataSourceGitlabGroup_basic(t *testing.T) {
 				Config: testAccDataGitlabGroupByID(rString),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGitlabGroup("gitlab_group.foo", "data.gitlab_group.foo"),
+					resource.TestCheckResourceAttr("data.gitlab_group.foo", "subgroup_count", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_group.foo", "project_count", "0"),
+					resource.TestCheckResourceAttrSet("data.gitlab_group.foo", "shared_runners_setting"),
 				),
 			},
 			// Get group using its full path
@@ -30,6 +33,8 @@ func TestAccDataSourceGitlabGroup_basic(t *testing.T) {
 				Config: testAccDataGitlabGroupByFullPath(rString),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGitlabGroup("gitlab_group.sub_foo", "data.gitlab_group.sub_foo"),
+					resource.TestCheckResourceAttr("data.gitlab_group.sub_foo", "subgroup_count", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_group.sub_foo", "project_count", "1"),
 				),
 			},
 		},
@@ -77,6 +82,8 @@ func testAccDataGitlabGroupByID(rString string) string {
 
 data "gitlab_group" "foo" {
   group_id = "${gitlab_group.foo.id}"
+
+  depends_on = [gitlab_project.sub_foo, gitlab_project_share_group.shared]
 }
 `, testAccDataGitlabGroupSetup(rString))
 }
@@ -87,6 +94,8 @@ func testAccDataGitlabGroupByFullPath(rString string) string {
 
 data "gitlab_group" "sub_foo" {
   full_path = "${gitlab_group.foo.path}/${gitlab_group.sub_foo.path}"
+
+  depends_on = [gitlab_project.sub_foo, gitlab_project_share_group.shared]
 }
 `, testAccDataGitlabGroupSetup(rString))
 }
@@ -111,5 +120,29 @@ resource "gitlab_group" "sub_foo" {
   # with no billing
   visibility_level = "public"
 }
+
+# A project of the subgroup and a project shared with the group are not counted as projects of the group
+resource "gitlab_project" "sub_foo" {
+  name         = "sub-foo-project-%[1]s"
+  namespace_id = gitlab_group.sub_foo.id
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project" "shared" {
+  name = "shared-project-%[1]s"
+
+  # So that acceptance tests can be run in a gitlab organization
+  # with no billing
+  visibility_level = "public"
+}
+
+resource "gitlab_project_share_group" "shared" {
+  project_id   = gitlab_project.shared.id
+  group_id     = gitlab_group.foo.id
+  group_access = "developer"
+}
   `, rString)
 }
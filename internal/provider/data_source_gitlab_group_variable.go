@@ -21,7 +21,7 @@ var _ = registerDataSource("gitlab_group_variable", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
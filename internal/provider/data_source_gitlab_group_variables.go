@@ -42,13 +42,12 @@ var _ = registerDataSource("gitlab_group_variables", func() *schema.Resource {
 })
 
 func dataSourceGitlabGroupVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	environmentScope := d.Get("environment_scope").(string)
 
 	options := &gitlab.ListGroupVariablesOptions{
-		Page:    1,
-		PerPage: 20,
+		Page: 1,
 	}
 
 	var variables []*gitlab.GroupVariable
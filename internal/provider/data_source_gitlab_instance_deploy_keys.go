@@ -109,13 +109,12 @@ var _ = registerDataSource("gitlab_instance_deploy_keys", func() *schema.Resourc
 })
 
 func dataSourceGitlabInstanceDeployKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	// Get group memberships
 	options := &gitlab.ListInstanceDeployKeysOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 20,
-			Page:    1,
+			Page: 1,
 		},
 		Public: gitlab.Bool(d.Get("public").(bool)),
 	}
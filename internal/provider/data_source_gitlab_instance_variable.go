@@ -20,11 +20,14 @@ var _ = registerDataSource("gitlab_instance_variable", func() *schema.Resource {
 })
 
 func dataSourceGitlabInstanceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	key := d.Get("key").(string)
 
-	variable, _, err := client.InstanceVariables.GetVariable(key, nil, gitlab.WithContext(ctx))
+	variable, _, err := client.InstanceVariables.GetVariable(key, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			return diag.Errorf("instance-level CI/CD variable with key %q does not exist", key)
+		}
 		return diag.FromErr(err)
 	}
 
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -37,6 +38,24 @@ func TestAccDataSourceGitlabInstanceVariable_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceGitlabInstanceVariable_notFound(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_instance_variable" "this" {
+						key = "missing_key_%d"
+					}
+				`, rInt),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`instance-level CI/CD variable with key "missing_key_%d" does not exist`, rInt)),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGitlabInstanceVariable(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
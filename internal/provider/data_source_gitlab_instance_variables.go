@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xanzy/go-gitlab"
 )
 
@@ -16,24 +19,41 @@ var _ = registerDataSource("gitlab_instance_variables", func() *schema.Resource
 
 		ReadContext: dataSourceGitlabInstanceVariablesRead,
 		Schema: map[string]*schema.Schema{
+			"key_regex": {
+				Description:      "A regular expression to filter the variables by their key. Only variables with a matching key are returned.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
 			"variables": {
 				Description: "The list of variables returned by the search",
 				Type:        schema.TypeList,
 				Computed:    true,
 				Elem: &schema.Resource{
-					Schema: datasourceSchemaFromResourceSchema(gitlabInstanceVariableGetSchema(), nil, nil),
+					Schema: gitlabInstanceVariablesDataSourceElemSchema(),
 				},
 			},
 		},
 	}
 })
 
+func gitlabInstanceVariablesDataSourceElemSchema() map[string]*schema.Schema {
+	elemSchema := datasourceSchemaFromResourceSchema(gitlabInstanceVariableGetSchema(), nil, nil)
+	elemSchema["value"].Sensitive = true
+	return elemSchema
+}
+
 func dataSourceGitlabInstanceVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
+
+	var keyRegex *regexp.Regexp
+	if v, ok := d.GetOk("key_regex"); ok {
+		keyRegex = regexp.MustCompile(v.(string))
+	}
 
 	options := &gitlab.ListInstanceVariablesOptions{
 		Page:    1,
-		PerPage: 20,
+		PerPage: 100,
 	}
 
 	var variables []*gitlab.InstanceVariable
@@ -43,11 +63,16 @@ func dataSourceGitlabInstanceVariablesRead(ctx context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 
-		variables = append(variables, paginatedVariables...)
+		for _, variable := range paginatedVariables {
+			if keyRegex != nil && !keyRegex.MatchString(variable.Key) {
+				continue
+			}
+			variables = append(variables, variable)
+		}
 		options.Page = resp.NextPage
 	}
 
-	d.SetId("instance_variables")
+	d.SetId(fmt.Sprintf("instance_variables:%s", d.Get("key_regex").(string)))
 	if err := d.Set("variables", flattenGitlabInstanceVariables(variables)); err != nil {
 		return diag.Errorf("failed to set variables to state: %v", err)
 	}
@@ -33,3 +33,28 @@ func TestAccDataSourceGitlabInstanceVariables_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceGitlabInstanceVariables_keyRegex(t *testing.T) {
+	testVariables := make([]*gitlab.InstanceVariable, 0)
+	for i := 0; i < 3; i++ {
+		testVariables = append(testVariables, testAccCreateInstanceVariable(t))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_instance_variables" "this" {
+						key_regex = "^%s$"
+					}
+				`, testVariables[1].Key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_instance_variables.this", "variables.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_instance_variables.this", "variables.0.key", testVariables[1].Key),
+					resource.TestCheckResourceAttr("data.gitlab_instance_variables.this", "variables.0.value", testVariables[1].Value),
+				),
+			},
+		},
+	})
+}
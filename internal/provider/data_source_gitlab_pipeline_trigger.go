@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerDataSource("gitlab_pipeline_trigger", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_pipeline_trigger`" + ` data source allows to retrieve details about a pipeline trigger of a project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pipeline_triggers.html#get-trigger-token-details)`,
+
+		ReadContext: dataSourceGitlabPipelineTriggerRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project the pipeline trigger belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"pipeline_trigger_id": {
+				Description: "The ID of the pipeline trigger.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the pipeline trigger.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token": {
+				Description: "The pipeline trigger token. Only returned if the trigger is owned by the current user.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"owner_id": {
+				Description: "The ID of the user owning the pipeline trigger.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The ISO8601 date/time the pipeline trigger was created at in UTC.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_used": {
+				Description: "The ISO8601 date/time the pipeline trigger was last used at in UTC.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func dataSourceGitlabPipelineTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+	pipelineTriggerID := d.Get("pipeline_trigger_id").(int)
+
+	pipelineTrigger, _, err := client.PipelineTriggers.GetPipelineTrigger(project, pipelineTriggerID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			return diag.Errorf("pipeline trigger %d does not exist in project %q", pipelineTriggerID, project)
+		}
+		return diag.Errorf("failed to get pipeline trigger %d of project %q: %v", pipelineTriggerID, project, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", project, pipelineTriggerID))
+	d.Set("description", pipelineTrigger.Description)
+	d.Set("token", pipelineTrigger.Token)
+	if pipelineTrigger.Owner != nil {
+		d.Set("owner_id", pipelineTrigger.Owner.ID)
+	}
+	if pipelineTrigger.CreatedAt != nil {
+		d.Set("created_at", pipelineTrigger.CreatedAt.Format(time.RFC3339))
+	}
+	if pipelineTrigger.LastUsed != nil {
+		d.Set("last_used", pipelineTrigger.LastUsed.Format(time.RFC3339))
+	}
+	return nil
+}
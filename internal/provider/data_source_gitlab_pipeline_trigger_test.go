@@ -0,0 +1,62 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccDataSourceGitlabPipelineTrigger_basic(t *testing.T) {
+	testProject := testAccCreateProject(t)
+	testTrigger, _, err := testGitlabClient.PipelineTriggers.AddPipelineTrigger(testProject.ID, &gitlab.AddPipelineTriggerOptions{
+		Description: gitlab.String("External Pipeline Trigger"),
+	})
+	if err != nil {
+		t.Fatalf("could not create pipeline trigger: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_pipeline_trigger" "this" {
+						project             = "%s"
+						pipeline_trigger_id = %d
+					}
+				`, testProject.PathWithNamespace, testTrigger.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_pipeline_trigger.this", "description", "External Pipeline Trigger"),
+					resource.TestCheckResourceAttr("data.gitlab_pipeline_trigger.this", "token", testTrigger.Token),
+					resource.TestCheckResourceAttrSet("data.gitlab_pipeline_trigger.this", "owner_id"),
+					resource.TestCheckResourceAttrSet("data.gitlab_pipeline_trigger.this", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGitlabPipelineTrigger_notFound(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_pipeline_trigger" "this" {
+						project             = %d
+						pipeline_trigger_id = 0
+					}
+				`, testProject.ID),
+				ExpectError: regexp.MustCompile(`pipeline trigger 0 does not exist in project`),
+			},
+		},
+	})
+}
@@ -2,10 +2,8 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
-	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -300,6 +298,61 @@ var _ = registerDataSource("gitlab_project", func() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"empty_repo": {
+				Description: "Whether the project repository is empty.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"forks_count": {
+				Description: "The number of forks of the project.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"star_count": {
+				Description: "The number of stars of the project.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"open_issues_count": {
+				Description: "The number of open issues of the project.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"container_registry_image_prefix": {
+				Description: "The image prefix of the container registry of the project.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"include_statistics": {
+				Description: "Whether to read the project statistics into `statistics`. Requires at least the reporter role in the project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"statistics": {
+				Description: "Statistics of the project, only populated when `include_statistics` is set.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_size": {
+							Description: "The size of the repository in bytes.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"storage_size": {
+							Description: "The total storage size of the project in bytes.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"lfs_objects_size": {
+							Description: "The size of the LFS objects of the project in bytes.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
 			"push_rules": {
 				Description: "Push rules for the project.",
 				Type:        schema.TypeList,
@@ -369,7 +422,7 @@ var _ = registerDataSource("gitlab_project", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	log.Printf("[INFO] Reading Gitlab project")
 
@@ -382,7 +435,12 @@ func dataSourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("Must specify either id or path_with_namespace")
 	}
 
-	found, _, err := client.Projects.GetProject(pid, nil, gitlab.WithContext(ctx))
+	options := &gitlab.GetProjectOptions{}
+	if d.Get("include_statistics").(bool) {
+		options.Statistics = gitlab.Bool(true)
+	}
+
+	found, _, err := client.Projects.GetProject(pid, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -442,12 +500,19 @@ func dataSourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("merge_commit_template", found.MergeCommitTemplate)
 	d.Set("ci_default_git_depth", found.CIDefaultGitDepth)
 	d.Set("ci_config_path", found.CIConfigPath)
+	d.Set("empty_repo", found.EmptyRepo)
+	d.Set("forks_count", found.ForksCount)
+	d.Set("star_count", found.StarCount)
+	d.Set("open_issues_count", found.OpenIssuesCount)
+	d.Set("container_registry_image_prefix", found.ContainerRegistryImagePrefix)
+	if err := d.Set("statistics", flattenProjectStatistics(found.Statistics)); err != nil {
+		return diag.Errorf("error setting statistics: %v", err)
+	}
 
 	log.Printf("[DEBUG] Reading Gitlab project %q push rules", d.Id())
 
 	pushRules, _, err := client.Projects.GetProjectPushRules(d.Id(), gitlab.WithContext(ctx))
-	var httpError *gitlab.ErrorResponse
-	if errors.As(err, &httpError) && httpError.Response.StatusCode == http.StatusNotFound {
+	if is404(err) {
 		log.Printf("[DEBUG] Failed to get push rules for project %q: %v", d.Id(), err)
 	} else if err != nil {
 		return diag.Errorf("Failed to get push rules for project %q: %v", d.Id(), err)
@@ -457,3 +522,17 @@ func dataSourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+func flattenProjectStatistics(statistics *gitlab.Statistics) []interface{} {
+	if statistics == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"repository_size":  statistics.RepositorySize,
+			"storage_size":     statistics.StorageSize,
+			"lfs_objects_size": statistics.LFSObjectsSize,
+		},
+	}
+}
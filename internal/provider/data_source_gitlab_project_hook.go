@@ -13,6 +13,8 @@ var _ = registerDataSource("gitlab_project_hook", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_project_hook`" + ` data source allows to retrieve details about a hook in a project.
 
+-> The secret token of the hook is never returned by the GitLab API, so the ` + "`token`" + ` attribute is always empty.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#get-project-hook)`,
 
 		ReadContext: dataSourceGitlabProjectHookRead,
@@ -21,13 +23,16 @@ var _ = registerDataSource("gitlab_project_hook", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	hookID := d.Get("hook_id").(int)
 
 	hook, _, err := client.Projects.GetProjectHook(project, hookID, gitlab.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		if is404(err) {
+			return diag.Errorf("project hook %d does not exist in project %q", hookID, project)
+		}
+		return diag.Errorf("failed to get project hook %d of project %q: %v", hookID, project, err)
 	}
 
 	d.SetId(fmt.Sprintf("%s:%d", project, hookID))
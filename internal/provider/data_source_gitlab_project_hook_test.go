@@ -5,14 +5,26 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/xanzy/go-gitlab"
 )
 
 func TestAccDataSourceGitlabProjectHook_basic(t *testing.T) {
 	testProject := testAccCreateProject(t)
-	testHook := testAccCreateProjectHooks(t, testProject.ID, 1)[0]
+	testHook, _, err := testGitlabClient.Projects.AddProjectHook(testProject.ID, &gitlab.AddProjectHookOptions{
+		URL:                   gitlab.String("https://example.com/hook"),
+		PushEvents:            gitlab.Bool(false),
+		IssuesEvents:          gitlab.Bool(true),
+		MergeRequestsEvents:   gitlab.Bool(true),
+		PipelineEvents:        gitlab.Bool(true),
+		EnableSSLVerification: gitlab.Bool(false),
+	})
+	if err != nil {
+		t.Fatalf("could not create project hook: %v", err)
+	}
 
 	resource.ParallelTest(t, resource.TestCase{
 		ProviderFactories: providerFactories,
@@ -28,8 +40,35 @@ func TestAccDataSourceGitlabProjectHook_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "hook_id", fmt.Sprintf("%d", testHook.ID)),
 					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "project_id", fmt.Sprintf("%d", testProject.ID)),
 					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "url", testHook.URL),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "push_events", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "issues_events", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "merge_requests_events", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "pipeline_events", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "tag_push_events", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "enable_ssl_verification", "false"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project_hook.this", "created_at"),
+					resource.TestCheckResourceAttr("data.gitlab_project_hook.this", "token", ""),
 				),
 			},
 		},
 	})
 }
+
+func TestAccDataSourceGitlabProjectHook_notFound(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_project_hook" "this" {
+						project = "%s"
+						hook_id = 999999999
+					}
+				`, testProject.PathWithNamespace),
+				ExpectError: regexp.MustCompile(`project hook 999999999 does not exist in project`),
+			},
+		},
+	})
+}
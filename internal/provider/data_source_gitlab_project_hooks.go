@@ -34,12 +34,11 @@ var _ = registerDataSource("gitlab_project_hooks", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectHooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.ListProjectHooksOptions{
-		PerPage: 20,
-		Page:    1,
+		Page: 1,
 	}
 
 	var hooks []*gitlab.ProjectHook
@@ -20,7 +20,7 @@ var _ = registerDataSource("gitlab_project_issue", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectIssueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	issueIID := d.Get("iid").(int)
 
@@ -213,13 +213,12 @@ var _ = registerDataSource("gitlab_project_issues", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.ListProjectIssuesOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 20,
-			Page:    1,
+			Page: 1,
 		},
 	}
 
@@ -232,12 +231,12 @@ func dataSourceGitlabProjectIssuesRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if v, ok := d.GetOk("labels"); ok {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(v.(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
 		options.Labels = &gitlabLabels
 	}
 
 	if v, ok := d.GetOk("not_labels"); ok {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(v.(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
 		options.Labels = &gitlabLabels
 	}
 
@@ -99,7 +99,7 @@ var _ = registerDataSource("gitlab_project_membership", func() *schema.Resource
 })
 
 func dataSourceGitlabProjectMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	var project *gitlab.Project
 	var err error
@@ -133,8 +133,7 @@ func dataSourceGitlabProjectMembershipRead(ctx context.Context, d *schema.Resour
 	listOptions := &gitlab.ListProjectMembersOptions{
 		Query: query,
 		ListOptions: gitlab.ListOptions{
-			PerPage: 20,
-			Page:    1,
+			Page: 1,
 		},
 	}
 
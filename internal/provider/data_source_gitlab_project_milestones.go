@@ -68,13 +68,12 @@ var _ = registerDataSource("gitlab_project_milestones", func() *schema.Resource
 })
 
 func dataSourceGitlabProjectMilestonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.ListMilestonesOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 20,
-			Page:    1,
+			Page: 1,
 		},
 	}
 
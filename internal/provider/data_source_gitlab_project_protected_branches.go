@@ -60,7 +60,7 @@ var _ = registerDataSource("gitlab_project_protected_branches", func() *schema.R
 })
 
 func dataSourceGitlabProjectProtectedBranchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	log.Printf("[INFO] Reading Gitlab protected branch")
 
@@ -23,6 +23,8 @@ func TestAccDataGitlabProjectTag_basic(t *testing.T) {
 				Config: testAccDataGitlabProjectTag(rInt, project.PathWithNamespace),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGitlabProjectTag("gitlab_project_tag.foo", "data.gitlab_project_tag.foo"),
+					resource.TestCheckResourceAttr("data.gitlab_project_tag.foo", "commit.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_project_tag.foo", "target", "gitlab_project_tag.foo", "target"),
 				),
 			},
 		},
@@ -38,6 +38,35 @@ func TestAccDataGitlabProject_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataGitlabProject_topicsAndStatistics(t *testing.T) {
+	projectname := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataGitlabProjectConfigTopicsAndStatistics(projectname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourceGitlabProject("gitlab_project.test", "data.gitlab_project.foo",
+						[]string{"id", "path_with_namespace", "topics.#"}),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "topics.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.gitlab_project.foo", "topics.*", "foo"),
+					resource.TestCheckTypeSetElemAttr("data.gitlab_project.foo", "topics.*", "bar"),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "empty_repo", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "forks_count", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "star_count", "0"),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "open_issues_count", "0"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project.foo", "container_registry_image_prefix"),
+					resource.TestCheckResourceAttr("data.gitlab_project.foo", "statistics.#", "1"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project.foo", "statistics.0.repository_size"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project.foo", "statistics.0.storage_size"),
+					resource.TestCheckResourceAttrSet("data.gitlab_project.foo", "statistics.0.lfs_objects_size"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGitlabProject(resourceName, dataSourceName string, testAttributes []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -107,3 +136,21 @@ data "gitlab_project" "foo" {
 }
 	`, projectName)
 }
+
+func testAccDataGitlabProjectConfigTopicsAndStatistics(projectName string) string {
+	return fmt.Sprintf(`
+resource "gitlab_project" "test"{
+	name = "%[1]s"
+	path = "%[1]s"
+	description = "Terraform acceptance tests"
+	visibility_level = "public"
+	initialize_with_readme = true
+	topics = ["foo", "bar"]
+}
+
+data "gitlab_project" "foo" {
+	path_with_namespace = gitlab_project.test.path_with_namespace
+	include_statistics  = true
+}
+	`, projectName)
+}
@@ -21,7 +21,7 @@ var _ = registerDataSource("gitlab_project_variable", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
@@ -42,13 +42,12 @@ var _ = registerDataSource("gitlab_project_variables", func() *schema.Resource {
 })
 
 func dataSourceGitlabProjectVariablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	environmentScope := d.Get("environment_scope").(string)
 
 	options := &gitlab.ListProjectVariablesOptions{
-		Page:    1,
-		PerPage: 20,
+		Page: 1,
 	}
 
 	var variables []*gitlab.ProjectVariable
@@ -108,7 +108,7 @@ func flattenProjects(projects []*gitlab.Project) (values []map[string]interface{
 				"id":                                    project.ID,
 				"description":                           project.Description,
 				"default_branch":                        project.DefaultBranch,
-				"public":                                project.Public,
+				"public":                                project.Visibility == gitlab.PublicVisibility,
 				"visibility":                            string(project.Visibility),
 				"ssh_url_to_repo":                       project.SSHURLToRepo,
 				"http_url_to_repo":                      project.HTTPURLToRepo,
@@ -286,6 +286,11 @@ var _ = registerDataSource("gitlab_projects", func() *schema.Resource {
 					"private",
 					"internal"}, true),
 			},
+			"topic": {
+				Description: "Limit by projects with the given topic. Multiple topics can be given as a comma-separated list, in which case only projects with all of the topics are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"with_issues_enabled": {
 				Description: "Limit by projects with issues feature enabled. Default is `false`.",
 				Type:        schema.TypeBool,
@@ -961,7 +966,7 @@ var _ = registerDataSource("gitlab_projects", func() *schema.Resource {
 // CRUD methods
 
 func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	var projectList []*gitlab.Project
 
 	// Permanent parameters
@@ -984,6 +989,7 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 	var sortPtr *string
 	var starredPtr *bool
 	var statisticsPtr *bool
+	var topicPtr *string
 	var visibilityPtr *gitlab.VisibilityValue
 	var withCustomAttributesPtr *bool
 	var withIssuesEnabledPtr *bool
@@ -1051,6 +1057,10 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 		d := data.(bool)
 		statisticsPtr = &d
 	}
+	if data, ok := d.GetOk("topic"); ok {
+		d := data.(string)
+		topicPtr = &d
+	}
 	if data, ok := d.GetOk("visibility"); ok {
 		visibilityPtr = gitlab.Visibility(gitlab.VisibilityValue(data.(string)))
 	}
@@ -1101,6 +1111,7 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			Simple:                   simplePtr,
 			Owned:                    ownedPtr,
 			Starred:                  starredPtr,
+			Topic:                    topicPtr,
 			WithIssuesEnabled:        withIssuesEnabledPtr,
 			WithMergeRequestsEnabled: withMergeRequestsEnabledPtr,
 			WithShared:               withSharedPtr,
@@ -1117,7 +1128,8 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			opts.ListOptions.Page++
 
 			log.Printf("[INFO] Currentpage: %d, Total: %d", response.CurrentPage, response.TotalPages)
-			if response.CurrentPage == response.TotalPages || response.CurrentPage > maxQueryablePages {
+			// NOTE: GitLab omits the total pages for large result sets, so rely on the next page instead.
+			if response.NextPage == 0 || response.CurrentPage >= maxQueryablePages {
 				break
 			}
 		}
@@ -1146,6 +1158,7 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			Membership:               membershipPtr,
 			Starred:                  starredPtr,
 			Statistics:               statisticsPtr,
+			Topic:                    topicPtr,
 			Visibility:               visibilityPtr,
 			WithIssuesEnabled:        withIssuesEnabledPtr,
 			WithMergeRequestsEnabled: withMergeRequestsEnabledPtr,
@@ -1163,7 +1176,8 @@ func dataSourceGitlabProjectsRead(ctx context.Context, d *schema.ResourceData, m
 			opts.ListOptions.Page++
 
 			log.Printf("[INFO] Currentpage: %d, Total: %d", response.CurrentPage, response.TotalPages)
-			if response.CurrentPage == response.TotalPages || response.CurrentPage > maxQueryablePages {
+			// NOTE: GitLab omits the total pages for large result sets, so rely on the next page instead.
+			if response.NextPage == 0 || response.CurrentPage >= maxQueryablePages {
 				break
 			}
 		}
@@ -96,6 +96,27 @@ func TestAccDataGitlabProjects_groups(t *testing.T) {
 	})
 }
 
+func TestAccDataGitlabProjects_visibilityAndTopic(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataGitlabProjectsConfigVisibilityAndTopic(rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_projects.private", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_projects.topic", "projects.#", "2"),
+					resource.TestCheckResourceAttr("data.gitlab_projects.private_topic", "projects.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_projects.private_topic", "projects.0.id", "gitlab_project.private_topic", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_projects.private_topic", "projects.0.visibility", "private"),
+					resource.TestCheckTypeSetElemAttr("data.gitlab_projects.private_topic", "projects.0.topics.*", fmt.Sprintf("tf-topic-%d", rInt)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataGitlabProjects_searchArchivedRepository(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -333,3 +354,54 @@ data "gitlab_projects" "subGroups" {
 }
 	`, parentGroupName, parentGroupName, subGroupName1, subGroupName1, subGroupName2, subGroupName2, projectName1, projectName2)
 }
+
+func testAccDataGitlabProjectsConfigVisibilityAndTopic(rInt int) string {
+	return fmt.Sprintf(`
+resource "gitlab_group" "test" {
+  name = "tf-group-%[1]d"
+  path = "tf-group-%[1]d"
+}
+
+resource "gitlab_project" "private_topic" {
+  name             = "private-topic-%[1]d"
+  namespace_id     = gitlab_group.test.id
+  visibility_level = "private"
+  topics           = ["tf-topic-%[1]d"]
+}
+
+resource "gitlab_project" "private" {
+  name             = "private-%[1]d"
+  namespace_id     = gitlab_group.test.id
+  visibility_level = "private"
+}
+
+resource "gitlab_project" "public_topic" {
+  name             = "public-topic-%[1]d"
+  namespace_id     = gitlab_group.test.id
+  visibility_level = "public"
+  topics           = ["tf-topic-%[1]d"]
+}
+
+data "gitlab_projects" "private" {
+  group_id   = gitlab_group.test.id
+  visibility = "private"
+
+  depends_on = [gitlab_project.private_topic, gitlab_project.private, gitlab_project.public_topic]
+}
+
+data "gitlab_projects" "topic" {
+  group_id = gitlab_group.test.id
+  topic    = "tf-topic-%[1]d"
+
+  depends_on = [gitlab_project.private_topic, gitlab_project.private, gitlab_project.public_topic]
+}
+
+data "gitlab_projects" "private_topic" {
+  group_id   = gitlab_group.test.id
+  visibility = "private"
+  topic      = "tf-topic-%[1]d"
+
+  depends_on = [gitlab_project.private_topic, gitlab_project.private, gitlab_project.public_topic]
+}
+	`, rInt)
+}
@@ -20,7 +20,7 @@ var _ = registerDataSource("gitlab_release_link", func() *schema.Resource {
 })
 
 func dataSourceGitlabReleaseLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	tagName := d.Get("tag_name").(string)
 	linkID := d.Get("link_id").(int)
@@ -43,14 +43,13 @@ var _ = registerDataSource("gitlab_release_links", func() *schema.Resource {
 })
 
 func dataSourceGitlabReleaseLinksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	tagName := d.Get("tag_name").(string)
 	options := gitlab.ListReleaseLinksOptions(
 		gitlab.ListOptions{
-			PerPage: 20,
-			Page:    1,
+			Page: 1,
 		})
 
 	var releaseLinks []*gitlab.ReleaseLink
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 
@@ -17,12 +18,21 @@ var _ = registerDataSource("gitlab_repository_file", func() *schema.Resource {
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/repository_files.html)`,
 
 		ReadContext: dataSourceGitlabRepositoryFileRead,
-		Schema:      datasourceSchemaFromResourceSchema(gitlabRepositoryFileGetSchema(), []string{"project", "file_path", "ref"}, nil),
+		Schema: constructSchema(
+			datasourceSchemaFromResourceSchema(gitlabRepositoryFileGetSchema(), []string{"project", "file_path", "ref"}, nil),
+			map[string]*schema.Schema{
+				"decoded_content": {
+					Description: "The decoded file content, e.g. to use it as plain text in other resources.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		),
 	}
 })
 
 func dataSourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	filePath := d.Get("file_path").(string)
 
@@ -33,6 +43,9 @@ func dataSourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceD
 	repositoryFile, resp, err := client.RepositoryFiles.GetFile(project, filePath, options, gitlab.WithContext(ctx))
 	if err != nil {
 		log.Printf("[DEBUG] file %s not found, response %v", filePath, resp)
+		if is404(err) {
+			return diag.Errorf("file %s not found in project %s at ref %s", filePath, project, *options.Ref)
+		}
 		return diag.FromErr(err)
 	}
 
@@ -42,5 +55,11 @@ func dataSourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceD
 	if err := setStateMapInResourceData(stateMap, d); err != nil {
 		return diag.FromErr(err)
 	}
+
+	decodedContent, err := base64.StdEncoding.DecodeString(repositoryFile.Content)
+	if err != nil {
+		return diag.Errorf("failed to decode the content of file %s in project %s: %v", filePath, project, err)
+	}
+	d.Set("decoded_content", string(decodedContent))
 	return nil
 }
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,12 +21,75 @@ func TestAccDataGitlabRepositoryFile_basic(t *testing.T) {
 				Config: testAccDataGitlabRepositoryFile(project.PathWithNamespace),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGitlabRepositoryFile("gitlab_repository_file.foo", "data.gitlab_repository_file.foo"),
+					resource.TestCheckResourceAttr("data.gitlab_repository_file.foo", "encoding", "base64"),
+					resource.TestCheckResourceAttr("data.gitlab_repository_file.foo", "decoded_content", "Meow goes the cat"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccDataGitlabRepositoryFile_atTag(t *testing.T) {
+	project := testAccCreateProject(t)
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "gitlab_project_tag" "foo" {
+  project = gitlab_repository_file.foo.project
+  name    = "v1.0.0"
+  ref     = gitlab_repository_file.foo.commit_id
+}
+
+data "gitlab_repository_file" "tag" {
+  project   = gitlab_project_tag.foo.project
+  file_path = gitlab_repository_file.foo.file_path
+  ref       = gitlab_project_tag.foo.name
+}
+`, testAccDataGitlabRepositoryFile(project.PathWithNamespace)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_repository_file.tag", "ref", "v1.0.0"),
+					resource.TestCheckResourceAttr("data.gitlab_repository_file.tag", "decoded_content", "Meow goes the cat"),
+					resource.TestCheckResourceAttrPair("data.gitlab_repository_file.tag", "blob_id", "gitlab_repository_file.foo", "blob_id"),
+					resource.TestCheckResourceAttrPair("data.gitlab_repository_file.tag", "last_commit_id", "gitlab_repository_file.foo", "last_commit_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataGitlabRepositoryFile_notFound(t *testing.T) {
+	project := testAccCreateProject(t)
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "gitlab_repository_file" "foo" {
+  project   = "%s"
+  file_path = "does-not-exist"
+  ref       = "main"
+}
+`, project.PathWithNamespace),
+				ExpectError: regexp.MustCompile(`file does-not-exist not found in project .* at ref main`),
+			},
+			{
+				Config: fmt.Sprintf(`
+data "gitlab_repository_file" "foo" {
+  project   = "%s"
+  file_path = "README.md"
+  ref       = "does-not-exist"
+}
+`, project.PathWithNamespace),
+				ExpectError: regexp.MustCompile(`file README.md not found in project .* at ref does-not-exist`),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGitlabRepositoryFile(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -39,7 +103,6 @@ func testAccDataSourceGitlabRepositoryFile(src, n string) resource.TestCheckFunc
 			"project",
 			"file_path",
 			"size",
-			"encoding",
 			"content",
 			"execute_filemode",
 			"ref",
@@ -77,12 +77,12 @@ var _ = registerDataSource("gitlab_repository_tree", func() *schema.Resource {
 })
 
 func dataSourceGitlabRepositoryTreeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	options := &gitlab.ListTreeOptions{
 		ListOptions: gitlab.ListOptions{
-			PerPage: 20,
+			PerPage: 100,
 			Page:    1,
 		},
 		Path:      gitlab.String(d.Get("path").(string)),
@@ -122,7 +122,7 @@ func flattenGitlabRepositoryTree(project string, treeNodes []*gitlab.TreeNode) [
 	for _, node := range treeNodes {
 
 		values := map[string]interface{}{
-			"id":   project,
+			"id":   node.ID,
 			"name": node.Name,
 			"type": node.Type,
 			"path": node.Path,
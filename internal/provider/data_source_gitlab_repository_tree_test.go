@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -30,6 +31,7 @@ func TestAccDataSourceGitlabRepositoryTree_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gitlab_repository_tree.this", "tree.0.type", "blob"),
 					resource.TestCheckResourceAttr("data.gitlab_repository_tree.this", "tree.0.path", "README.md"),
 					resource.TestCheckResourceAttr("data.gitlab_repository_tree.this", "tree.0.mode", "100644"),
+					resource.TestMatchResourceAttr("data.gitlab_repository_tree.this", "tree.0.id", regexp.MustCompile(`^[0-9a-f]{40}$`)),
 
 					resource.TestCheckResourceAttr("data.gitlab_repository_tree.this", "tree.1.name", testFile.FilePath),
 					resource.TestCheckResourceAttr("data.gitlab_repository_tree.this", "tree.1.type", "blob"),
@@ -40,3 +42,44 @@ func TestAccDataSourceGitlabRepositoryTree_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceGitlabRepositoryTree_recursiveSubdirectory(t *testing.T) {
+	testProject := testAccCreateProject(t)
+	testAccCreateProjectFile(t, testProject.ID, "content", "dir/first", testProject.DefaultBranch)
+	testAccCreateProjectFile(t, testProject.ID, "content", "dir/sub/second", testProject.DefaultBranch)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "gitlab_repository_tree" "this" {
+						project   = %d
+						ref       = "%s"
+						path      = "dir"
+						recursive = true
+					}
+				`, testProject.ID, testProject.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_repository_tree.this", "tree.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.this", "tree.*", map[string]string{
+						"name": "sub",
+						"type": "tree",
+						"path": "dir/sub",
+						"mode": "040000",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.this", "tree.*", map[string]string{
+						"name": "first",
+						"type": "blob",
+						"path": "dir/first",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.gitlab_repository_tree.this", "tree.*", map[string]string{
+						"name": "second",
+						"type": "blob",
+						"path": "dir/sub/second",
+					}),
+				),
+			},
+		},
+	})
+}
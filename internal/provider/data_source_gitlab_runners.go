@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/mitchellh/hashstructure"
+	"github.com/xanzy/go-gitlab"
+)
+
+var runnerStatusAllowedValues = []string{
+	"online",
+	"offline",
+	"stale",
+	"never_contacted",
+}
+
+var _ = registerDataSource("gitlab_runners", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_runners`" + ` data source allows to list runners and filter them, for example to find idle runners.
+
+Without ` + "`project`" + ` or ` + "`group`" + ` all runners of the instance are listed, which requires administrator privileges.
+
+-> The tags of each runner are read with one additional request per runner.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/runners.html)`,
+
+		ReadContext: dataSourceGitlabRunnersRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description:   "The ID or URL-encoded path of a project to list the runners available in.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"group"},
+			},
+			"group": {
+				Description:   "The ID or URL-encoded path of a group to list the runners available in, including the ones of its ancestor groups.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project"},
+			},
+			"type": {
+				Description:      fmt.Sprintf("Return only runners of the given type. Valid values are: %s.", renderValueListForDocs(userRunnerTypeAllowedValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(userRunnerTypeAllowedValues, false)),
+			},
+			"status": {
+				Description:      fmt.Sprintf("Return only runners with the given status. Valid values are: %s.", renderValueListForDocs(runnerStatusAllowedValues)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(runnerStatusAllowedValues, false)),
+			},
+			"paused": {
+				Description: "Return only paused runners when `true`, or only runners accepting jobs when `false`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"tag_list": {
+				Description: "Return only runners having all of the given tags.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"runners": {
+				Description: "The list of runners.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the runner.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the runner.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"active": {
+							Description: "Whether the runner accepts new jobs.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"paused": {
+							Description: "Whether the runner ignores new jobs.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"online": {
+							Description: "Whether the runner has contacted GitLab recently.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the runner.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_shared": {
+							Description: "Whether the runner is shared with all projects.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"runner_type": {
+							Description: "The type of the runner.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tag_list": {
+							Description: "The tags of the runner.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func dataSourceGitlabRunnersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	project := d.Get("project").(string)
+	group := d.Get("group").(string)
+	options := gitlab.ListRunnersOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		options.Type = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		options.Status = gitlab.String(v.(string))
+	}
+
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("paused"); ok {
+		options.Paused = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("tag_list"); ok {
+		options.TagList = stringSetToStringSlice(v.(*schema.Set))
+	}
+
+	optionsHash, err := hashstructure.Hash(&options, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var runners []*gitlab.Runner
+	for options.Page != 0 {
+		var paginatedRunners []*gitlab.Runner
+		var resp *gitlab.Response
+		switch {
+		case project != "":
+			projectOptions := gitlab.ListProjectRunnersOptions(options)
+			paginatedRunners, resp, err = client.Runners.ListProjectRunners(project, &projectOptions, gitlab.WithContext(ctx))
+		case group != "":
+			// NOTE: the group runners API doesn't support the `paused` filter, it's applied below.
+			groupOptions := gitlab.ListGroupsRunnersOptions{
+				ListOptions: options.ListOptions,
+				Type:        options.Type,
+				Status:      options.Status,
+				TagList:     options.TagList,
+			}
+			paginatedRunners, resp, err = client.Runners.ListGroupsRunners(group, &groupOptions, gitlab.WithContext(ctx))
+		default:
+			paginatedRunners, resp, err = client.Runners.ListAllRunners(&options, gitlab.WithContext(ctx))
+		}
+		if err != nil {
+			return diag.Errorf("failed to list runners: %v", err)
+		}
+
+		for _, runner := range paginatedRunners {
+			if options.Paused != nil && runner.Paused != *options.Paused {
+				continue
+			}
+			runners = append(runners, runner)
+		}
+		options.Page = resp.NextPage
+	}
+
+	log.Printf("[DEBUG] read tags of %d gitlab runners", len(runners))
+	values := make([]map[string]interface{}, 0, len(runners))
+	for _, runner := range runners {
+		details, _, err := client.Runners.GetRunnerDetails(runner.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.Errorf("failed to read details of runner %d: %v", runner.ID, err)
+		}
+
+		values = append(values, map[string]interface{}{
+			"id":          runner.ID,
+			"description": runner.Description,
+			"active":      !runner.Paused,
+			"paused":      runner.Paused,
+			"online":      runner.Online,
+			"status":      runner.Status,
+			"is_shared":   runner.IsShared,
+			"runner_type": runner.RunnerType,
+			"tag_list":    details.TagList,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%d", project, group, optionsHash))
+	if err := d.Set("runners", values); err != nil {
+		return diag.Errorf("failed to set runners to state: %v", err)
+	}
+
+	return nil
+}
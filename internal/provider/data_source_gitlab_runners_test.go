@@ -0,0 +1,70 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGitlabRunners_filterByTag(t *testing.T) {
+	testAccRequiresAtLeast(t, "16.0")
+
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabUserRunnerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_runner" "docker" {
+						runner_type = "project_type"
+						project_id  = %[1]d
+						description = "docker runner"
+						tag_list    = ["docker", "linux"]
+					}
+
+					resource "gitlab_user_runner" "shell" {
+						runner_type = "project_type"
+						project_id  = %[1]d
+						description = "shell runner"
+						tag_list    = ["shell", "linux"]
+						paused      = true
+					}
+
+					data "gitlab_runners" "docker" {
+						project  = %[1]d
+						tag_list = ["docker"]
+
+						depends_on = [gitlab_user_runner.docker, gitlab_user_runner.shell]
+					}
+
+					data "gitlab_runners" "linux_paused" {
+						project  = %[1]d
+						tag_list = ["linux"]
+						paused   = true
+
+						depends_on = [gitlab_user_runner.docker, gitlab_user_runner.shell]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_runners.docker", "runners.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_runners.docker", "runners.0.id", "gitlab_user_runner.docker", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.docker", "runners.0.description", "docker runner"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.docker", "runners.0.runner_type", "project_type"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.docker", "runners.0.active", "true"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.docker", "runners.0.online", "false"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.docker", "runners.0.tag_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.gitlab_runners.docker", "runners.0.tag_list.*", "docker"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.linux_paused", "runners.#", "1"),
+					resource.TestCheckResourceAttrPair("data.gitlab_runners.linux_paused", "runners.0.id", "gitlab_user_runner.shell", "id"),
+					resource.TestCheckResourceAttr("data.gitlab_runners.linux_paused", "runners.0.paused", "true"),
+				),
+			},
+		},
+	})
+}
@@ -42,7 +42,7 @@ var _ = registerDataSource("gitlab_user", func() *schema.Resource {
 				},
 			},
 			"email": {
-				Description: "The public email address of the user. **Note**: before GitLab 14.8 the lookup was based on the users primary email address.",
+				Description: "The public email address of the user. Looking up a user by an email address which is not public requires an administrator token. **Note**: before GitLab 14.8 the lookup was based on the users primary email address.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Optional:    true,
@@ -91,6 +91,11 @@ var _ = registerDataSource("gitlab_user", func() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"bot": {
+				Description: "Whether the user is a bot user, e.g. the user of a project or group access token.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 			"extern_uid": {
 				Description: "The external UID of the user.",
 				Type:        schema.TypeString,
@@ -182,7 +187,7 @@ var _ = registerDataSource("gitlab_user", func() *schema.Resource {
 })
 
 func dataSourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	var user *gitlab.User
 	var err error
@@ -219,6 +224,16 @@ func dataSourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if len(users) == 0 {
+			if emailOk {
+				// Only administrators can find users by their private email addresses.
+				isAdmin, err := isCurrentUserAdmin(ctx, client)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				if !isAdmin {
+					return diag.Errorf("couldn't find a user with the public email address %q. Looking up users by an email address which is not public requires an administrator token", email)
+				}
+			}
 			return diag.Errorf("couldn't find a user matching: %s%s", username, email)
 		} else if len(users) != 1 {
 			return diag.Errorf("more than one user found matching: %s%s", username, email)
@@ -240,6 +255,7 @@ func dataSourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("projects_limit", user.ProjectsLimit)
 	d.Set("state", user.State)
 	d.Set("external", user.External)
+	d.Set("bot", user.Bot)
 	d.Set("extern_uid", user.ExternUID)
 
 	if user.CreatedAt != nil {
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -23,6 +24,7 @@ func TestAccDataSourceGitlabUser_basic(t *testing.T) {
 				Config: testAccDataGitlabUserConfigEmail(rString),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGitlabUser("gitlab_user.foo", "data.gitlab_user.foo"),
+					resource.TestCheckResourceAttr("data.gitlab_user.foo", "bot", "false"),
 				),
 			},
 			// Get user using its ID
@@ -43,6 +45,24 @@ func TestAccDataSourceGitlabUser_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceGitlabUser_notFound(t *testing.T) {
+	rString := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "gitlab_user" "foo" {
+  email = "unknown-%s@example.com"
+}
+`, rString),
+				ExpectError: regexp.MustCompile(`couldn't find a user matching`),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGitlabUser(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -56,6 +56,11 @@ var _ = registerDataSource("gitlab_users", func() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			"external": {
+				Description: "Filter users that are external. (Requires administrator privileges)",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"extern_uid": {
 				Description: "Lookup users by external UID. (Requires administrator privileges)",
 				Type:        schema.TypeString,
@@ -137,6 +142,11 @@ var _ = registerDataSource("gitlab_users", func() *schema.Resource {
 							Type:        schema.TypeBool,
 							Computed:    true,
 						},
+						"bot": {
+							Description: "Whether the user is a bot user, e.g. the user of a project or group access token.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
 						"extern_uid": {
 							Description: "The external UID of the user.",
 							Type:        schema.TypeString,
@@ -226,7 +236,7 @@ var _ = registerDataSource("gitlab_users", func() *schema.Resource {
 })
 
 func dataSourceGitlabUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	listUsersOptions, id, err := expandGitlabUsersOptions(d)
 	if err != nil {
@@ -267,6 +277,7 @@ func flattenGitlabUsers(users []*gitlab.User) []interface{} {
 			"projects_limit":     user.ProjectsLimit,
 			"state":              user.State,
 			"external":           user.External,
+			"bot":                user.Bot,
 			"extern_uid":         user.ExternUID,
 			"provider":           user.Provider,
 			"two_factor_enabled": user.TwoFactorEnabled,
@@ -333,6 +344,12 @@ func expandGitlabUsersOptions(d *schema.ResourceData) (*gitlab.ListUsersOptions,
 		optionsHash.WriteString(strconv.FormatBool(blocked))
 	}
 	optionsHash.WriteString(",")
+	if data, ok := d.GetOk("external"); ok {
+		external := data.(bool)
+		listUsersOptions.External = &external
+		optionsHash.WriteString(strconv.FormatBool(external))
+	}
+	optionsHash.WriteString(",")
 	if data, ok := d.GetOk("extern_uid"); ok {
 		externalUID := data.(string)
 		listUsersOptions.ExternalUID = &externalUID
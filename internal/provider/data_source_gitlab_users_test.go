@@ -41,6 +41,14 @@ func TestAccDataSourceGitlabUsers_basic(t *testing.T) {
 					// resource.TestCheckResourceAttr("data.gitlab_users.foo", "users.0.email", user2),
 				),
 			},
+			{
+				Config: testAccDataSourceGitlabUsersConfigExternal(rInt, rInt2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gitlab_users.foo", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.gitlab_users.foo", "users.0.email", user2),
+					resource.TestCheckResourceAttr("data.gitlab_users.foo", "users.0.external", "true"),
+				),
+			},
 			{
 				Config: testAccDataSourceGitlabLotsOfUsers(),
 			},
@@ -124,6 +132,32 @@ data "gitlab_users" "foo" {
 	`, rInt, rInt, rInt, rInt2, rInt2, rInt2, rInt2)
 }
 
+func testAccDataSourceGitlabUsersConfigExternal(rInt int, rInt2 int) string {
+	return fmt.Sprintf(`
+resource "gitlab_user" "foo" {
+  name             = "footest1"
+  username         = "listest%d"
+  password         = "test%dtt"
+  email            = "user%d@test.test"
+  projects_limit   = 3
+}
+
+resource "gitlab_user" "foo2" {
+  name             = "footest2"
+  username         = "listest%d"
+  password         = "test%dtt"
+  email            = "user%d@test.test"
+  projects_limit   = 2
+  is_external      = true
+}
+
+data "gitlab_users" "foo" {
+  search   = "footest"
+  external = true
+}
+	`, rInt, rInt, rInt, rInt2, rInt2, rInt2)
+}
+
 func testAccDataSourceGitlabLotsOfUsers() string {
 	return fmt.Sprintf(`
 resource "gitlab_user" "foo" {
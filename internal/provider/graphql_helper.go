@@ -2,25 +2,64 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"strings"
 
 	"github.com/xanzy/go-gitlab"
 )
 
 // Helper method for modifying client requests appropriately for sending a GraphQL call instead of a REST call.
+// Errors reported in the `errors` field of the GraphQL response are returned as error.
 func SendGraphQLRequest(ctx context.Context, client *gitlab.Client, query GraphQLQuery, response interface{}) (interface{}, error) {
-	request, err := client.NewRequest("POST", "", query, nil)
+	request, err := client.NewRequest("POST", "", query, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
 	if err != nil {
 		return nil, err
 	}
 	// Overwrite the path of the existing request, as otherwise the go-gitlab client appends /api/v4 instead.
 	request.URL.Path = "/api/graphql"
-	if _, err = client.Do(request, response); err != nil {
+	var rawResponse json.RawMessage
+	if _, err = client.Do(request, &rawResponse); err != nil {
+		return nil, err
+	}
+
+	var errorResponse struct {
+		Errors []GraphQLError `json:"errors"`
+	}
+	if err := json.Unmarshal(rawResponse, &errorResponse); err != nil {
+		return nil, err
+	}
+	if err := graphQLErrorsToError(errorResponse.Errors); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(rawResponse, response); err != nil {
 		return nil, err
 	}
 	return response, nil
 }
 
 // Represents a GraphQL call to the API. All GraphQL calls are a string passed to the "query" parameter, so they should be included here.
+// Values which are not part of the query itself, like user input, are passed with the "variables" parameter.
 type GraphQLQuery struct {
-	Query string `json:"query"`
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError represents an error returned by the GraphQL API, either for the whole request or by a mutation.
+type GraphQLError struct {
+	Message string `json:"message"`
+}
+
+// graphQLErrorsToError combines the given GraphQL errors into a single error, or returns nil if there are none.
+func graphQLErrorsToError(graphQLErrors []GraphQLError) error {
+	if len(graphQLErrors) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(graphQLErrors))
+	for _, e := range graphQLErrors {
+		messages = append(messages, e.Message)
+	}
+	return errors.New(strings.Join(messages, ", "))
 }
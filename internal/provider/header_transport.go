@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"net/http"
+)
+
+// headerTransport is a http.RoundTripper which adds custom headers to each request.
+// Headers which are already set by the GitLab client, like `Authorization`, are never overridden.
+type headerTransport struct {
+	transport http.RoundTripper
+	headers   map[string]string
+}
+
+func newHeaderTransport(transport http.RoundTripper, headers map[string]string) *headerTransport {
+	return &headerTransport{
+		transport: transport,
+		headers:   headers,
+	}
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the given request.
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		if req.Header.Get(key) == "" {
+			req.Header.Set(key, value)
+		}
+	}
+
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestConfig_customHeaders(t *testing.T) {
+	var gotHeaders http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeaders = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:   "glpat-test",
+		BaseURL: server.URL,
+		Headers: map[string]string{
+			"X-Gateway-Key": "gateway-secret",
+			"X-Team":        "platform",
+			// The client sets the Authorization header itself, so it must not be overridden.
+			"Authorization": "Bearer clobbered",
+		},
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Version.GetVersion(); err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+
+	for key, want := range map[string]string{
+		"X-Gateway-Key": "gateway-secret",
+		"X-Team":        "platform",
+		"Authorization": "Bearer glpat-test",
+	} {
+		if got := gotHeaders.Get(key); got != want {
+			t.Fatalf("got header %s %q, expected %q", key, got, want)
+		}
+	}
+}
+
+func TestValidateProviderHeaders(t *testing.T) {
+	cases := []struct {
+		Headers     map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Headers:     map[string]interface{}{"X-Gateway-Key": "secret"},
+			ExpectError: false,
+		},
+		{
+			Headers:     map[string]interface{}{"Authorization": "Bearer token"},
+			ExpectError: true,
+		},
+		{
+			Headers:     map[string]interface{}{"authorization": "Bearer token"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		diags := validateProviderHeaders(tc.Headers, cty.GetAttrPath("headers"))
+		if diags.HasError() != tc.ExpectError {
+			t.Fatalf("got diagnostics %v for %v, expected error: %t", diags, tc.Headers, tc.ExpectError)
+		}
+	}
+}
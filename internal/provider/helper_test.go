@@ -83,6 +83,26 @@ func testAccCheckEE(t *testing.T) {
 	}
 }
 
+// testAccCheckSAMLEnabled skips the test unless the GitLab test instance has SAML authentication enabled.
+// This is signaled with the GITLAB_SAML_ENABLED environment variable, see scripts/gitlab.rb.
+func testAccCheckSAMLEnabled(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("GITLAB_SAML_ENABLED") == "" {
+		t.Skip("Test is skipped because SAML is not enabled on the GitLab test instance (GITLAB_SAML_ENABLED is not set)")
+	}
+}
+
+// testAccCheckLDAPEnabled skips the test unless the GitLab test instance has an LDAP server configured.
+// This is signaled with the GITLAB_LDAP_ENABLED environment variable.
+func testAccCheckLDAPEnabled(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("GITLAB_LDAP_ENABLED") == "" {
+		t.Skip("Test is skipped because LDAP is not enabled on the GitLab test instance (GITLAB_LDAP_ENABLED is not set)")
+	}
+}
+
 func testAccRequiresLessThan(t *testing.T, requiredMaxVersion string) {
 	isLessThan, err := isGitLabVersionLessThan(context.TODO(), testGitlabClient, requiredMaxVersion)()
 	if err != nil {
@@ -156,7 +176,7 @@ func testAccCreateProjectWithNamespace(t *testing.T, namespaceID int) *gitlab.Pr
 	}
 
 	t.Cleanup(func() {
-		if _, err := testGitlabClient.Projects.DeleteProject(project.ID); err != nil {
+		if _, err := testGitlabClient.Projects.DeleteProject(project.ID, nil); err != nil {
 			t.Fatalf("could not cleanup test project: %v", err)
 		}
 	})
@@ -216,7 +236,7 @@ func testAccCreateGroups(t *testing.T, n int) []*gitlab.Group {
 
 		groupID := groups[i].ID // Needed for closure.
 		t.Cleanup(func() {
-			if _, err := testGitlabClient.Groups.DeleteGroup(groupID); err != nil {
+			if _, err := testGitlabClient.Groups.DeleteGroup(groupID, nil); err != nil {
 				t.Fatalf("could not cleanup test group: %v", err)
 			}
 		})
@@ -400,6 +420,57 @@ func testAccCreateClusterAgents(t *testing.T, pid interface{}, n int) []*gitlab.
 	return clusterAgents
 }
 
+// testAccCreateComplianceFrameworks is a test helper for creating a specified number of compliance frameworks
+// in a top-level group. It returns the global IDs of the frameworks.
+func testAccCreateComplianceFrameworks(t *testing.T, group *gitlab.Group, n int) []string {
+	t.Helper()
+
+	var frameworkIDs []string
+	for i := 0; i < n; i++ {
+		query := GraphQLQuery{
+			Query: `mutation($namespacePath: ID!, $name: String!) {
+				createComplianceFramework(input: {namespacePath: $namespacePath, params: {name: $name, description: "Terraform acceptance tests", color: "#1aaa55"}}) {
+					framework { id }
+					errors
+				}
+			}`,
+			Variables: map[string]interface{}{
+				"namespacePath": group.FullPath,
+				"name":          acctest.RandomWithPrefix("acctest-framework"),
+			},
+		}
+		var response struct {
+			Data struct {
+				CreateComplianceFramework struct {
+					Framework struct {
+						ID string `json:"id"`
+					} `json:"framework"`
+					Errors []string `json:"errors"`
+				} `json:"createComplianceFramework"`
+			} `json:"data"`
+		}
+		if _, err := SendGraphQLRequest(context.Background(), testGitlabClient, query, &response); err != nil {
+			t.Fatalf("could not create test compliance framework: %v", err)
+		}
+		if errs := response.Data.CreateComplianceFramework.Errors; len(errs) > 0 {
+			t.Fatalf("could not create test compliance framework: %v", errs)
+		}
+
+		frameworkID := response.Data.CreateComplianceFramework.Framework.ID
+		t.Cleanup(func() {
+			query := GraphQLQuery{
+				Query:     `mutation($id: ComplianceManagementFrameworkID!) { destroyComplianceFramework(input: {id: $id}) { errors } }`,
+				Variables: map[string]interface{}{"id": frameworkID},
+			}
+			if _, err := SendGraphQLRequest(context.Background(), testGitlabClient, query, &struct{}{}); err != nil {
+				t.Fatalf("could not cleanup test compliance framework: %v", err)
+			}
+		})
+		frameworkIDs = append(frameworkIDs, frameworkID)
+	}
+	return frameworkIDs
+}
+
 func testAccCreateProjectIssues(t *testing.T, pid interface{}, n int) []*gitlab.Issue {
 	t.Helper()
 
@@ -508,7 +579,7 @@ func testAccCreateProjectEnvironment(t *testing.T, projectID int, options *gitla
 
 	t.Cleanup(func() {
 		if projectEnvironment.State != "stopped" {
-			_, err = testGitlabClient.Environments.StopEnvironment(projectID, projectEnvironment.ID)
+			_, _, err = testGitlabClient.Environments.StopEnvironment(projectID, projectEnvironment.ID, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -625,7 +696,7 @@ func testAccGitlabProjectStart(t *testing.T) testAccGitlabProjectContext {
 	}
 
 	t.Cleanup(func() {
-		if _, err := testGitlabClient.Projects.DeleteProject(project.ID); err != nil {
+		if _, err := testGitlabClient.Projects.DeleteProject(project.ID, nil); err != nil {
 			t.Fatalf("could not delete test project: %v", err)
 		}
 	})
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+const (
+	hookTestStatusSucceeded = "succeeded"
+	hookTestStatusFailed    = "failed"
+)
+
+// testGitlabProjectHook triggers a test of the project hook with a push event and returns the test status.
+// A failing test is reported as a warning, because the hook target may not be reachable yet during the apply.
+func testGitlabProjectHook(ctx context.Context, client *gitlab.Client, project string, hookID int) (string, diag.Diagnostics) {
+	log.Printf("[DEBUG] test gitlab project hook %s/%d", project, hookID)
+
+	if _, err := client.Projects.TriggerTestProjectHook(project, hookID, gitlab.ProjectHookEventPush, gitlab.WithContext(ctx)); err != nil {
+		return hookTestStatusFailed, diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Test of project hook %d failed", hookID),
+				Detail:   fmt.Sprintf("The hook has been created, but triggering a test push event failed: %v", err),
+			},
+		}
+	}
+
+	return hookTestStatusSucceeded, nil
+}
+
+// gitlabHookCustomHeadersSchema returns the schema of the `custom_headers` attribute of the project and group hook resources.
+func gitlabHookCustomHeadersSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Custom headers to send when invoking the hook. GitLab does not return the header values, so changes made outside of Terraform are not detected.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Sensitive:   true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func expandHookCustomHeaders(v interface{}) *[]*gitlab.HookCustomHeader {
+	customHeaders := []*gitlab.HookCustomHeader{}
+	for key, value := range v.(map[string]interface{}) {
+		customHeaders = append(customHeaders, &gitlab.HookCustomHeader{Key: key, Value: value.(string)})
+	}
+	sort.Slice(customHeaders, func(i, j int) bool { return customHeaders[i].Key < customHeaders[j].Key })
+	return &customHeaders
+}
+
+// changedHookCustomHeaders returns the custom headers which have been added or changed and the keys
+// of the custom headers which have been removed between the old and the new `custom_headers` value.
+func changedHookCustomHeaders(oldValue, newValue interface{}) (map[string]string, []string) {
+	oldHeaders := oldValue.(map[string]interface{})
+	newHeaders := newValue.(map[string]interface{})
+
+	changed := make(map[string]string)
+	for key, value := range newHeaders {
+		if oldHeaders[key] != value {
+			changed[key] = value.(string)
+		}
+	}
+
+	var removed []string
+	for key := range oldHeaders {
+		if _, ok := newHeaders[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	return changed, removed
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestGitlab_resourceGitlabProjectHookCreate_testOnCreate(t *testing.T) {
+	cases := []struct {
+		Name               string
+		TestStatusCode     int
+		TestResponse       string
+		ExpectedTestStatus string
+		ExpectWarning      bool
+	}{
+		{
+			Name:               "test succeeds",
+			TestStatusCode:     http.StatusCreated,
+			TestResponse:       `{"message": "201 Created"}`,
+			ExpectedTestStatus: hookTestStatusSucceeded,
+			ExpectWarning:      false,
+		},
+		{
+			Name:               "test fails",
+			TestStatusCode:     http.StatusUnprocessableEntity,
+			TestResponse:       `{"message": "Hook execution failed: Failed to open TCP connection"}`,
+			ExpectedTestStatus: hookTestStatusFailed,
+			ExpectWarning:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			testRequests := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/projects/42/hooks", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"id": 1, "project_id": 42, "url": "https://example.com/hook"}`))
+			})
+			mux.HandleFunc("/api/v4/projects/42/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id": 1, "project_id": 42, "url": "https://example.com/hook"}`))
+			})
+			mux.HandleFunc("/api/v4/projects/42/hooks/1/test/push_events", func(w http.ResponseWriter, r *http.Request) {
+				testRequests++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.TestStatusCode)
+				_, _ = w.Write([]byte(tc.TestResponse))
+			})
+			client := newTestGitlabClient(t, mux)
+
+			d := schema.TestResourceDataRaw(t, gitlabProjectHookResourceSchema(), map[string]interface{}{
+				"project":        "42",
+				"url":            "https://example.com/hook",
+				"test_on_create": true,
+			})
+
+			diags := resourceGitlabProjectHookCreate(context.Background(), d, &ProviderMeta{Client: client})
+			if diags.HasError() {
+				t.Fatalf("expected no errors, got %v", diags)
+			}
+
+			if testRequests != 1 {
+				t.Fatalf("got %d test requests, expected 1", testRequests)
+			}
+			if status := d.Get("last_test_status").(string); status != tc.ExpectedTestStatus {
+				t.Fatalf("got last_test_status %q, expected %q", status, tc.ExpectedTestStatus)
+			}
+
+			hasWarning := len(diags) == 1 && diags[0].Severity == diag.Warning
+			if hasWarning != tc.ExpectWarning {
+				t.Fatalf("expected warning %t, got %v", tc.ExpectWarning, diags)
+			}
+		})
+	}
+}
+
+func TestGitlab_hookCreate_customHeaders(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Path     string
+		Schema   map[string]*schema.Schema
+		Raw      map[string]interface{}
+		Response string
+		Create   func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+	}{
+		{
+			Name:     "project hook",
+			Path:     "/api/v4/projects/42/hooks",
+			Schema:   gitlabProjectHookResourceSchema(),
+			Raw:      map[string]interface{}{"project": "42"},
+			Response: `{"id": 1, "project_id": 42, "url": "https://example.com/hook"}`,
+			Create:   resourceGitlabProjectHookCreate,
+		},
+		{
+			Name:     "group hook",
+			Path:     "/api/v4/groups/42/hooks",
+			Schema:   gitlabGroupHookResourceSchema(),
+			Raw:      map[string]interface{}{"group": "42"},
+			Response: `{"id": 1, "group_id": 42, "url": "https://example.com/hook"}`,
+			Create:   resourceGitlabGroupHookCreate,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var createRequest struct {
+				Token         string                     `json:"token"`
+				CustomHeaders []*gitlab.HookCustomHeader `json:"custom_headers"`
+			}
+			mux := http.NewServeMux()
+			mux.HandleFunc(tc.Path, func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createRequest); err != nil {
+					t.Errorf("failed to decode create request: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(tc.Response))
+			})
+			mux.HandleFunc(tc.Path+"/1", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.Response))
+			})
+			client := newTestGitlabClient(t, mux)
+
+			tc.Raw["url"] = "https://example.com/hook"
+			tc.Raw["token"] = "secret-token"
+			tc.Raw["custom_headers"] = map[string]interface{}{"X-Custom-Header": "value", "Authorization": "Bearer secret"}
+			d := schema.TestResourceDataRaw(t, tc.Schema, tc.Raw)
+
+			if diags := tc.Create(context.Background(), d, &ProviderMeta{Client: client}); diags.HasError() {
+				t.Fatalf("expected no errors, got %v", diags)
+			}
+
+			if createRequest.Token != "secret-token" {
+				t.Fatalf("got token %q in create request, expected %q", createRequest.Token, "secret-token")
+			}
+			expectedHeaders := []*gitlab.HookCustomHeader{
+				{Key: "Authorization", Value: "Bearer secret"},
+				{Key: "X-Custom-Header", Value: "value"},
+			}
+			if !reflect.DeepEqual(createRequest.CustomHeaders, expectedHeaders) {
+				t.Fatalf("got custom headers %v in create request, expected %v", createRequest.CustomHeaders, expectedHeaders)
+			}
+		})
+	}
+}
+
+func TestGitlab_changedHookCustomHeaders(t *testing.T) {
+	oldHeaders := map[string]interface{}{"X-Unchanged": "a", "X-Changed": "b", "X-Removed": "c"}
+	newHeaders := map[string]interface{}{"X-Unchanged": "a", "X-Changed": "B", "X-Added": "d"}
+
+	changed, removed := changedHookCustomHeaders(oldHeaders, newHeaders)
+
+	expectedChanged := map[string]string{"X-Changed": "B", "X-Added": "d"}
+	if !reflect.DeepEqual(changed, expectedChanged) {
+		t.Fatalf("got changed headers %v, expected %v", changed, expectedChanged)
+	}
+	if !reflect.DeepEqual(removed, []string{"X-Removed"}) {
+		t.Fatalf("got removed headers %v, expected %v", removed, []string{"X-Removed"})
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/xanzy/go-gitlab"
+)
+
+// maxPerPage is the maximum page size supported by the GitLab API.
+const maxPerPage = 100
+
+// withDefaultPerPage returns a request option which sets the `per_page` query parameter of list requests
+// which don't set a page size explicitly. It is installed for all requests of the client, so that
+// list calls only need to leave `gitlab.ListOptions.PerPage` unset to honor the provider-wide default.
+func withDefaultPerPage(perPage int) gitlab.RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		if req.Method != http.MethodGet {
+			return nil
+		}
+
+		query := req.URL.Query()
+		if query.Get("per_page") != "" {
+			return nil
+		}
+
+		query.Set("per_page", strconv.Itoa(perPage))
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestConfig_defaultPerPage(t *testing.T) {
+	var gotPerPage []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPerPage = append(gotPerPage, r.URL.Query().Get("per_page"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:          "glpat-test",
+		BaseURL:        server.URL,
+		DefaultPerPage: 100,
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	// A list request without a page size uses the default.
+	if _, _, err := client.Tags.ListTags("42", &gitlab.ListTagsOptions{ListOptions: gitlab.ListOptions{Page: 1}}); err != nil {
+		t.Fatalf("failed to list tags: %v", err)
+	}
+	// An explicit page size takes precedence.
+	if _, _, err := client.Tags.ListTags("42", &gitlab.ListTagsOptions{ListOptions: gitlab.ListOptions{Page: 1, PerPage: 5}}); err != nil {
+		t.Fatalf("failed to list tags: %v", err)
+	}
+	// Other requests are not changed.
+	if _, _, err := client.Tags.CreateTag("42", &gitlab.CreateTagOptions{TagName: gitlab.String("v1.0.0"), Ref: gitlab.String("main")}); err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	want := []string{"100", "5", ""}
+	if len(gotPerPage) != len(want) {
+		t.Fatalf("got per_page %q, expected %q", gotPerPage, want)
+	}
+	for i := range want {
+		if gotPerPage[i] != want[i] {
+			t.Fatalf("got per_page %q, expected %q", gotPerPage, want)
+		}
+	}
+}
@@ -2,11 +2,17 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var (
@@ -34,9 +40,20 @@ func New(version string) func() *schema.Provider {
 			Schema: map[string]*schema.Schema{
 				"token": {
 					Type:        schema.TypeString,
-					Required:    true,
-					DefaultFunc: schema.EnvDefaultFunc("GITLAB_TOKEN", nil),
-					Description: "The OAuth2 Token, Project, Group, Personal Access Token or CI Job Token used to connect to GitLab. The OAuth method is used in this provider for authentication (using Bearer authorization token). See https://docs.gitlab.com/ee/api/#authentication for details. It may be sourced from the `GITLAB_TOKEN` environment variable.",
+					Optional:    true,
+					Description: "The OAuth2 Token, Project, Group or Personal Access Token used to connect to GitLab. The OAuth method is used in this provider for authentication (using Bearer authorization token). See https://docs.gitlab.com/ee/api/#authentication for details. It may be sourced from the `GITLAB_TOKEN` environment variable. At most one of `token`, `oauth_token` and `job_token` may be set.",
+				},
+				"oauth_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "An OAuth2 access token, e.g. of a GitLab OAuth application, used to connect to GitLab as a Bearer token. It takes precedence over the `GITLAB_TOKEN` environment variable.",
+				},
+				"job_token": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "A CI job token, e.g. `CI_JOB_TOKEN` of a pipeline, used to connect to GitLab with the `JOB-TOKEN` header. Job tokens can only access a [limited set of API endpoints](https://docs.gitlab.com/ee/ci/jobs/ci_job_token.html) and the `early_auth_check` is skipped for them. It takes precedence over the `GITLAB_TOKEN` environment variable.",
 				},
 				"base_url": {
 					Type:        schema.TypeString,
@@ -54,7 +71,6 @@ func New(version string) func() *schema.Provider {
 				"cacert_file": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
 					Description: "This is a file containing the ca cert to verify the gitlab instance. This is available for use when working with GitLab CE or Gitlab Enterprise with a locally-issued or self-signed certificate chain.",
 				},
 				"insecure": {
@@ -63,17 +79,26 @@ func New(version string) func() *schema.Provider {
 					Default:     false,
 					Description: "When set to true this disables SSL verification of the connection to the GitLab instance.",
 				},
+				"ca_cert": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"cacert_file"},
+					Description:   "The PEM encoded CA certificate to verify the GitLab instance, or the path to a file containing it. Like `cacert_file`, but also accepts inline PEM data.",
+				},
 				"client_cert": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: "File path to client certificate when GitLab instance is behind company proxy. File must contain PEM encoded data.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					RequiredWith: []string{"client_key"},
+					Description:  "The PEM encoded client certificate for mutual TLS, e.g. when the GitLab instance is behind a company proxy, or the path to a file containing it. Required when `client_key` is set.",
 				},
 				"client_key": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: "File path to client key when GitLab instance is behind company proxy. File must contain PEM encoded data. Required when `client_cert` is set.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Sensitive:    true,
+					RequiredWith: []string{"client_cert"},
+					Description:  "The PEM encoded private key of the client certificate for mutual TLS, or the path to a file containing it. Required when `client_cert` is set.",
 				},
 				"early_auth_check": {
 					Type:        schema.TypeBool,
@@ -81,6 +106,57 @@ func New(version string) func() *schema.Provider {
 					Default:     true,
 					Description: "(Experimental) By default the provider does a dummy request to get the current user in order to verify that the provider configuration is correct and the GitLab API is reachable. Turn it off, to skip this check. This may be useful if the GitLab instance does not yet exist and is created within the same terraform module. This is an experimental feature and may change in the future. Please make sure to always keep backups of your state.",
 				},
+				"default_variable_masked": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "The default value of the `masked` attribute of the `gitlab_instance_variable`, `gitlab_project_variable` and `gitlab_group_variable` resources. The value set on a resource always takes precedence.",
+				},
+				"client_request_limit": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Delays requests once the remaining requests of the GitLab rate limit, as returned in the `RateLimit-Remaining` header, drop to this number, until the rate limit resets at the time of the `RateLimit-Reset` header. This avoids `429 Too Many Requests` responses when managing many resources. Defaults to `0`, which disables the delay.",
+				},
+				"default_per_page": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, maxPerPage),
+					Description:  fmt.Sprintf("The page size of list requests, e.g. to read data sources with many items in fewer requests. At most `%d`. Defaults to `0`, which uses the default page size of the GitLab API. List requests which need a specific page size are not affected.", maxPerPage),
+				},
+				"headers": {
+					Type:             schema.TypeMap,
+					Optional:         true,
+					Elem:             &schema.Schema{Type: schema.TypeString},
+					ValidateDiagFunc: validateProviderHeaders,
+					Description:      "Custom headers which are added to each request, e.g. to pass an API gateway. The `Authorization` header can't be set, because it carries the `token`. Headers which are set by the GitLab client itself are never overridden.",
+				},
+				"retry": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Customizes how requests which are rejected by GitLab with `429 Too Many Requests` or a server error are retried. The delay between attempts grows exponentially from `base_delay` and honors the `Retry-After` header returned by GitLab. When not set, the default retry behavior of the GitLab client is used.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"max_attempts": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      5,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The maximum number of attempts for a single request, including the initial one. Defaults to `5`.",
+							},
+							"base_delay": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          "1s",
+								ValidateDiagFunc: validateDuration,
+								Description:      "The delay before the first retry, as a Go duration string, e.g. `500ms` or `2s`. Defaults to `1s`.",
+							},
+						},
+					},
+				},
 			},
 
 			DataSourcesMap: resourceFactoriesToMap(allDataSources),
@@ -95,14 +171,39 @@ func New(version string) func() *schema.Provider {
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		token, oauthToken, jobToken, err := providerTokens(d)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
 		config := Config{
-			Token:         d.Get("token").(string),
-			BaseURL:       d.Get("base_url").(string),
-			CACertFile:    d.Get("cacert_file").(string),
-			Insecure:      d.Get("insecure").(bool),
-			ClientCert:    d.Get("client_cert").(string),
-			ClientKey:     d.Get("client_key").(string),
-			EarlyAuthFail: d.Get("early_auth_check").(bool),
+			Token:          token,
+			OAuthToken:     oauthToken,
+			JobToken:       jobToken,
+			BaseURL:        d.Get("base_url").(string),
+			CACertFile:     d.Get("cacert_file").(string),
+			CACert:         d.Get("ca_cert").(string),
+			Insecure:       d.Get("insecure").(bool),
+			ClientCert:     d.Get("client_cert").(string),
+			ClientKey:      d.Get("client_key").(string),
+			EarlyAuthFail:  d.Get("early_auth_check").(bool),
+			RequestLimit:   d.Get("client_request_limit").(int),
+			DefaultPerPage: d.Get("default_per_page").(int),
+		}
+
+		if v, ok := d.GetOk("headers"); ok {
+			config.Headers = make(map[string]string)
+			for key, value := range v.(map[string]interface{}) {
+				config.Headers[key] = value.(string)
+			}
+		}
+
+		if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+			retry := v.([]interface{})[0].(map[string]interface{})
+			// The base delay has already been validated by the schema.
+			baseDelay, _ := time.ParseDuration(retry["base_delay"].(string))
+			config.RetryMaxAttempts = retry["max_attempts"].(int)
+			config.RetryBaseDelay = baseDelay
 		}
 
 		client, err := config.Client(ctx)
@@ -113,10 +214,48 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		userAgent := p.UserAgent("terraform-provider-gitlab", version)
 		client.UserAgent = userAgent
 
-		return client, nil
+		meta := &ProviderMeta{
+			Client: client,
+			Settings: ProviderSettings{
+				DefaultVariableMasked: d.Get("default_variable_masked").(bool),
+			},
+		}
+
+		// Detect the version once the credentials are verified, it is cached for the version gated behavior of resources.
+		if config.EarlyAuthFail && config.JobToken == "" {
+			if _, err := meta.gitLabVersion(ctx); err != nil {
+				log.Printf("[DEBUG] failed to read the GitLab version: %v", err)
+			}
+		}
+
+		return meta, nil
 	}
 }
 
+// providerTokens returns the `token`, `oauth_token` and `job_token` of the provider configuration.
+// At most one of them may be configured, the `GITLAB_TOKEN` environment variable is only used as `token`
+// if none of them is configured.
+func providerTokens(d *schema.ResourceData) (string, string, string, error) {
+	token := d.Get("token").(string)
+	oauthToken := d.Get("oauth_token").(string)
+	jobToken := d.Get("job_token").(string)
+
+	configured := 0
+	for _, v := range []string{token, oauthToken, jobToken} {
+		if v != "" {
+			configured++
+		}
+	}
+	if configured > 1 {
+		return "", "", "", errors.New("only one of `token`, `oauth_token` and `job_token` can be configured")
+	}
+	if configured == 0 {
+		token = os.Getenv("GITLAB_TOKEN")
+	}
+
+	return token, oauthToken, jobToken, nil
+}
+
 func makeRegisterResourceFunc(factories map[string]func() *schema.Resource, resourceType string) func(name string, fn func() *schema.Resource) interface{} {
 	// lintignore: R009 // panic() during package initialization is ok
 	return func(name string, fn func() *schema.Resource) interface{} {
@@ -148,3 +287,45 @@ func resourceFactoriesToMap(factories map[string]func() *schema.Resource) map[st
 
 	return resourcesMap
 }
+
+func validateDuration(i interface{}, p cty.Path) diag.Diagnostics {
+	v := i.(string)
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("expected %s to be a valid duration, got %q: %v", attributeNameFromPath(p), v, err),
+			AttributePath: p,
+		}}
+	}
+	if d <= 0 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("expected %s to be a positive duration, got %q", attributeNameFromPath(p), v),
+			AttributePath: p,
+		}}
+	}
+
+	return nil
+}
+
+// attributeNameFromPath returns the name of the attribute the given path points to, e.g. `base_delay` for `retry.0.base_delay`.
+func attributeNameFromPath(p cty.Path) string {
+	for i := len(p) - 1; i >= 0; i-- {
+		if step, ok := p[i].(cty.GetAttrStep); ok {
+			return step.Name
+		}
+	}
+	return "value"
+}
+
+func validateProviderHeaders(i interface{}, p cty.Path) diag.Diagnostics {
+	for key := range i.(map[string]interface{}) {
+		if strings.EqualFold(key, "Authorization") {
+			return diag.Errorf("the %q header can't be set, configure the `token` instead", key)
+		}
+	}
+
+	return nil
+}
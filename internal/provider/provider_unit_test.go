@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProvider_prepareProviderConfig(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Env           map[string]string
+		Config        map[string]cty.Value
+		ExpectedError string
+	}{
+		{
+			Name:   "only token",
+			Config: map[string]cty.Value{"token": cty.StringVal("glpat-test")},
+		},
+		{
+			Name:   "GITLAB_TOKEN and job_token",
+			Env:    map[string]string{"GITLAB_TOKEN": "glpat-from-env"},
+			Config: map[string]cty.Value{"job_token": cty.StringVal("job-test")},
+		},
+		{
+			Name:   "GITLAB_TOKEN and oauth_token",
+			Env:    map[string]string{"GITLAB_TOKEN": "glpat-from-env"},
+			Config: map[string]cty.Value{"oauth_token": cty.StringVal("oauth-test")},
+		},
+		{
+			Name: "ca_cert",
+			Config: map[string]cty.Value{
+				"token":   cty.StringVal("glpat-test"),
+				"ca_cert": cty.StringVal("/path/to/ca.pem"),
+			},
+		},
+		{
+			Name: "cacert_file",
+			Config: map[string]cty.Value{
+				"token":       cty.StringVal("glpat-test"),
+				"cacert_file": cty.StringVal("/path/to/ca.pem"),
+			},
+		},
+		{
+			Name: "ca_cert and cacert_file",
+			Config: map[string]cty.Value{
+				"token":       cty.StringVal("glpat-test"),
+				"ca_cert":     cty.StringVal("/path/to/ca.pem"),
+				"cacert_file": cty.StringVal("/path/to/ca.pem"),
+			},
+			ExpectedError: `"ca_cert": conflicts with cacert_file`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for k, v := range tc.Env {
+				t.Setenv(k, v)
+			}
+
+			err := testPrepareProviderConfig(t, tc.Config)
+			if tc.ExpectedError == "" {
+				if err != "" {
+					t.Fatalf("expected no error, got %s", err)
+				}
+			} else if !strings.Contains(err, tc.ExpectedError) {
+				t.Fatalf("expected error containing %q, got %q", tc.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestProvider_providerTokens(t *testing.T) {
+	cases := []struct {
+		Name               string
+		Env                string
+		Config             map[string]interface{}
+		ExpectedToken      string
+		ExpectedOAuthToken string
+		ExpectedJobToken   string
+		ExpectedError      bool
+	}{
+		{
+			Name:          "token",
+			Config:        map[string]interface{}{"token": "glpat-test"},
+			ExpectedToken: "glpat-test",
+		},
+		{
+			Name:          "token from GITLAB_TOKEN",
+			Env:           "glpat-from-env",
+			Config:        map[string]interface{}{},
+			ExpectedToken: "glpat-from-env",
+		},
+		{
+			Name:          "token takes precedence over GITLAB_TOKEN",
+			Env:           "glpat-from-env",
+			Config:        map[string]interface{}{"token": "glpat-test"},
+			ExpectedToken: "glpat-test",
+		},
+		{
+			Name:               "oauth_token takes precedence over GITLAB_TOKEN",
+			Env:                "glpat-from-env",
+			Config:             map[string]interface{}{"oauth_token": "oauth-test"},
+			ExpectedOAuthToken: "oauth-test",
+		},
+		{
+			Name:             "job_token takes precedence over GITLAB_TOKEN",
+			Env:              "glpat-from-env",
+			Config:           map[string]interface{}{"job_token": "job-test"},
+			ExpectedJobToken: "job-test",
+		},
+		{
+			Name:          "token and job_token",
+			Config:        map[string]interface{}{"token": "glpat-test", "job_token": "job-test"},
+			ExpectedError: true,
+		},
+		{
+			Name:          "oauth_token and job_token",
+			Config:        map[string]interface{}{"oauth_token": "oauth-test", "job_token": "job-test"},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Setenv("GITLAB_TOKEN", tc.Env)
+
+			d := schema.TestResourceDataRaw(t, New("dev")().Schema, tc.Config)
+			token, oauthToken, jobToken, err := providerTokens(d)
+			if tc.ExpectedError {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if token != tc.ExpectedToken || oauthToken != tc.ExpectedOAuthToken || jobToken != tc.ExpectedJobToken {
+				t.Fatalf("expected tokens (%q, %q, %q), got (%q, %q, %q)", tc.ExpectedToken, tc.ExpectedOAuthToken, tc.ExpectedJobToken, token, oauthToken, jobToken)
+			}
+		})
+	}
+}
+
+func TestProvider_configure(t *testing.T) {
+	versionRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/user", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "username": "root"}`))
+	})
+	mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+		versionRequests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := New("dev")()
+	d := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+		"token":                   "glpat-test",
+		"base_url":                server.URL,
+		"default_variable_masked": true,
+	})
+
+	meta, diags := configure("dev", provider)(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("failed to configure the provider: %v", diags)
+	}
+
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		t.Fatalf("expected the meta to be a *ProviderMeta, got %T", meta)
+	}
+	if providerMeta.Client == nil {
+		t.Fatal("expected the meta to hold a client")
+	}
+	if !providerMeta.Settings.DefaultVariableMasked {
+		t.Fatal("expected the default_variable_masked setting to be true")
+	}
+
+	// The version is detected once while configuring the provider and cached for the resources.
+	if _, err := providerMeta.supportsFeature(context.Background(), "15.0"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if versionRequests != 1 {
+		t.Fatalf("got %d version requests, expected 1", versionRequests)
+	}
+}
+
+func TestProvider_validateDuration(t *testing.T) {
+	path := cty.GetAttrPath("retry").IndexInt(0).GetAttr("base_delay")
+
+	cases := []struct {
+		Value         string
+		ExpectedError string
+	}{
+		{Value: "500ms"},
+		{Value: "2s"},
+		{Value: "soon", ExpectedError: `expected base_delay to be a valid duration, got "soon"`},
+		{Value: "0s", ExpectedError: `expected base_delay to be a positive duration, got "0s"`},
+		{Value: "-1s", ExpectedError: `expected base_delay to be a positive duration, got "-1s"`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Value, func(t *testing.T) {
+			diags := validateDuration(tc.Value, path)
+			if tc.ExpectedError == "" {
+				if diags.HasError() {
+					t.Fatalf("expected no error, got %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || !strings.HasPrefix(diags[0].Summary, tc.ExpectedError) {
+				t.Fatalf("expected error starting with %q, got %v", tc.ExpectedError, diags)
+			}
+			if !diags[0].AttributePath.Equals(path) {
+				t.Fatalf("expected the error for attribute path %#v, got %#v", path, diags[0].AttributePath)
+			}
+		})
+	}
+}
+
+// testPrepareProviderConfig validates the given provider configuration like Terraform does, including the defaults
+// of the provider schema, and returns the joined error summaries.
+func testPrepareProviderConfig(t *testing.T, config map[string]cty.Value) string {
+	t.Helper()
+
+	provider := New("dev")()
+	configType := schema.InternalMap(provider.Schema).CoreConfigSchema().ImpliedType()
+	attributes := map[string]cty.Value{}
+	for name, attributeType := range configType.AttributeTypes() {
+		attributes[name] = cty.NullVal(attributeType)
+	}
+	for name, value := range config {
+		attributes[name] = value
+	}
+
+	rawConfig, err := msgpack.Marshal(cty.ObjectVal(attributes), configType)
+	if err != nil {
+		t.Fatalf("failed to encode provider config: %v", err)
+	}
+
+	resp, err := schema.NewGRPCProviderServer(provider).PrepareProviderConfig(context.Background(), &tfprotov5.PrepareProviderConfigRequest{
+		Config: &tfprotov5.DynamicValue{MsgPack: rawConfig},
+	})
+	if err != nil {
+		t.Fatalf("failed to prepare provider config: %v", err)
+	}
+
+	var errs []string
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, d.Summary+": "+d.Detail)
+		}
+	}
+	return strings.Join(errs, "; ")
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitMaxDelay caps the delay of a single request, GitLab rate limits reset at least every minute.
+const rateLimitMaxDelay = time.Minute
+
+// rateLimitTransport is a http.RoundTripper which keeps track of the `RateLimit-Remaining` and
+// `RateLimit-Reset` headers returned by GitLab and delays requests until the rate limit resets
+// once the remaining requests drop to the configured limit, instead of running into `429 Too Many Requests`.
+type rateLimitTransport struct {
+	transport http.RoundTripper
+	// limit is the number of remaining requests at which requests are delayed.
+	limit int
+
+	// now and sleep are replaced in tests.
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+
+	mu        sync.Mutex
+	remaining int
+	reset     time.Time
+}
+
+func newRateLimitTransport(transport http.RoundTripper, limit int) *rateLimitTransport {
+	return &rateLimitTransport{
+		transport: transport,
+		limit:     limit,
+		now:       time.Now,
+		sleep:     sleepWithContext,
+		remaining: -1,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if delay := t.delay(); delay > 0 {
+		log.Printf("[DEBUG] GitLab rate limit almost exhausted, delaying request for %s", delay)
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.update(resp.Header)
+	return resp, nil
+}
+
+// delay returns how long the next request has to wait for the rate limit to reset.
+// All requests are delayed until the reset, including concurrent ones, the first
+// response of the new window then reports its remaining requests.
+func (t *rateLimitTransport) delay() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.remaining < 0 || t.remaining > t.limit {
+		return 0
+	}
+
+	delay := t.reset.Sub(t.now())
+	if delay <= 0 {
+		return 0
+	}
+	if delay > rateLimitMaxDelay {
+		return rateLimitMaxDelay
+	}
+	return delay
+}
+
+func (t *rateLimitTransport) update(header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get("RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// Responses of concurrent requests may arrive out of order, don't go back to an older window.
+	if resetAt := time.Unix(reset, 0); !resetAt.Before(t.reset) {
+		t.remaining = remaining
+		t.reset = resetAt
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
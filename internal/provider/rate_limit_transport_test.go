@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRateLimitTransport_delaysRequests(t *testing.T) {
+	now := time.Date(2023, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name           string
+		Remaining      int
+		Reset          time.Time
+		ExpectedDelays []time.Duration
+	}{
+		{
+			Name:           "remaining requests above the limit",
+			Remaining:      50,
+			Reset:          now.Add(30 * time.Second),
+			ExpectedDelays: nil,
+		},
+		{
+			Name:           "remaining requests at the limit",
+			Remaining:      10,
+			Reset:          now.Add(30 * time.Second),
+			ExpectedDelays: []time.Duration{30 * time.Second, 30 * time.Second},
+		},
+		{
+			Name:           "rate limit already reset",
+			Remaining:      0,
+			Reset:          now.Add(-time.Second),
+			ExpectedDelays: nil,
+		},
+		{
+			Name:           "reset too far in the future",
+			Remaining:      0,
+			Reset:          now.Add(time.Hour),
+			ExpectedDelays: []time.Duration{rateLimitMaxDelay, rateLimitMaxDelay},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("RateLimit-Remaining", strconv.Itoa(tc.Remaining))
+				w.Header().Set("RateLimit-Reset", strconv.FormatInt(tc.Reset.Unix(), 10))
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			var delays []time.Duration
+			transport := newRateLimitTransport(http.DefaultTransport, 10)
+			transport.now = func() time.Time { return now }
+			transport.sleep = func(_ context.Context, d time.Duration) error {
+				delays = append(delays, d)
+				return nil
+			}
+			client := &http.Client{Transport: transport}
+
+			for i := 0; i < 3; i++ {
+				resp, err := client.Get(server.URL)
+				if err != nil {
+					t.Fatalf("request %d failed: %v", i, err)
+				}
+				resp.Body.Close()
+			}
+
+			if len(delays) != len(tc.ExpectedDelays) {
+				t.Fatalf("got delays %v, expected %v", delays, tc.ExpectedDelays)
+			}
+			for i := range delays {
+				if delays[i] != tc.ExpectedDelays[i] {
+					t.Fatalf("got delays %v, expected %v", delays, tc.ExpectedDelays)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimitTransport_delaysConcurrentRequests(t *testing.T) {
+	now := time.Date(2023, time.March, 10, 12, 0, 0, 0, time.UTC)
+	reset := now.Add(30 * time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "0")
+		w.Header().Set("RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var delays []time.Duration
+	transport := newRateLimitTransport(http.DefaultTransport, 10)
+	transport.now = func() time.Time { return now }
+	transport.sleep = func(_ context.Context, d time.Duration) error {
+		mu.Lock()
+		defer mu.Unlock()
+		delays = append(delays, d)
+		return nil
+	}
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("first request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	const concurrentRequests = 5
+	var wg sync.WaitGroup
+	for i := 0; i < concurrentRequests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("request failed: %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if len(delays) != concurrentRequests {
+		t.Fatalf("got %d delayed requests, expected all %d concurrent requests to be delayed", len(delays), concurrentRequests)
+	}
+	for _, delay := range delays {
+		if delay != 30*time.Second {
+			t.Fatalf("got delays %v, expected all to wait for the reset", delays)
+		}
+	}
+}
+
+func TestConfig_requestLimitDelaysRequests(t *testing.T) {
+	reset := time.Now().Add(2 * time.Second).Unix()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "1")
+		w.Header().Set("RateLimit-Reset", strconv.FormatInt(reset, 10))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:        "glpat-test",
+		BaseURL:      server.URL,
+		RequestLimit: 1,
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if _, _, err := client.Version.GetVersion(); err != nil {
+		t.Fatalf("first request failed: %v", err)
+	}
+
+	start := time.Now()
+	if _, _, err := client.Version.GetVersion(); err != nil {
+		t.Fatalf("second request failed: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("second request took %s, expected it to be delayed until the rate limit resets", elapsed)
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validApplicationScopes = []string{
+	"api",
+	"read_api",
+	"read_user",
+	"read_repository",
+	"write_repository",
+	"read_registry",
+	"write_registry",
+	"sudo",
+	"admin_mode",
+	"openid",
+	"profile",
+	"email",
+	"create_runner",
+	"k8s_proxy",
+}
+
+var _ = registerResource("gitlab_application", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_application`" + ` resource allows to manage the lifecycle of an instance-wide OAuth application.
+
+-> This resource requires administration privileges.
+
+-> The ` + "`secret`" + ` and the ` + "`scopes`" + ` of an application cannot be read from the GitLab API. Therefore, they are not available for imported resources
+   and changes of the ` + "`scopes`" + ` are ignored until the application is recreated.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/applications.html)`,
+
+		CreateContext: resourceGitlabApplicationCreate,
+		ReadContext:   resourceGitlabApplicationRead,
+		DeleteContext: resourceGitlabApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"redirect_uri": {
+				Description: "The URL GitLab redirects to after the user authorized the application.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scopes": {
+				Description: fmt.Sprintf("The scopes of the application. Valid values are: %s.", renderValueListForDocs(validApplicationScopes)),
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(validApplicationScopes, false),
+				},
+				// NOTE: the scopes cannot be read, thus they are unknown for imported applications
+				//       and replacing the application because of them would rotate its secret.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					oldScopes, _ := d.GetChange("scopes")
+					return d.Id() != "" && oldScopes.(*schema.Set).Len() == 0
+				},
+			},
+			"confidential": {
+				Description: "The application is used where the client secret can be kept confidential. Native mobile apps and Single Page Apps are considered non-confidential.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+			"application_id": {
+				Description: "The OAuth application ID (client ID) of the application.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret": {
+				Description: "The OAuth secret of the application. This is only populated when creating a new application. This attribute is not available for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+})
+
+func resourceGitlabApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	options := &gitlab.CreateApplicationOptions{
+		Name:         gitlab.String(d.Get("name").(string)),
+		RedirectURI:  gitlab.String(d.Get("redirect_uri").(string)),
+		Scopes:       gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("scopes").(*schema.Set)), " ")),
+		Confidential: gitlab.Bool(d.Get("confidential").(bool)),
+	}
+
+	log.Printf("[DEBUG] create gitlab application %s", *options.Name)
+	application, _, err := client.Applications.CreateApplication(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create application %s: %s", *options.Name, err)
+	}
+
+	d.SetId(strconv.Itoa(application.ID))
+	// NOTE: the secret can only be read once after creating the application
+	d.Set("secret", application.Secret)
+
+	return resourceGitlabApplicationRead(ctx, d, meta)
+}
+
+func resourceGitlabApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse application id %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] read gitlab application %d", applicationID)
+	application, err := resourceGitlabApplicationFind(ctx, client, applicationID)
+	if err != nil {
+		return diag.Errorf("failed to read application %d: %s", applicationID, err)
+	}
+	if application == nil {
+		log.Printf("[DEBUG] gitlab application %d not found, removing from state", applicationID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", application.ApplicationName)
+	d.Set("redirect_uri", application.CallbackURL)
+	d.Set("confidential", application.Confidential)
+	d.Set("application_id", application.ApplicationID)
+
+	return nil
+}
+
+func resourceGitlabApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	applicationID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("unable to parse application id %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab application %d", applicationID)
+	if _, err := client.Applications.DeleteApplication(applicationID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to delete application %d: %s", applicationID, err)
+	}
+
+	return nil
+}
+
+// resourceGitlabApplicationFind returns the application with the given ID, or nil if it doesn't exist.
+// The applications API has no endpoint to get a single application, thus all applications are listed.
+func resourceGitlabApplicationFind(ctx context.Context, client *gitlab.Client, applicationID int) (*gitlab.Application, error) {
+	options := &gitlab.ListApplicationsOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+
+	for options.Page != 0 {
+		applications, resp, err := client.Applications.ListApplications(options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range applications {
+			if application.ID == applicationID {
+				return application, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
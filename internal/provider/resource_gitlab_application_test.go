@@ -0,0 +1,73 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabApplication_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("acctest")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabApplicationDestroy,
+		Steps: []resource.TestStep{
+			// Create an application
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_application" "this" {
+					name         = "%s"
+					redirect_uri = "https://example.com/oauth/callback"
+					scopes       = ["openid", "read_user"]
+					confidential = false
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "application_id"),
+					resource.TestCheckResourceAttrSet("gitlab_application.this", "secret"),
+					resource.TestCheckResourceAttr("gitlab_application.this", "redirect_uri", "https://example.com/oauth/callback"),
+					resource.TestCheckResourceAttr("gitlab_application.this", "confidential", "false"),
+				),
+			},
+			// Verify upstream resource with an import.
+			{
+				ResourceName:      "gitlab_application.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The secret is only known during creating and the scopes cannot be read.
+				ImportStateVerifyIgnore: []string{"secret", "scopes"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabApplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_application" {
+			continue
+		}
+
+		applicationID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		application, err := resourceGitlabApplicationFind(context.Background(), testGitlabClient, applicationID)
+		if err != nil {
+			return err
+		}
+		if application != nil {
+			return fmt.Errorf("application %d still exists", applicationID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGitlab_resourceGitlabApplicationDiff_scopes(t *testing.T) {
+	cases := []struct {
+		Name                string
+		StateScopes         []string
+		ExpectedRequiresNew bool
+	}{
+		{
+			Name:        "imported application without scopes",
+			StateScopes: nil,
+		},
+		{
+			Name:        "same scopes",
+			StateScopes: []string{"openid", "read_user"},
+		},
+		{
+			Name:                "changed scopes",
+			StateScopes:         []string{"openid"},
+			ExpectedRequiresNew: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := allResources["gitlab_application"]()
+			coreSchema := r.CoreConfigSchema()
+			attributes := map[string]cty.Value{}
+			for name, attribute := range coreSchema.Attributes {
+				attributes[name] = cty.NullVal(attribute.Type)
+			}
+			attributes["name"] = cty.StringVal("app")
+			attributes["redirect_uri"] = cty.StringVal("https://example.com/oauth/callback")
+			attributes["scopes"] = cty.SetVal([]cty.Value{cty.StringVal("openid"), cty.StringVal("read_user")})
+			attributes["confidential"] = cty.True
+			rawConfig := cty.ObjectVal(attributes)
+
+			state := &terraform.InstanceState{
+				ID: "1",
+				Attributes: map[string]string{
+					"id":             "1",
+					"name":           "app",
+					"redirect_uri":   "https://example.com/oauth/callback",
+					"confidential":   "true",
+					"application_id": "abc",
+				},
+				RawConfig: rawConfig,
+			}
+			state.Attributes["scopes.#"] = strconv.Itoa(len(tc.StateScopes))
+			for _, scope := range tc.StateScopes {
+				state.Attributes[fmt.Sprintf("scopes.%d", schema.HashString(scope))] = scope
+			}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(rawConfig, coreSchema), nil)
+			if err != nil {
+				t.Fatalf("failed to diff: %v", err)
+			}
+
+			if requiresNew := diff != nil && diff.RequiresNew(); requiresNew != tc.ExpectedRequiresNew {
+				t.Fatalf("expected requires new %t, got %t: %v", tc.ExpectedRequiresNew, requiresNew, diff)
+			}
+		})
+	}
+}
@@ -150,7 +150,7 @@ var commitSchema = &schema.Resource{
 }
 
 func resourceGitlabBranchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 	ref := d.Get("ref").(string)
@@ -170,7 +170,7 @@ func resourceGitlabBranchCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitlabBranchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, name, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -182,7 +182,7 @@ func resourceGitlabBranchRead(ctx context.Context, d *schema.ResourceData, meta
 		if is404(err) {
 			log.Printf("[DEBUG] recieved 404 for gitlab branch %s, removing from state", name)
 			d.SetId("")
-			return diag.FromErr(err)
+			return nil
 		}
 		log.Printf("[DEBUG] failed to read gitlab branch %s response %v", name, resp)
 		return diag.FromErr(err)
@@ -205,14 +205,14 @@ func resourceGitlabBranchRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabBranchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, name, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] delete gitlab branch %s", name)
 	resp, err := client.Branches.DeleteBranch(project, name, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		log.Printf("[DEBUG] failed to delete gitlab branch %s response %v", name, resp)
 		return diag.FromErr(err)
 	}
@@ -52,6 +52,8 @@ var _ = registerResource("gitlab_branch_protection", func() *schema.Resource {
 
 ~> The ` + "`allowed_to_push`" + `, ` + "`allowed_to_merge`" + `, ` + "`allowed_to_unprotect`" + `, ` + "`unprotect_access_level`" + ` and ` + "`code_owner_approval_required`" + ` attributes require a GitLab Enterprise instance.
 
+-> The ` + "`branch`" + ` may be a [wildcard](https://docs.gitlab.com/ee/user/project/protected_branches.html#configure-multiple-protected-branches-by-using-a-wildcard), like ` + "`release/*`" + `, to protect all matching branches. The wildcard is kept as is in the state and the import ID.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/protected_branches.html)`,
 
 		CreateContext: resourceGitlabBranchProtectionCreate,
@@ -124,7 +126,7 @@ var _ = registerResource("gitlab_branch_protection", func() *schema.Resource {
 })
 
 func resourceGitlabBranchProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 
@@ -189,7 +191,7 @@ func resourceGitlabBranchProtectionCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabBranchProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, branch, err := projectAndBranchFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -200,9 +202,12 @@ func resourceGitlabBranchProtectionRead(ctx context.Context, d *schema.ResourceD
 	// Get protected branch by project ID/path and branch name
 	pb, _, err := client.ProtectedBranches.GetProtectedBranch(project, branch, gitlab.WithContext(ctx))
 	if err != nil {
-		log.Printf("[DEBUG] failed to read gitlab branch protection for project %s, branch %s: %s", project, branch, err)
-		d.SetId("")
-		return nil
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab branch protection for project %s, branch %s not found, removing from state", project, branch)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read gitlab branch protection for project %s, branch %s: %v", project, branch, err)
 	}
 
 	d.Set("project", project)
@@ -256,7 +261,7 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 	// NOTE: At the time of writing, the only value that does not force re-creation is code_owner_approval_required,
 	// so therefore that is the only update that needs to be handled.
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 	codeOwnerApprovalRequired := d.Get("code_owner_approval_required").(bool)
@@ -270,7 +275,7 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 	if _, err := client.ProtectedBranches.RequireCodeOwnerApprovals(project, branch, options, gitlab.WithContext(ctx)); err != nil {
 		// The user might be running a version of GitLab that does not support this feature.
 		// We enhance the generic 404 error with a more informative message.
-		if errResponse, ok := err.(*gitlab.ErrorResponse); ok && errResponse.Response.StatusCode == 404 {
+		if is404(err) {
 			return diag.Errorf("feature unavailable: code owner approvals: %v", err)
 		}
 
@@ -281,14 +286,14 @@ func resourceGitlabBranchProtectionUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabBranchProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	branch := d.Get("branch").(string)
 
 	log.Printf("[DEBUG] Delete gitlab protected branch %s for project %s", branch, project)
 
 	_, err := client.ProtectedBranches.UnprotectRepositoryBranches(project, branch, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
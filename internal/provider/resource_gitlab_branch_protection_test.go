@@ -421,6 +421,67 @@ func TestAccGitlabBranchProtection_createForProjectDefaultBranch(t *testing.T) {
 	})
 }
 
+func TestAccGitlabBranchProtection_wildcardWithCodeOwnerApprovalAndGroup(t *testing.T) {
+	testAccCheckEE(t)
+
+	testProject := testAccCreateProject(t)
+	testGroup := testAccCreateGroups(t, 1)[0]
+	var protectedBranch gitlab.ProtectedBranch
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabBranchProtectionDestroy,
+		Steps: []resource.TestStep{
+			// Protect all release branches, require code owner approval and allow a group to merge
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_share_group" "this" {
+						project_id   = %[1]d
+						group_id     = %[2]d
+						group_access = "developer"
+					}
+
+					resource "gitlab_branch_protection" "this" {
+						project                      = "%[1]d"
+						branch                       = "release/*"
+						push_access_level            = "maintainer"
+						merge_access_level           = "maintainer"
+						code_owner_approval_required = true
+
+						allowed_to_merge {
+							group_id = %[2]d
+						}
+
+						depends_on = [gitlab_project_share_group.this]
+					}
+				`, testProject.ID, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabBranchProtectionExists("gitlab_branch_protection.this", &protectedBranch),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "id", fmt.Sprintf("%d:release/*", testProject.ID)),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "branch", "release/*"),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "code_owner_approval_required", "true"),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "allowed_to_merge.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_branch_protection.this", "allowed_to_merge.0.group_id", fmt.Sprintf("%d", testGroup.ID)),
+					testAccCheckGitlabBranchProtectionAttributes(&protectedBranch, &testAccGitlabBranchProtectionExpectedAttributes{
+						Name:                      "release/*",
+						PushAccessLevel:           accessLevelValueToName[gitlab.MaintainerPermissions],
+						MergeAccessLevel:          accessLevelValueToName[gitlab.MaintainerPermissions],
+						UnprotectAccessLevel:      accessLevelValueToName[gitlab.MaintainerPermissions],
+						GroupsAllowedToMerge:      []string{testGroup.FullPath},
+						CodeOwnerApprovalRequired: true,
+					}),
+				),
+			},
+			// Verify import with the wildcard branch name
+			{
+				ResourceName:      "gitlab_branch_protection.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabBranchProtectionPersistsInStateCorrectly(n string, pb *gitlab.ProtectedBranch) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -77,6 +77,50 @@ func TestAccGitlabBranch_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabBranch_fromMain(t *testing.T) {
+	project := testAccCreateProject(t)
+	branchName := acctest.RandomWithPrefix("acctest-branch")
+
+	mainBranch, _, err := testGitlabClient.Branches.GetBranch(project.ID, "main")
+	if err != nil {
+		t.Fatalf("failed to get main branch: %v", err)
+	}
+
+	config := fmt.Sprintf(`
+		resource "gitlab_branch" "this" {
+			project = "%d"
+			name    = "%s"
+			ref     = "main"
+		}
+	`, project.ID, branchName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabBranchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_branch.this", "commit.0.id", mainBranch.Commit.ID),
+					resource.TestCheckResourceAttr("gitlab_branch.this", "merged", "false"),
+					resource.TestCheckResourceAttr("gitlab_branch.this", "protected", "false"),
+					resource.TestCheckResourceAttrSet("gitlab_branch.this", "web_url"),
+				),
+			},
+			// Delete the branch outside of Terraform, it's recreated
+			{
+				PreConfig: func() {
+					if _, err := testGitlabClient.Branches.DeleteBranch(project.ID, branchName); err != nil {
+						t.Fatalf("failed to delete branch: %v", err)
+					}
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("gitlab_branch.this", "commit.0.id", mainBranch.Commit.ID),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabBranchCommit(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs := s.RootModule().Resources[fmt.Sprintf("gitlab_branch.%s", n)]
@@ -38,7 +38,7 @@ var _ = registerResource("gitlab_cluster_agent", func() *schema.Resource {
 })
 
 func resourceGitlabClusterAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.RegisterAgentOptions{
@@ -56,7 +56,7 @@ func resourceGitlabClusterAgentCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabClusterAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, agentID, err := resourceGitlabClusterAgentParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -81,14 +81,14 @@ func resourceGitlabClusterAgentRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabClusterAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, agentID, err := resourceGitlabClusterAgentParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] delete GitLab Agent for Kubernetes in project %s with id %d", project, agentID)
-	if _, err := client.ClusterAgents.DeleteAgent(project, agentID, gitlab.WithContext(ctx)); err != nil {
+	if _, err := client.ClusterAgents.DeleteAgent(project, agentID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -20,6 +20,8 @@ var _ = registerResource("gitlab_cluster_agent_token", func() *schema.Resource {
 
 -> Requires at least GitLab 15.0
 
+-> Destroying this resource revokes the token. A token which has been revoked outside of Terraform is recreated.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/cluster_agents.html#create-an-agent-token)`,
 
 		CreateContext: resourceGitlabClusterAgentTokenCreate,
@@ -34,7 +36,7 @@ var _ = registerResource("gitlab_cluster_agent_token", func() *schema.Resource {
 })
 
 func resourceGitlabClusterAgentTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	agentID := d.Get("agent_id").(int)
@@ -59,7 +61,7 @@ func resourceGitlabClusterAgentTokenCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabClusterAgentTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -75,6 +77,12 @@ func resourceGitlabClusterAgentTokenRead(ctx context.Context, d *schema.Resource
 		}
 		return diag.FromErr(err)
 	}
+	// NOTE: revoked tokens are still returned by the API, but cannot be used anymore.
+	if clusterAgentToken.Status == "revoked" {
+		log.Printf("[DEBUG] token for GitLab Agent for Kubernetes %d in project %s with id %d has been revoked, removing from state", agentID, project, tokenID)
+		d.SetId("")
+		return nil
+	}
 
 	stateMap := gitlabClusterAgentTokenToStateMap(project, clusterAgentToken)
 	if err = setStateMapInResourceData(stateMap, d); err != nil {
@@ -84,14 +92,14 @@ func resourceGitlabClusterAgentTokenRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabClusterAgentTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, agentID, tokenID, err := resourceGitlabClusterAgentTokenParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] delete token for GitLab Agent for Kubernetes %d in project %s with id %d", agentID, project, tokenID)
-	if _, err := client.ClusterAgents.RevokeAgentToken(project, agentID, tokenID, gitlab.WithContext(ctx)); err != nil {
+	if _, err := client.ClusterAgents.RevokeAgentToken(project, agentID, tokenID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
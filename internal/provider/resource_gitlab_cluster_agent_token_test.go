@@ -117,6 +117,60 @@ func TestAccGitlabClusterAgentToken_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabClusterAgentToken_revokedOutsideOfTerraform(t *testing.T) {
+	testAccRequiresAtLeast(t, "15.0")
+
+	testProject := testAccCreateProject(t)
+	var firstToken gitlab.AgentToken
+
+	config := fmt.Sprintf(`
+		resource "gitlab_cluster_agent" "this" {
+			project = "%d"
+			name    = "agent-1"
+		}
+
+		resource "gitlab_cluster_agent_token" "this" {
+			project  = gitlab_cluster_agent.this.project
+			agent_id = gitlab_cluster_agent.this.agent_id
+			name     = "agent-1-token"
+		}
+	`, testProject.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabClusterAgentTokenDestroy,
+		Steps: []resource.TestStep{
+			// Create an agent and a token for it
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceGitlabClusterAgentTokenGet("gitlab_cluster_agent_token.this", &firstToken),
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_token.this", "status", "active"),
+					resource.TestCheckResourceAttrSet("gitlab_cluster_agent_token.this", "token"),
+				),
+			},
+			// Revoke the token outside of Terraform, which recreates it
+			{
+				PreConfig: func() {
+					if _, err := testGitlabClient.ClusterAgents.RevokeAgentToken(testProject.ID, firstToken.AgentID, firstToken.ID); err != nil {
+						t.Fatalf("failed to revoke cluster agent token: %v", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_cluster_agent_token.this", "status", "active"),
+					resource.TestCheckResourceAttrWith("gitlab_cluster_agent_token.this", "token_id", func(value string) error {
+						if value == fmt.Sprintf("%d", firstToken.ID) {
+							return fmt.Errorf("expected the revoked token %d to be replaced", firstToken.ID)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceGitlabClusterAgentTokenGet(resourceName string, clusterAgentToken *gitlab.AgentToken) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
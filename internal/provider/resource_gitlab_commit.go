@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var validCommitActions = []string{"create", "delete", "move", "update", "chmod"}
+
+var _ = registerResource("gitlab_commit", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_commit`" + ` resource allows to create a commit with multiple file actions at once.
+
+-> A commit cannot be changed or removed once it has been created. Therefore, every change of the resource creates a new commit
+   and destroying the resource only removes it from the state.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/commits.html#create-a-commit-with-multiple-files-and-actions)`,
+
+		CreateContext: resourceGitlabCommitCreate,
+		ReadContext:   resourceGitlabCommitRead,
+		DeleteContext: resourceGitlabCommitDelete,
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or full path of the project.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"branch": {
+				Description: "Name of the branch to commit into. To create a new branch, also provide either `start_branch` or `start_sha`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"start_branch": {
+				Description:   "Name of the branch to start the new branch from.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_sha"},
+			},
+			"start_sha": {
+				Description:   "SHA of the commit to start the new branch from.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_branch"},
+			},
+			"commit_message": {
+				Description: "The commit message.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"author_email": {
+				Description: "Email of the commit author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"author_name": {
+				Description: "Name of the commit author.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"action": {
+				Description: "The file actions of the commit. They are applied in the given order.",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Description:      fmt.Sprintf("The action to perform. Valid values are: %s.", renderValueListForDocs(validCommitActions)),
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validCommitActions, false)),
+						},
+						"file_path": {
+							Description: "Full path to the file.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"previous_path": {
+							Description: "Original full path to the file being moved. Only considered for the `move` action.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"content": {
+							Description: "File content, required for the `create` and `update` actions. For the `move` action the content of the file is kept if no content is given.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"encoding": {
+							Description:      fmt.Sprintf("The encoding of the `content`. Valid values are: %s. Defaults to `text`.", renderValueListForDocs(validRepositoryFileEncodings)),
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validRepositoryFileEncodings, false)),
+						},
+						"execute_filemode": {
+							Description: "Enables or disables the execute flag on the file. Only considered for the `chmod` action.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"last_commit_id": {
+							Description: "Last known file commit ID. Only considered in `update`, `move` and `delete` actions.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"short_id": {
+				Description: "The short ID of the commit.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"title": {
+				Description: "The title of the commit.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"web_url": {
+				Description: "The URL of the commit in the GitLab UI.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"committed_date": {
+				Description: "The date of the commit.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabCommitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+
+	options := &gitlab.CreateCommitOptions{
+		Branch:        gitlab.String(d.Get("branch").(string)),
+		CommitMessage: gitlab.String(d.Get("commit_message").(string)),
+		Actions:       expandCommitActions(d.Get("action").([]interface{})),
+	}
+	if v, ok := d.GetOk("start_branch"); ok {
+		options.StartBranch = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("start_sha"); ok {
+		options.StartSHA = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("author_email"); ok {
+		options.AuthorEmail = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("author_name"); ok {
+		options.AuthorName = gitlab.String(v.(string))
+	}
+
+	// NOTE: the commits API suffers from the same concurrency issues as the repository files API.
+	if err := resourceGitlabRepositoryFileApiLock.lock(ctx); err != nil {
+		return diag.FromErr(err)
+	}
+	defer resourceGitlabRepositoryFileApiLock.unlock()
+
+	log.Printf("[DEBUG] create gitlab commit on branch %s of project %s", *options.Branch, project)
+	commit, _, err := client.Commits.CreateCommit(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create commit on branch %s of project %s: %s", *options.Branch, project, err)
+	}
+
+	d.SetId(commit.ID)
+	return resourceGitlabCommitRead(ctx, d, meta)
+}
+
+func resourceGitlabCommitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+
+	log.Printf("[DEBUG] read gitlab commit %s of project %s", d.Id(), project)
+	commit, _, err := client.Commits.GetCommit(project, d.Id(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab commit %s of project %s not found, removing from state", d.Id(), project)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read commit %s of project %s: %s", d.Id(), project, err)
+	}
+
+	d.Set("short_id", commit.ShortID)
+	d.Set("title", commit.Title)
+	d.Set("web_url", commit.WebURL)
+	if commit.CommittedDate != nil {
+		d.Set("committed_date", commit.CommittedDate.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceGitlabCommitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] gitlab commit %s cannot be deleted, only removing it from state", d.Id())
+	return nil
+}
+
+func expandCommitActions(actions []interface{}) []*gitlab.CommitActionOptions {
+	options := make([]*gitlab.CommitActionOptions, 0, len(actions))
+	for _, v := range actions {
+		action := v.(map[string]interface{})
+
+		option := &gitlab.CommitActionOptions{
+			Action:   gitlab.FileAction(gitlab.FileActionValue(action["action"].(string))),
+			FilePath: gitlab.String(action["file_path"].(string)),
+		}
+		if v := action["previous_path"].(string); v != "" {
+			option.PreviousPath = gitlab.String(v)
+		}
+		if v := action["content"].(string); v != "" {
+			option.Content = gitlab.String(v)
+		}
+		if v := action["encoding"].(string); v != "" {
+			option.Encoding = gitlab.String(v)
+		}
+		if v := action["last_commit_id"].(string); v != "" {
+			option.LastCommitID = gitlab.String(v)
+		}
+		if *option.Action == gitlab.FileChmod {
+			option.ExecuteFilemode = gitlab.Bool(action["execute_filemode"].(bool))
+		}
+		options = append(options, option)
+	}
+	return options
+}
@@ -0,0 +1,72 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabCommit_multipleFiles(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_commit" "this" {
+						project        = "%d"
+						branch         = "main"
+						commit_message = "Add two files"
+
+						action {
+							action    = "create"
+							file_path = "foo.txt"
+							content   = "foo"
+						}
+
+						action {
+							action    = "create"
+							file_path = "bar/baz.txt"
+							content   = "YmF6"
+							encoding  = "base64"
+						}
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_commit.this", "short_id"),
+					resource.TestCheckResourceAttrSet("gitlab_commit.this", "web_url"),
+					resource.TestCheckResourceAttr("gitlab_commit.this", "title", "Add two files"),
+					func(s *terraform.State) error {
+						commitID := s.RootModule().Resources["gitlab_commit.this"].Primary.ID
+
+						branch, _, err := testGitlabClient.Branches.GetBranch(testProject.ID, "main")
+						if err != nil {
+							return err
+						}
+						if branch.Commit.ID != commitID {
+							return fmt.Errorf("expected main to point to commit %s, got %s", commitID, branch.Commit.ID)
+						}
+
+						for path, expected := range map[string]string{"foo.txt": "foo", "bar/baz.txt": "baz"} {
+							content, _, err := testGitlabClient.RepositoryFiles.GetRawFile(testProject.ID, path, &gitlab.GetRawFileOptions{Ref: gitlab.String(commitID)})
+							if err != nil {
+								return fmt.Errorf("failed to get file %s: %w", path, err)
+							}
+							if string(content) != expected {
+								return fmt.Errorf("expected content of file %s to be %q, got %q", path, expected, string(content))
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
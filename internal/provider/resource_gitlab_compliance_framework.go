@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_compliance_framework", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_compliance_framework`" + ` resource allows to manage the lifecycle of a compliance framework on a top-level group.
+
+-> Compliance frameworks require GitLab Premium, a ` + "`pipeline_configuration_full_path`" + ` requires GitLab Ultimate.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationcreatecomplianceframework)`,
+
+		CreateContext: resourceGitlabComplianceFrameworkCreate,
+		ReadContext:   resourceGitlabComplianceFrameworkRead,
+		UpdateContext: resourceGitlabComplianceFrameworkUpdate,
+		DeleteContext: resourceGitlabComplianceFrameworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Description:  "The ID or full path of the top-level group to create the compliance framework in.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"framework_id": {
+				Description: "The global ID of the compliance framework, e.g. to assign it with `gitlab_project_compliance_framework`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "The name of the compliance framework.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"description": {
+				Description:  "The description of the compliance framework.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"color": {
+				Description:  "The color of the compliance framework label in 6-digit hex notation with leading '#' sign, e.g. `#87BEEF`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{6}$`), "must be a 6-digit hex color with leading '#'"),
+			},
+			"pipeline_configuration_full_path": {
+				Description: "The full path of the compliance pipeline configuration, which is run instead of the pipeline configuration of projects with this framework, e.g. `.compliance-gitlab-ci.yml@compliance/hipaa`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"default": {
+				Description: "Whether the compliance framework is the default framework of the group, which is assigned to new projects.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+})
+
+// gitlabComplianceFramework represents a compliance framework returned by the GraphQL API.
+type gitlabComplianceFramework struct {
+	ID                            string `json:"id"`
+	Name                          string `json:"name"`
+	Description                   string `json:"description"`
+	Color                         string `json:"color"`
+	PipelineConfigurationFullPath string `json:"pipelineConfigurationFullPath"`
+	Default                       bool   `json:"default"`
+}
+
+func resourceGitlabComplianceFrameworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	namespace := d.Get("namespace_id").(string)
+
+	group, _, err := client.Groups.GetGroup(namespace, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get group %q: %v", namespace, err)
+	}
+
+	params := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"color":       d.Get("color").(string),
+		"default":     d.Get("default").(bool),
+	}
+	if v, ok := d.GetOk("pipeline_configuration_full_path"); ok {
+		params["pipelineConfigurationFullPath"] = v.(string)
+	}
+
+	query := GraphQLQuery{
+		Query: `mutation($namespacePath: ID!, $params: ComplianceFrameworkInput!) {
+			createComplianceFramework(input: {namespacePath: $namespacePath, params: $params}) {
+				framework { id }
+				errors
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"namespacePath": group.FullPath,
+			"params":        params,
+		},
+	}
+
+	log.Printf("[DEBUG] create gitlab compliance framework %q in group %s", params["name"], namespace)
+	var response struct {
+		Data struct {
+			CreateComplianceFramework struct {
+				Framework struct {
+					ID string `json:"id"`
+				} `json:"framework"`
+				Errors []string `json:"errors"`
+			} `json:"createComplianceFramework"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return diag.Errorf("failed to create compliance framework in group %q: %v", namespace, err)
+	}
+	if errs := response.Data.CreateComplianceFramework.Errors; len(errs) > 0 {
+		return diag.Errorf("failed to create compliance framework in group %q: %s", namespace, strings.Join(errs, ", "))
+	}
+
+	frameworkID, err := extractIIDFromGlobalID(response.Data.CreateComplianceFramework.Framework.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resourceGitlabComplianceFrameworkBuildID(namespace, frameworkID))
+	return resourceGitlabComplianceFrameworkRead(ctx, d, meta)
+}
+
+func resourceGitlabComplianceFrameworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	namespace, frameworkID, err := resourceGitlabComplianceFrameworkParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab compliance framework %d in group %s", frameworkID, namespace)
+	framework, err := getGitlabComplianceFramework(ctx, client, namespace, frameworkID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing compliance framework %d from state", namespace, frameworkID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read compliance framework %d in group %q: %v", frameworkID, namespace, err)
+	}
+	if framework == nil {
+		log.Printf("[DEBUG] gitlab compliance framework %d in group %s not found, removing from state", frameworkID, namespace)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("namespace_id", namespace)
+	d.Set("framework_id", framework.ID)
+	d.Set("name", framework.Name)
+	d.Set("description", framework.Description)
+	d.Set("color", framework.Color)
+	d.Set("pipeline_configuration_full_path", framework.PipelineConfigurationFullPath)
+	d.Set("default", framework.Default)
+
+	return nil
+}
+
+func resourceGitlabComplianceFrameworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	params := map[string]interface{}{}
+	if d.HasChange("name") {
+		params["name"] = d.Get("name").(string)
+	}
+	if d.HasChange("description") {
+		params["description"] = d.Get("description").(string)
+	}
+	if d.HasChange("color") {
+		params["color"] = d.Get("color").(string)
+	}
+	if d.HasChange("pipeline_configuration_full_path") {
+		params["pipelineConfigurationFullPath"] = d.Get("pipeline_configuration_full_path").(string)
+	}
+	if d.HasChange("default") {
+		params["default"] = d.Get("default").(bool)
+	}
+
+	query := GraphQLQuery{
+		Query: `mutation($id: ComplianceManagementFrameworkID!, $params: ComplianceFrameworkInput!) {
+			updateComplianceFramework(input: {id: $id, params: $params}) { errors }
+		}`,
+		Variables: map[string]interface{}{
+			"id":     d.Get("framework_id").(string),
+			"params": params,
+		},
+	}
+
+	log.Printf("[DEBUG] update gitlab compliance framework %s", d.Id())
+	var response struct {
+		Data struct {
+			UpdateComplianceFramework struct {
+				Errors []string `json:"errors"`
+			} `json:"updateComplianceFramework"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return diag.Errorf("failed to update compliance framework %s: %v", d.Id(), err)
+	}
+	if errs := response.Data.UpdateComplianceFramework.Errors; len(errs) > 0 {
+		return diag.Errorf("failed to update compliance framework %s: %s", d.Id(), strings.Join(errs, ", "))
+	}
+
+	return resourceGitlabComplianceFrameworkRead(ctx, d, meta)
+}
+
+func resourceGitlabComplianceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	query := GraphQLQuery{
+		Query: `mutation($id: ComplianceManagementFrameworkID!) {
+			destroyComplianceFramework(input: {id: $id}) { errors }
+		}`,
+		Variables: map[string]interface{}{
+			"id": d.Get("framework_id").(string),
+		},
+	}
+
+	log.Printf("[DEBUG] delete gitlab compliance framework %s", d.Id())
+	var response struct {
+		Data struct {
+			DestroyComplianceFramework struct {
+				Errors []string `json:"errors"`
+			} `json:"destroyComplianceFramework"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return diag.Errorf("failed to delete compliance framework %s: %v", d.Id(), err)
+	}
+	if errs := response.Data.DestroyComplianceFramework.Errors; len(errs) > 0 {
+		return diag.Errorf("failed to delete compliance framework %s: %s", d.Id(), strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// getGitlabComplianceFramework returns the compliance framework with the given ID of the group,
+// or nil if the group has no such framework.
+func getGitlabComplianceFramework(ctx context.Context, client *gitlab.Client, namespace string, frameworkID int) (*gitlabComplianceFramework, error) {
+	// The group is looked up with the REST API first, because the GraphQL API only finds groups by full path.
+	group, _, err := client.Groups.GetGroup(namespace, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	query := GraphQLQuery{
+		Query: `query($fullPath: ID!, $id: ComplianceManagementFrameworkID!) {
+			namespace(fullPath: $fullPath) {
+				complianceFrameworks(id: $id) {
+					nodes { id name description color pipelineConfigurationFullPath default }
+				}
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"fullPath": group.FullPath,
+			"id":       fmt.Sprintf("gid://gitlab/ComplianceManagement::Framework/%d", frameworkID),
+		},
+	}
+
+	var response struct {
+		Data struct {
+			Namespace *struct {
+				ComplianceFrameworks struct {
+					Nodes []*gitlabComplianceFramework `json:"nodes"`
+				} `json:"complianceFrameworks"`
+			} `json:"namespace"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return nil, err
+	}
+	if response.Data.Namespace == nil {
+		return nil, errors.New("namespace not found")
+	}
+
+	for _, framework := range response.Data.Namespace.ComplianceFrameworks.Nodes {
+		if id, err := extractIIDFromGlobalID(framework.ID); err == nil && id == frameworkID {
+			return framework, nil
+		}
+	}
+	return nil, nil
+}
+
+func resourceGitlabComplianceFrameworkBuildID(namespace string, frameworkID int) string {
+	return fmt.Sprintf("%s:%d", namespace, frameworkID)
+}
+
+func resourceGitlabComplianceFrameworkParseID(id string) (string, int, error) {
+	namespace, rawFrameworkID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	frameworkID, err := strconv.Atoi(rawFrameworkID)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid compliance framework id %q, expected <group>:<framework id>", id)
+	}
+
+	return namespace, frameworkID, nil
+}
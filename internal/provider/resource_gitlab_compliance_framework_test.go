@@ -0,0 +1,94 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabComplianceFramework_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabComplianceFrameworkDestroy,
+		Steps: []resource.TestStep{
+			// Create a compliance framework with a pipeline configuration
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_compliance_framework" "this" {
+						namespace_id                     = "%s"
+						name                             = "HIPAA"
+						description                      = "Health Insurance Portability and Accountability Act"
+						color                            = "#87BEEF"
+						pipeline_configuration_full_path = ".hipaa.yml@%s/compliance"
+					}
+				`, testGroup.FullPath, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_compliance_framework.this", "framework_id"),
+					resource.TestCheckResourceAttr("gitlab_compliance_framework.this", "color", "#87BEEF"),
+					resource.TestCheckResourceAttr("gitlab_compliance_framework.this", "pipeline_configuration_full_path", fmt.Sprintf(".hipaa.yml@%s/compliance", testGroup.FullPath)),
+					resource.TestCheckResourceAttr("gitlab_compliance_framework.this", "default", "false"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_compliance_framework.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the color of the compliance framework
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_compliance_framework" "this" {
+						namespace_id                     = "%s"
+						name                             = "HIPAA"
+						description                      = "Health Insurance Portability and Accountability Act"
+						color                            = "#1AAA55"
+						pipeline_configuration_full_path = ".hipaa.yml@%s/compliance"
+					}
+				`, testGroup.FullPath, testGroup.FullPath),
+				Check: resource.TestCheckResourceAttr("gitlab_compliance_framework.this", "color", "#1AAA55"),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_compliance_framework.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabComplianceFrameworkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_compliance_framework" {
+			continue
+		}
+
+		namespace, frameworkID, err := resourceGitlabComplianceFrameworkParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		framework, err := getGitlabComplianceFramework(context.Background(), testGitlabClient, namespace, frameworkID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if framework != nil {
+			return fmt.Errorf("compliance framework %d in group %s still exists", frameworkID, namespace)
+		}
+	}
+	return nil
+}
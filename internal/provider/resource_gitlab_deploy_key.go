@@ -61,7 +61,7 @@ var _ = registerResource("gitlab_deploy_key", func() *schema.Resource {
 })
 
 func resourceGitlabDeployKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.AddDeployKeyOptions{
 		Title:   gitlab.String(d.Get("title").(string)),
@@ -82,7 +82,7 @@ func resourceGitlabDeployKeyCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabDeployKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	deployKeyID, err := strconv.Atoi(d.Id())
@@ -110,7 +110,7 @@ func resourceGitlabDeployKeyRead(ctx context.Context, d *schema.ResourceData, me
 }
 
 func resourceGitlabDeployKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	deployKeyID, err := strconv.Atoi(d.Id())
@@ -61,7 +61,7 @@ var _ = registerResource("gitlab_deploy_key_enable", func() *schema.Resource {
 })
 
 func resourceGitlabDeployKeyEnableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	key_id, err := strconv.Atoi(d.Get("key_id").(string))
@@ -79,7 +79,7 @@ func resourceGitlabDeployKeyEnableCreate(ctx context.Context, d *schema.Resource
 	options := &gitlab.UpdateDeployKeyOptions{
 		CanPush: gitlab.Bool(d.Get("can_push").(bool)),
 	}
-	_, _, err = client.DeployKeys.UpdateDeployKey(project, key_id, options)
+	_, _, err = client.DeployKeys.UpdateDeployKey(project, key_id, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -90,7 +90,7 @@ func resourceGitlabDeployKeyEnableCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabDeployKeyEnableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project, deployKeyID, err := resourceGitLabDeployKeyEnableParseId(d.Id())
 	if err != nil {
@@ -99,15 +99,20 @@ func resourceGitlabDeployKeyEnableRead(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[DEBUG] read gitlab deploy key %s/%d", project, deployKeyID)
 
-	deployKey, _, err := client.DeployKeys.GetDeployKey(project, deployKeyID, gitlab.WithContext(ctx))
+	deployKey, err := resourceGitlabDeployKeyEnableFindProjectDeployKey(ctx, client, project, deployKeyID)
 	if err != nil {
 		if is404(err) {
-			log.Printf("[DEBUG] gitlab deploy key not found %s/%d", project, deployKeyID)
+			log.Printf("[DEBUG] gitlab project %s not found, removing deploy key %d from state", project, deployKeyID)
 			d.SetId("")
 			return nil
 		}
 		return diag.FromErr(err)
 	}
+	if deployKey == nil {
+		log.Printf("[DEBUG] gitlab deploy key %d is not enabled for project %s, removing from state", deployKeyID, project)
+		d.SetId("")
+		return nil
+	}
 
 	d.Set("title", deployKey.Title)
 	d.Set("key_id", strconv.Itoa(deployKey.ID))
@@ -119,7 +124,7 @@ func resourceGitlabDeployKeyEnableRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabDeployKeyEnableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project, deployKeyID, err := resourceGitLabDeployKeyEnableParseId(d.Id())
 	if err != nil {
@@ -128,18 +133,36 @@ func resourceGitlabDeployKeyEnableDelete(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] Delete gitlab deploy key %s/%d", project, deployKeyID)
 
-	response, err := client.DeployKeys.DeleteDeployKey(project, deployKeyID, gitlab.WithContext(ctx))
-
-	if err != nil {
+	if _, err := client.DeployKeys.DeleteDeployKey(project, deployKeyID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
-	// HTTP 2XX is success including 204 with no body
-	if response != nil && response.StatusCode/100 == 2 {
-		return nil
+	return nil
+}
+
+// resourceGitlabDeployKeyEnableFindProjectDeployKey returns the deploy key with the given ID from the deploy keys
+// enabled for the project, or nil if the deploy key is not enabled for the project.
+func resourceGitlabDeployKeyEnableFindProjectDeployKey(ctx context.Context, client *gitlab.Client, project string, deployKeyID int) (*gitlab.ProjectDeployKey, error) {
+	options := &gitlab.ListProjectDeployKeysOptions{
+		PerPage: 100,
+		Page:    1,
 	}
 
-	return nil
+	for options.Page != 0 {
+		deployKeys, resp, err := client.DeployKeys.ListProjectDeployKeys(project, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deployKey := range deployKeys {
+			if deployKey.ID == deployKeyID {
+				return deployKey, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
 }
 
 func resourceGitLabDeployKeyEnableParseId(id string) (string, int, error) {
@@ -4,7 +4,9 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -36,6 +38,8 @@ func TestAccGitlabDeployKeyEnable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "key"),
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "title"),
+					resource.TestCheckResourceAttr("gitlab_deploy_key_enable.foo", "can_push", "false"),
+					testAccCheckGitlabDeployKeyEnabled(testProjectKeyShared.ID, parentProjectDeployKey.ID, false),
 				),
 			},
 			// Verify import
@@ -50,6 +54,8 @@ func TestAccGitlabDeployKeyEnable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "key"),
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "title"),
+					resource.TestCheckResourceAttr("gitlab_deploy_key_enable.foo", "can_push", "true"),
+					testAccCheckGitlabDeployKeyEnabled(testProjectKeyShared.ID, parentProjectDeployKey.ID, true),
 				),
 			},
 			// Verify import
@@ -64,6 +70,8 @@ func TestAccGitlabDeployKeyEnable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "key"),
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "title"),
+					resource.TestCheckResourceAttr("gitlab_deploy_key_enable.foo", "can_push", "false"),
+					testAccCheckGitlabDeployKeyEnabled(testProjectKeyShared.ID, parentProjectDeployKey.ID, false),
 				),
 			},
 			// Verify import
@@ -78,6 +86,8 @@ func TestAccGitlabDeployKeyEnable_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "key"),
 					resource.TestCheckResourceAttrSet("gitlab_deploy_key_enable.foo", "title"),
+					resource.TestCheckResourceAttr("gitlab_deploy_key_enable.foo", "can_push", "false"),
+					testAccCheckGitlabDeployKeyEnabled(testProjectKeyShared.ID, parentProjectDeployKey.ID, false),
 				),
 			},
 			// Verify import
@@ -90,6 +100,22 @@ func TestAccGitlabDeployKeyEnable_basic(t *testing.T) {
 	})
 }
 
+func testAccCheckGitlabDeployKeyEnabled(project int, deployKeyID int, canPush bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		deployKey, err := resourceGitlabDeployKeyEnableFindProjectDeployKey(context.Background(), testGitlabClient, strconv.Itoa(project), deployKeyID)
+		if err != nil {
+			return err
+		}
+		if deployKey == nil {
+			return fmt.Errorf("deploy key %d is not enabled for project %d", deployKeyID, project)
+		}
+		if deployKey.CanPush != canPush {
+			return fmt.Errorf("expected can_push of deploy key %d to be %t, got %t", deployKeyID, canPush, deployKey.CanPush)
+		}
+		return nil
+	}
+}
+
 func testAccCheckGitlabDeployKeyEnableDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		project, deployKeyID, err := resourceGitLabDeployKeyEnableParseId(rs.Primary.ID)
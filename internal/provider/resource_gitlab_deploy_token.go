@@ -102,7 +102,7 @@ func expiresAtSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 }
 
 func resourceGitlabDeployTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, isProject := d.GetOk("project")
 	group, isGroup := d.GetOk("group")
 
@@ -160,7 +160,7 @@ func resourceGitlabDeployTokenCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabDeployTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, isProject := d.GetOk("project")
 	group, isGroup := d.GetOk("group")
 	deployTokenID, err := strconv.Atoi(d.Id())
@@ -182,12 +182,16 @@ func resourceGitlabDeployTokenRead(ctx context.Context, d *schema.ResourceData,
 	if isProject {
 		log.Printf("[DEBUG] Read GitLab deploy token %d in project %s", deployTokenID, project.(string))
 		options := gitlab.ListProjectDeployTokensOptions{
-			Page:    1,
-			PerPage: 20,
+			Page: 1,
 		}
 		for options.Page != 0 && deployToken == nil {
 			paginatedDeployTokens, resp, err := client.DeployTokens.ListProjectDeployTokens(project, &options, gitlab.WithContext(ctx))
 			if err != nil {
+				if is404(err) {
+					log.Printf("[DEBUG] GitLab project %s of deploy token %d not found, removing from state", project.(string), deployTokenID)
+					d.SetId("")
+					return nil
+				}
 				return diag.FromErr(err)
 			}
 			deployToken = extractDeployToken(paginatedDeployTokens)
@@ -196,12 +200,16 @@ func resourceGitlabDeployTokenRead(ctx context.Context, d *schema.ResourceData,
 	} else if isGroup {
 		log.Printf("[DEBUG] Read GitLab deploy token %d in group %s", deployTokenID, group.(string))
 		options := gitlab.ListGroupDeployTokensOptions{
-			Page:    1,
-			PerPage: 20,
+			Page: 1,
 		}
 		for options.Page != 0 && deployToken == nil {
 			paginatedDeployTokens, resp, err := client.DeployTokens.ListGroupDeployTokens(group, &options, gitlab.WithContext(ctx))
 			if err != nil {
+				if is404(err) {
+					log.Printf("[DEBUG] GitLab group %s of deploy token %d not found, removing from state", group.(string), deployTokenID)
+					d.SetId("")
+					return nil
+				}
 				return diag.FromErr(err)
 			}
 			deployToken = extractDeployToken(paginatedDeployTokens)
@@ -230,7 +238,7 @@ func resourceGitlabDeployTokenRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabDeployTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, isProject := d.GetOk("project")
 	group, isGroup := d.GetOk("group")
 	deployTokenID, err := strconv.Atoi(d.Id())
@@ -249,6 +257,10 @@ func resourceGitlabDeployTokenDelete(ctx context.Context, d *schema.ResourceData
 		response, err = client.DeployTokens.DeleteGroupDeployToken(group, deployTokenID, gitlab.WithContext(ctx))
 	}
 	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] GitLab deploy token %d already deleted", deployTokenID)
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
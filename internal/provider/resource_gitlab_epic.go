@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_epic", func() *schema.Resource {
+	validEpicStates := []string{"opened", "closed"}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_epic`" + ` resource allows to manage the lifecycle of an epic within a group.
+
+-> Epics require a GitLab Premium or Ultimate license.
+
+-> The resource ID is composed of the group and the instance-wide ` + "`epic_id`" + `, whereas the epic API endpoints are addressed with the group-scoped ` + "`iid`" + `, which is exposed as a computed attribute.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/epics.html)`,
+
+		CreateContext: resourceGitlabEpicCreate,
+		ReadContext:   resourceGitlabEpicRead,
+		UpdateContext: resourceGitlabEpicUpdate,
+		DeleteContext: resourceGitlabEpicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group the epic belongs to.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"title": {
+				Description: "The title of the epic.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the epic.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"labels": {
+				Description: "The labels of the epic.",
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+			},
+			"start_date": {
+				Description:      "The fixed start date of the epic in the format YYYY-MM-DD. If not set, the start date is inherited from the milestones of the epic's issues.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isISO6801Date,
+			},
+			"due_date": {
+				Description:      "The fixed due date of the epic in the format YYYY-MM-DD. If not set, the due date is inherited from the milestones of the epic's issues.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isISO6801Date,
+			},
+			"confidential": {
+				Description: "Whether the epic is confidential.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			// NOTE: not part of `CREATE`, but part of `UPDATE` with the `state_event` field.
+			"state": {
+				Description:      fmt.Sprintf("The state of the epic. Valid values are: %s.", renderValueListForDocs(validEpicStates)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "opened",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validEpicStates, false)),
+			},
+			"epic_id": {
+				Description: "The instance-wide ID of the epic.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"iid": {
+				Description: "The internal ID of the epic within its group.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"group_id": {
+				Description: "The ID of the group the epic belongs to.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"author_id": {
+				Description: "The ID of the author of the epic.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"web_url": {
+				Description: "The web URL of the epic.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "The time of creation of the epic. Date time string, ISO 8601 formatted, for example 2016-03-11T03:45:40Z.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The last update time of the epic. Date time string, ISO 8601 formatted, for example 2016-03-11T03:45:40Z.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabEpicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Get("group").(string)
+
+	options := &gitlab.CreateEpicOptions{
+		Title:        gitlab.String(d.Get("title").(string)),
+		Confidential: gitlab.Bool(d.Get("confidential").(bool)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(description.(string))
+	}
+	if labels, ok := d.GetOk("labels"); ok {
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(labels.(*schema.Set)))
+		options.Labels = &gitlabLabels
+	}
+	if startDate, ok := d.GetOk("start_date"); ok {
+		parsedStartDate, err := parseISO8601Date(startDate.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse start_date: %s. %v", startDate.(string), err)
+		}
+		options.StartDateIsFixed = gitlab.Bool(true)
+		options.StartDateFixed = parsedStartDate
+	}
+	if dueDate, ok := d.GetOk("due_date"); ok {
+		parsedDueDate, err := parseISO8601Date(dueDate.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse due_date: %s. %v", dueDate.(string), err)
+		}
+		options.DueDateIsFixed = gitlab.Bool(true)
+		options.DueDateFixed = parsedDueDate
+	}
+
+	log.Printf("[DEBUG] create gitlab epic %q in group %s", *options.Title, group)
+	epic, _, err := client.Epics.CreateEpic(group, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create epic in group %s: %v", group, err)
+	}
+	d.SetId(resourceGitlabEpicBuildID(group, epic.ID))
+	d.Set("iid", epic.IID)
+
+	// Epics are always created opened, other states can only be reached with a `state_event`.
+	if state := d.Get("state").(string); state != epic.State {
+		options := &gitlab.UpdateEpicOptions{
+			StateEvent: gitlab.String(issueStateToStateEvent[state]),
+		}
+		if _, _, err := client.Epics.UpdateEpic(group, epic.IID, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to update epic %d in group %s right after creation: %v", epic.IID, group, err)
+		}
+	}
+
+	return resourceGitlabEpicRead(ctx, d, meta)
+}
+
+func resourceGitlabEpicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, epicID, err := resourceGitlabEpicParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	epicIID, err := resourceGitlabEpicIID(ctx, client, d, group, epicID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if epicIID == 0 {
+		log.Printf("[WARN] gitlab epic %d not found in group %s, removing from state", epicID, group)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] read gitlab epic %d in group %s", epicIID, group)
+	epic, _, err := client.Epics.GetEpic(group, epicIID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[WARN] gitlab epic %d not found in group %s, removing from state", epicIID, group)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read epic %d in group %s: %v", epicIID, group, err)
+	}
+
+	stateMap := gitlabEpicToStateMap(group, epic)
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabEpicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, _, err := resourceGitlabEpicParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	epicIID := d.Get("iid").(int)
+
+	options := &gitlab.UpdateEpicOptions{}
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("labels") {
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(d.Get("labels").(*schema.Set)))
+		options.Labels = &gitlabLabels
+	}
+	if d.HasChange("confidential") {
+		options.Confidential = gitlab.Bool(d.Get("confidential").(bool))
+	}
+	if d.HasChange("start_date") {
+		startDate := d.Get("start_date").(string)
+		options.StartDateIsFixed = gitlab.Bool(startDate != "")
+		if startDate != "" {
+			parsedStartDate, err := parseISO8601Date(startDate)
+			if err != nil {
+				return diag.Errorf("failed to parse start_date: %s. %v", startDate, err)
+			}
+			options.StartDateFixed = parsedStartDate
+		}
+	}
+	if d.HasChange("due_date") {
+		dueDate := d.Get("due_date").(string)
+		options.DueDateIsFixed = gitlab.Bool(dueDate != "")
+		if dueDate != "" {
+			parsedDueDate, err := parseISO8601Date(dueDate)
+			if err != nil {
+				return diag.Errorf("failed to parse due_date: %s. %v", dueDate, err)
+			}
+			options.DueDateFixed = parsedDueDate
+		}
+	}
+	if d.HasChange("state") {
+		options.StateEvent = gitlab.String(issueStateToStateEvent[d.Get("state").(string)])
+	}
+
+	log.Printf("[DEBUG] update gitlab epic %d in group %s", epicIID, group)
+	if _, _, err := client.Epics.UpdateEpic(group, epicIID, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("failed to update epic %d in group %s: %v", epicIID, group, err)
+	}
+
+	return resourceGitlabEpicRead(ctx, d, meta)
+}
+
+func resourceGitlabEpicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, _, err := resourceGitlabEpicParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	epicIID := d.Get("iid").(int)
+
+	log.Printf("[DEBUG] delete gitlab epic %d in group %s", epicIID, group)
+	if _, err := client.Epics.DeleteEpic(group, epicIID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to delete epic %d in group %s: %v", epicIID, group, err)
+	}
+	return nil
+}
+
+// resourceGitlabEpicIID returns the group-scoped IID of the epic with the given instance-wide ID.
+// The IID is taken from the state if available, otherwise, e.g. when importing, it is looked up
+// in the epics of the group. A zero IID is returned if the epic does not exist.
+func resourceGitlabEpicIID(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, group string, epicID int) (int, error) {
+	if iid := d.Get("iid").(int); iid != 0 {
+		return iid, nil
+	}
+
+	// NOTE: the epics of subgroups have their own IIDs, thus they must not be matched.
+	options := &gitlab.ListGroupEpicsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+		IncludeDescendantGroups: gitlab.Bool(false),
+	}
+	for options.Page != 0 {
+		epics, resp, err := client.Epics.ListGroupEpics(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			if is404(err) {
+				return 0, nil
+			}
+			return 0, fmt.Errorf("failed to list epics of group %s: %w", group, err)
+		}
+		for _, epic := range epics {
+			if epic.ID == epicID {
+				return epic.IID, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	return 0, nil
+}
+
+func resourceGitlabEpicParseID(id string) (string, int, error) {
+	group, rawEpicID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	epicID, err := strconv.Atoi(rawEpicID)
+	if err != nil {
+		return "", 0, fmt.Errorf("expected epic ID to be a number in %q: %w", id, err)
+	}
+
+	return group, epicID, nil
+}
+
+func resourceGitlabEpicBuildID(group string, epicID int) string {
+	stringEpicID := strconv.Itoa(epicID)
+	return buildTwoPartID(&group, &stringEpicID)
+}
+
+func gitlabEpicToStateMap(group string, epic *gitlab.Epic) map[string]interface{} {
+	stateMap := make(map[string]interface{})
+	stateMap["group"] = group
+	stateMap["group_id"] = epic.GroupID
+	stateMap["epic_id"] = epic.ID
+	stateMap["iid"] = epic.IID
+	stateMap["title"] = epic.Title
+	stateMap["description"] = epic.Description
+	stateMap["labels"] = epic.Labels
+	stateMap["confidential"] = epic.Confidential
+	stateMap["state"] = epic.State
+	stateMap["web_url"] = epic.WebURL
+	if epic.StartDateIsFixed && epic.StartDateFixed != nil {
+		stateMap["start_date"] = epic.StartDateFixed.String()
+	} else {
+		stateMap["start_date"] = nil
+	}
+	if epic.DueDateIsFixed && epic.DueDateFixed != nil {
+		stateMap["due_date"] = epic.DueDateFixed.String()
+	} else {
+		stateMap["due_date"] = nil
+	}
+	if epic.Author != nil {
+		stateMap["author_id"] = epic.Author.ID
+	} else {
+		stateMap["author_id"] = nil
+	}
+	if epic.CreatedAt != nil {
+		stateMap["created_at"] = epic.CreatedAt.Format(time.RFC3339)
+	} else {
+		stateMap["created_at"] = nil
+	}
+	if epic.UpdatedAt != nil {
+		stateMap["updated_at"] = epic.UpdatedAt.Format(time.RFC3339)
+	} else {
+		stateMap["updated_at"] = nil
+	}
+	return stateMap
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_epic_issue", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_epic_issue`" + ` resource allows to attach an issue to an epic.
+
+-> Epics require a GitLab Premium or Ultimate license.
+
+-> Destroying this resource only detaches the issue from the epic, the issue itself is not deleted.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/epic_issues.html)`,
+
+		CreateContext: resourceGitlabEpicIssueCreate,
+		ReadContext:   resourceGitlabEpicIssueRead,
+		DeleteContext: resourceGitlabEpicIssueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or full path of the group the epic belongs to.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"epic_iid": {
+				Description: "The internal ID of the epic within its group.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"issue_id": {
+				Description: "The instance-wide ID of the issue to attach to the epic.",
+				Type:        schema.TypeInt,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"epic_issue_id": {
+				Description: "The ID of the association between the epic and the issue.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabEpicIssueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Get("group").(string)
+	epicIID := d.Get("epic_iid").(int)
+	issueID := d.Get("issue_id").(int)
+
+	log.Printf("[DEBUG] attach issue %d to gitlab epic %d in group %s", issueID, epicIID, group)
+	if _, _, err := client.EpicIssues.AssignEpicIssue(group, epicIID, issueID, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("failed to attach issue %d to epic %d in group %s: %v", issueID, epicIID, group, err)
+	}
+
+	d.SetId(resourceGitlabEpicIssueBuildID(group, epicIID, issueID))
+	return resourceGitlabEpicIssueRead(ctx, d, meta)
+}
+
+func resourceGitlabEpicIssueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, epicIID, issueID, err := resourceGitlabEpicIssueParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read issue %d of gitlab epic %d in group %s", issueID, epicIID, group)
+	issue, err := findGitlabEpicIssue(ctx, client, group, epicIID, issueID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[WARN] gitlab epic %d not found in group %s, removing issue %d from state", epicIID, group, issueID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to list issues of epic %d in group %s: %v", epicIID, group, err)
+	}
+	if issue == nil {
+		log.Printf("[WARN] issue %d is not attached to gitlab epic %d in group %s anymore, removing from state", issueID, epicIID, group)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", group)
+	d.Set("epic_iid", epicIID)
+	d.Set("issue_id", issue.ID)
+	d.Set("epic_issue_id", issue.EpicIssueID)
+	return nil
+}
+
+func resourceGitlabEpicIssueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, epicIID, issueID, err := resourceGitlabEpicIssueParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	epicIssueID := d.Get("epic_issue_id").(int)
+
+	log.Printf("[DEBUG] detach issue %d from gitlab epic %d in group %s", issueID, epicIID, group)
+	if _, _, err := client.EpicIssues.RemoveEpicIssue(group, epicIID, epicIssueID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to detach issue %d from epic %d in group %s: %v", issueID, epicIID, group, err)
+	}
+	return nil
+}
+
+// findGitlabEpicIssue returns the issue with the given ID from the issues of the epic,
+// or nil if the issue is not attached to the epic.
+func findGitlabEpicIssue(ctx context.Context, client *gitlab.Client, group string, epicIID int, issueID int) (*gitlab.Issue, error) {
+	options := &gitlab.ListOptions{
+		PerPage: 100,
+		Page:    1,
+	}
+	for options.Page != 0 {
+		issues, resp, err := client.EpicIssues.ListEpicIssues(group, epicIID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.ID == issueID {
+				return issue, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+	return nil, nil
+}
+
+func resourceGitlabEpicIssueBuildID(group string, epicIID int, issueID int) string {
+	return fmt.Sprintf("%s:%d:%d", group, epicIID, issueID)
+}
+
+func resourceGitlabEpicIssueParseID(id string) (string, int, int, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("invalid epic issue id %q, expected format '{group}:{epic_iid}:{issue_id}'", id)
+	}
+	group, rawEpicIID, rawIssueID := parts[0], parts[1], parts[2]
+	epicIID, err := strconv.Atoi(rawEpicIID)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid epic issue id %q with 'epic_iid' %q, expected integer", id, rawEpicIID)
+	}
+	issueID, err := strconv.Atoi(rawIssueID)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid epic issue id %q with 'issue_id' %q, expected integer", id, rawIssueID)
+	}
+
+	return group, epicIID, issueID, nil
+}
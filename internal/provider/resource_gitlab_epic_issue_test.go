@@ -0,0 +1,76 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabEpicIssue_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	testIssue := testAccCreateProjectIssues(t, testProject.ID, 1)[0]
+
+	epicConfig := fmt.Sprintf(`
+		resource "gitlab_epic" "this" {
+			group = "%d"
+			title = "Test Epic"
+		}
+	`, testGroup.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabEpicDestroy,
+		Steps: []resource.TestStep{
+			// Attach the issue to the epic
+			{
+				Config: epicConfig + fmt.Sprintf(`
+					resource "gitlab_epic_issue" "this" {
+						group    = gitlab_epic.this.group
+						epic_iid = gitlab_epic.this.iid
+						issue_id = %d
+					}
+				`, testIssue.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_epic_issue.this", "epic_issue_id"),
+					testAccCheckGitlabEpicIssueAttached(testProject.ID, testIssue.IID, true),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_epic_issue.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Detach the issue from the epic, the issue is not deleted
+			{
+				Config: epicConfig,
+				Check:  testAccCheckGitlabEpicIssueAttached(testProject.ID, testIssue.IID, false),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabEpicIssueAttached(projectID int, issueIID int, attached bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		issue, _, err := testGitlabClient.Issues.GetIssue(projectID, issueIID)
+		if err != nil {
+			return fmt.Errorf("failed to get issue %d of project %d: %w", issueIID, projectID, err)
+		}
+
+		if attached && issue.Epic == nil {
+			return fmt.Errorf("expected issue %d of project %d to be attached to an epic", issueIID, projectID)
+		}
+		if !attached && issue.Epic != nil {
+			return fmt.Errorf("expected issue %d of project %d not to be attached to an epic, got epic %d", issueIID, projectID, issue.Epic.IID)
+		}
+		return nil
+	}
+}
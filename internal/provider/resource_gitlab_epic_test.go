@@ -0,0 +1,150 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabEpic_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabEpicDestroy,
+		Steps: []resource.TestStep{
+			// Create an epic with the required attributes only
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_epic" "this" {
+						group = "%d"
+						title = "Test Epic"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_epic.this", "state", "opened"),
+					resource.TestCheckResourceAttr("gitlab_epic.this", "group_id", fmt.Sprintf("%d", testGroup.ID)),
+					resource.TestCheckResourceAttrSet("gitlab_epic.this", "epic_id"),
+					resource.TestCheckResourceAttrSet("gitlab_epic.this", "iid"),
+					resource.TestCheckResourceAttrSet("gitlab_epic.this", "web_url"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_epic.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Add labels, dates and a description
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_label" "bug" {
+						group = "%[1]d"
+						name  = "bug"
+						color = "#ff0000"
+					}
+
+					resource "gitlab_group_label" "feature" {
+						group = "%[1]d"
+						name  = "feature"
+						color = "#00ff00"
+					}
+
+					resource "gitlab_epic" "this" {
+						group        = "%[1]d"
+						title        = "Test Epic"
+						description  = "A test epic"
+						labels       = [gitlab_group_label.bug.name, gitlab_group_label.feature.name]
+						start_date   = "2022-04-10"
+						due_date     = "2022-04-15"
+						confidential = true
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_epic.this", "labels.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_epic.this", "start_date", "2022-04-10"),
+					resource.TestCheckResourceAttr("gitlab_epic.this", "due_date", "2022-04-15"),
+					resource.TestCheckResourceAttr("gitlab_epic.this", "confidential", "true"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_epic.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Close the epic and remove the fixed dates
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_label" "bug" {
+						group = "%[1]d"
+						name  = "bug"
+						color = "#ff0000"
+					}
+
+					resource "gitlab_group_label" "feature" {
+						group = "%[1]d"
+						name  = "feature"
+						color = "#00ff00"
+					}
+
+					resource "gitlab_epic" "this" {
+						group        = "%[1]d"
+						title        = "Test Epic"
+						description  = "A test epic"
+						labels       = [gitlab_group_label.bug.name, gitlab_group_label.feature.name]
+						confidential = true
+						state        = "closed"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_epic.this", "state", "closed"),
+					resource.TestCheckResourceAttr("gitlab_epic.this", "start_date", ""),
+					resource.TestCheckResourceAttr("gitlab_epic.this", "due_date", ""),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_epic.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabEpicDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_epic" {
+			continue
+		}
+
+		group, _, err := resourceGitlabEpicParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		epicIID, err := strconv.Atoi(rs.Primary.Attributes["iid"])
+		if err != nil {
+			return err
+		}
+
+		epic, _, err := testGitlabClient.Epics.GetEpic(group, epicIID)
+		if err == nil && epic != nil {
+			return fmt.Errorf("epic %s still exists", rs.Primary.ID)
+		}
+		if !is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
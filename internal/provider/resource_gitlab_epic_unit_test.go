@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGitlab_resourceGitlabEpicIID_ignoresSubgroupEpics(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/groups/foo/epics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// The epic with ID 42 belongs to a subgroup, which GitLab only lists with the descendant groups.
+		if r.URL.Query().Get("include_descendant_groups") == "false" {
+			_, _ = fmt.Fprint(w, `[{"id": 1, "iid": 1, "group_id": 10}]`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `[{"id": 1, "iid": 1, "group_id": 10}, {"id": 42, "iid": 7, "group_id": 11}]`)
+	})
+	client := newTestGitlabClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, allResources["gitlab_epic"]().Schema, map[string]interface{}{})
+
+	iid, err := resourceGitlabEpicIID(context.Background(), client, d, "foo", 42)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if iid != 0 {
+		t.Fatalf("got IID %d of a subgroup epic, expected 0", iid)
+	}
+}
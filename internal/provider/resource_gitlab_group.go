@@ -30,7 +30,7 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: map[string]*schema.Schema{
+		Schema: constructSchema(map[string]*schema.Schema{
 			"name": {
 				Description: "The name of this group.",
 				Type:        schema.TypeString,
@@ -138,7 +138,7 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 				Default:     48,
 			},
 			"parent_id": {
-				Description: "Id of the parent group (creates a nested group).",
+				Description: "Id of the parent group (creates a nested group). Changing it transfers the group to the new parent group, which must not contain a group or project with the same path.",
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     0,
@@ -155,12 +155,19 @@ var _ = registerResource("gitlab_group", func() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
-		},
+			"membership_lock": {
+				Description: "Defaults to false. Users cannot be added to projects in this group. Requires GitLab Premium.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		}, avatarSchema()),
+		CustomizeDiff: avatarHashCustomizeDiff,
 	}
 })
 
 func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	options := &gitlab.CreateGroupOptions{
 		Name:                 gitlab.String(d.Get("name").(string)),
 		LFSEnabled:           gitlab.Bool(d.Get("lfs_enabled").(bool)),
@@ -229,6 +236,22 @@ func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 		options.DefaultBranchProtection = gitlab.Int(v.(int))
 	}
 
+	if v, ok := d.GetOk("membership_lock"); ok {
+		options.MembershipLock = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("avatar"); ok {
+		avatarFile, err := openAvatar(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer avatarFile.Close()
+		options.Avatar = &gitlab.GroupAvatar{
+			Filename: v.(string),
+			Image:    avatarFile,
+		}
+	}
+
 	log.Printf("[DEBUG] create gitlab group %q", *options.Name)
 
 	group, _, err := client.Groups.CreateGroup(options, gitlab.WithContext(ctx))
@@ -256,7 +279,7 @@ func resourceGitlabGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	log.Printf("[DEBUG] read gitlab group %s", d.Id())
 
 	group, _, err := client.Groups.GetGroup(d.Id(), nil, gitlab.WithContext(ctx))
@@ -296,12 +319,14 @@ func resourceGitlabGroupRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("share_with_group_lock", group.ShareWithGroupLock)
 	d.Set("default_branch_protection", group.DefaultBranchProtection)
 	d.Set("prevent_forking_outside_group", group.PreventForkingOutsideGroup)
+	d.Set("membership_lock", group.MembershipLock)
+	d.Set("avatar_url", group.AvatarURL)
 
 	return nil
 }
 
 func resourceGitlabGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.UpdateGroupOptions{}
 
@@ -371,6 +396,30 @@ func resourceGitlabGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 		options.PreventForkingOutsideGroup = gitlab.Bool(d.Get("prevent_forking_outside_group").(bool))
 	}
 
+	if d.HasChange("membership_lock") {
+		options.MembershipLock = gitlab.Bool(d.Get("membership_lock").(bool))
+	}
+
+	if d.HasChanges("avatar", "avatar_hash") {
+		avatarPath := d.Get("avatar").(string)
+		// NOTE: the avatar should be removed
+		if avatarPath == "" {
+			options.Avatar = &gitlab.GroupAvatar{}
+			// terraform doesn't care to remove this from state, thus, we do.
+			d.Set("avatar_hash", "")
+		} else {
+			avatarFile, err := openAvatar(avatarPath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			defer avatarFile.Close()
+			options.Avatar = &gitlab.GroupAvatar{
+				Filename: avatarPath,
+				Image:    avatarFile,
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] update gitlab group %s", d.Id())
 
 	_, _, err := client.Groups.UpdateGroup(d.Id(), options, gitlab.WithContext(ctx))
@@ -395,6 +444,10 @@ func transferSubGroup(ctx context.Context, d *schema.ResourceData, client *gitla
 		return fmt.Errorf("error converting parent_id %v into an int", n)
 	}
 
+	if err := checkSubGroupTransferTarget(ctx, d, client, parentId); err != nil {
+		return err
+	}
+
 	opt := &gitlab.TransferSubGroupOptions{}
 	if parentId != 0 {
 		log.Printf("[DEBUG] transfer gitlab group %s from %v to new parent group %v", d.Id(), o, n)
@@ -411,11 +464,44 @@ func transferSubGroup(ctx context.Context, d *schema.ResourceData, client *gitla
 	return nil
 }
 
+// checkSubGroupTransferTarget verifies that the group path is still available in the new parent group,
+// because GitLab refuses to transfer a group into a namespace which already contains a group or project with the same path.
+func checkSubGroupTransferTarget(ctx context.Context, d *schema.ResourceData, client *gitlab.Client, parentId int) error {
+	targetPath := d.Get("path").(string)
+	if parentId != 0 {
+		parent, _, err := client.Groups.GetGroup(parentId, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("error reading new parent group %d of group %s: %s", parentId, d.Id(), err)
+		}
+		targetPath = fmt.Sprintf("%s/%s", parent.FullPath, targetPath)
+	}
+
+	namespace, _, err := client.Namespaces.GetNamespace(targetPath, gitlab.WithContext(ctx))
+	if err != nil && !is404(err) {
+		return fmt.Errorf("error checking if namespace %s is available: %s", targetPath, err)
+	}
+	if err == nil && fmt.Sprintf("%d", namespace.ID) != d.Id() {
+		return fmt.Errorf("error transfering group %s to new parent group %v: the namespace %s already exists", d.Id(), parentId, targetPath)
+	}
+
+	if parentId != 0 {
+		_, _, err = client.Projects.GetProject(targetPath, nil, gitlab.WithContext(ctx))
+		if err != nil && !is404(err) {
+			return fmt.Errorf("error checking if project path %s is available: %s", targetPath, err)
+		}
+		if err == nil {
+			return fmt.Errorf("error transfering group %s to new parent group %v: a project with the path %s already exists", d.Id(), parentId, targetPath)
+		}
+	}
+
+	return nil
+}
+
 func resourceGitlabGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	log.Printf("[DEBUG] Delete gitlab group %s", d.Id())
 
-	_, err := client.Groups.DeleteGroup(d.Id(), gitlab.WithContext(ctx))
+	_, err := client.Groups.DeleteGroup(d.Id(), nil, gitlab.WithContext(ctx))
 	if err != nil && !strings.Contains(err.Error(), "Group has been already marked for deletion") {
 		return diag.Errorf("error deleting group %s: %s", d.Id(), err)
 	}
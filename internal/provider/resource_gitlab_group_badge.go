@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -29,6 +30,7 @@ var _ = registerResource("gitlab_group_badge", func() *schema.Resource {
 			"group": {
 				Description: "The id of the group to add the badge to.",
 				Type:        schema.TypeString,
+				ForceNew:    true,
 				Required:    true,
 			},
 			"link_url": {
@@ -41,6 +43,11 @@ var _ = registerResource("gitlab_group_badge", func() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"name": {
+				Description: "The name of the badge.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"rendered_link_url": {
 				Description: "The link_url argument rendered (in case of use of placeholders).",
 				Type:        schema.TypeString,
@@ -56,14 +63,15 @@ var _ = registerResource("gitlab_group_badge", func() *schema.Resource {
 })
 
 func resourceGitlabGroupBadgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	groupID := d.Get("group").(string)
 	options := &gitlab.AddGroupBadgeOptions{
 		LinkURL:  gitlab.String(d.Get("link_url").(string)),
 		ImageURL: gitlab.String(d.Get("image_url").(string)),
+		Name:     gitlab.String(d.Get("name").(string)),
 	}
 
-	log.Printf("[DEBUG] create gitlab group variable %s/%s", *options.LinkURL, *options.ImageURL)
+	log.Printf("[DEBUG] create gitlab group badge %q / %q", *options.LinkURL, *options.ImageURL)
 
 	badge, _, err := client.GroupBadges.AddGroupBadge(groupID, options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -78,10 +86,8 @@ func resourceGitlabGroupBadgeCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupBadgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	ids := strings.Split(d.Id(), ":")
-	groupID := ids[0]
-	badgeID, err := strconv.Atoi(ids[1])
+	client := meta.(*ProviderMeta).Client
+	groupID, badgeID, err := resourceGitlabGroupBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -98,15 +104,17 @@ func resourceGitlabGroupBadgeRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if badge.Kind != "" && badge.Kind != gitlab.GroupBadgeKind {
+		return diag.Errorf("badge %d of group %s is a %s badge and cannot be managed by the gitlab_group_badge resource", badgeID, groupID, badge.Kind)
+	}
+
 	resourceGitlabGroupBadgeSetToState(d, badge, &groupID)
 	return nil
 }
 
 func resourceGitlabGroupBadgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	ids := strings.Split(d.Id(), ":")
-	groupID := ids[0]
-	badgeID, err := strconv.Atoi(ids[1])
+	client := meta.(*ProviderMeta).Client
+	groupID, badgeID, err := resourceGitlabGroupBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -114,6 +122,7 @@ func resourceGitlabGroupBadgeUpdate(ctx context.Context, d *schema.ResourceData,
 	options := &gitlab.EditGroupBadgeOptions{
 		LinkURL:  gitlab.String(d.Get("link_url").(string)),
 		ImageURL: gitlab.String(d.Get("image_url").(string)),
+		Name:     gitlab.String(d.Get("name").(string)),
 	}
 
 	log.Printf("[DEBUG] update gitlab group badge %s/%d", groupID, badgeID)
@@ -127,10 +136,8 @@ func resourceGitlabGroupBadgeUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupBadgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	ids := strings.Split(d.Id(), ":")
-	groupID := ids[0]
-	badgeID, err := strconv.Atoi(ids[1])
+	client := meta.(*ProviderMeta).Client
+	groupID, badgeID, err := resourceGitlabGroupBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -138,7 +145,7 @@ func resourceGitlabGroupBadgeDelete(ctx context.Context, d *schema.ResourceData,
 	log.Printf("[DEBUG] Delete gitlab group badge %s/%d", groupID, badgeID)
 
 	_, err = client.GroupBadges.DeleteGroupBadge(groupID, badgeID, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -148,7 +155,21 @@ func resourceGitlabGroupBadgeDelete(ctx context.Context, d *schema.ResourceData,
 func resourceGitlabGroupBadgeSetToState(d *schema.ResourceData, badge *gitlab.GroupBadge, groupID *string) {
 	d.Set("link_url", badge.LinkURL)
 	d.Set("image_url", badge.ImageURL)
+	d.Set("name", badge.Name)
 	d.Set("rendered_link_url", badge.RenderedLinkURL)
 	d.Set("rendered_image_url", badge.RenderedImageURL)
 	d.Set("group", groupID)
 }
+
+func resourceGitlabGroupBadgeParseID(id string) (string, int, error) {
+	ids := strings.Split(id, ":")
+	if len(ids) != 2 {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), expected 'group:badge_id'", id)
+	}
+	groupID := ids[0]
+	badgeID, err := strconv.Atoi(ids[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected format of ID (%s), expected 'group:badge_id'", id)
+	}
+	return groupID, badgeID, nil
+}
@@ -31,6 +31,7 @@ func TestAccGitlabGroupBadge_basic(t *testing.T) {
 					testAccCheckGitlabGroupBadgeAttributes(&badge, &testAccGitlabGroupBadgeExpectedAttributes{
 						LinkURL:  fmt.Sprintf("https://example.com/badge-%d", rInt),
 						ImageURL: fmt.Sprintf("https://example.com/badge-%d.svg", rInt),
+						Name:     "badge",
 					}),
 				),
 			},
@@ -48,9 +49,16 @@ func TestAccGitlabGroupBadge_basic(t *testing.T) {
 					testAccCheckGitlabGroupBadgeAttributes(&badge, &testAccGitlabGroupBadgeExpectedAttributes{
 						LinkURL:  fmt.Sprintf("https://example.com/new-badge-%d", rInt),
 						ImageURL: fmt.Sprintf("https://example.com/new-badge-%d.svg", rInt),
+						Name:     "badge-updated",
 					}),
 				),
 			},
+			// Test ImportState
+			{
+				ResourceName:      "gitlab_group_badge.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -85,6 +93,7 @@ func testAccCheckGitlabGroupBadgeExists(n string, badge *gitlab.GroupBadge) reso
 type testAccGitlabGroupBadgeExpectedAttributes struct {
 	LinkURL  string
 	ImageURL string
+	Name     string
 }
 
 func testAccCheckGitlabGroupBadgeAttributes(badge *gitlab.GroupBadge, want *testAccGitlabGroupBadgeExpectedAttributes) resource.TestCheckFunc {
@@ -97,6 +106,10 @@ func testAccCheckGitlabGroupBadgeAttributes(badge *gitlab.GroupBadge, want *test
 			return fmt.Errorf("got image_url %s; want %s", badge.ImageURL, want.ImageURL)
 		}
 
+		if badge.Name != want.Name {
+			return fmt.Errorf("got name %q; want %q", badge.Name, want.Name)
+		}
+
 		return nil
 	}
 }
@@ -139,6 +152,7 @@ resource "gitlab_group_badge" "foo" {
   group     = "${gitlab_group.foo.id}"
   link_url  = "https://example.com/badge-%d"
   image_url = "https://example.com/badge-%d.svg"
+  name      = "badge"
 }
 	`, rInt, rInt, rInt, rInt)
 }
@@ -160,6 +174,7 @@ resource "gitlab_group_badge" "foo" {
   group     = "${gitlab_group.foo.id}"
   link_url  = "https://example.com/new-badge-%d"
   image_url = "https://example.com/new-badge-%d.svg"
+  name      = "badge-updated"
 }
 	`, rInt, rInt, rInt, rInt)
 }
@@ -101,6 +101,7 @@ var _ = registerResource("gitlab_group_cluster", func() *schema.Resource {
 				Description: "TLS certificate (needed if API is using a self-signed TLS certificate).",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return strings.TrimSpace(old) == strings.TrimSpace(new)
 				},
@@ -123,7 +124,7 @@ var _ = registerResource("gitlab_group_cluster", func() *schema.Resource {
 })
 
 func resourceGitlabGroupClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 
 	pk := gitlab.AddGroupPlatformKubernetesOptions{
@@ -173,7 +174,7 @@ func resourceGitlabGroupClusterCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabGroupClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group, clusterId, err := groupIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -215,7 +216,7 @@ func resourceGitlabGroupClusterRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group, clusterId, err := groupIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -270,7 +271,7 @@ func resourceGitlabGroupClusterUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabGroupClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group, clusterId, err := groupIdAndClusterIdFromId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -279,7 +280,7 @@ func resourceGitlabGroupClusterDelete(ctx context.Context, d *schema.ResourceDat
 	log.Printf("[DEBUG] delete gitlab group cluster %q/%d", group, clusterId)
 
 	_, err = client.GroupCluster.DeleteCluster(group, clusterId, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -19,6 +19,8 @@ var _ = registerResource("gitlab_group_custom_attribute", func() *schema.Resourc
 		},
 		`The `+"`gitlab_group_custom_attribute`"+` resource allows to manage custom attributes for a group.
 
+-> Requires administrator privileges.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/custom_attributes.html)`,
 	)
 })
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_epic_board", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_epic_board`" + ` resource allows to manage the lifecycle of an epic board in a group.
+
+~> **NOTE:** If the board lists are changed all lists will be recreated.
+
+-> Epic boards require GitLab Premium.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationepicboardcreate)`,
+
+		CreateContext: resourceGitlabGroupEpicBoardCreate,
+		ReadContext:   resourceGitlabGroupEpicBoardRead,
+		UpdateContext: resourceGitlabGroupEpicBoardUpdate,
+		DeleteContext: resourceGitlabGroupEpicBoardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description:  "The ID or full path of the group owned by the authenticated user.",
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"name": {
+				Description:  "The name of the board.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"lists": {
+				Description: "The list of epic board lists. The default `Open` and `Closed` lists of the board are not part of it.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the list.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"label_id": {
+							Description: "The ID of the group label the list should be scoped to.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"position": {
+							Description: "The position of the list within the board. The position for the list is based on the its position in the `lists` array.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupEpicBoardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	groupID := d.Get("group").(string)
+	name := d.Get("name").(string)
+
+	group, _, err := client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get group %q: %v", groupID, err)
+	}
+
+	query := GraphQLQuery{
+		Query: `mutation($groupPath: ID!, $name: String!) {
+			epicBoardCreate(input: {groupPath: $groupPath, name: $name}) {
+				epicBoard { id }
+				errors
+			}
+		}`,
+		Variables: map[string]interface{}{
+			"groupPath": group.FullPath,
+			"name":      name,
+		},
+	}
+
+	log.Printf("[DEBUG] create Group Epic Board %q in group %q", name, groupID)
+	var response struct {
+		Data struct {
+			EpicBoardCreate struct {
+				EpicBoard struct {
+					ID string `json:"id"`
+				} `json:"epicBoard"`
+				Errors []string `json:"errors"`
+			} `json:"epicBoardCreate"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return diag.Errorf("failed to create Group Epic Board %q in group %q: %v", name, groupID, err)
+	}
+	if errs := response.Data.EpicBoardCreate.Errors; len(errs) > 0 {
+		return diag.Errorf("failed to create Group Epic Board %q in group %q: %s", name, groupID, strings.Join(errs, ", "))
+	}
+
+	epicBoardID, err := extractIIDFromGlobalID(response.Data.EpicBoardCreate.EpicBoard.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resourceGitlabGroupEpicBoardBuildID(groupID, epicBoardID))
+
+	if v, ok := d.GetOk("lists"); ok {
+		if err := resourceGitlabGroupEpicBoardCreateLists(ctx, client, epicBoardID, v.([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabGroupEpicBoardRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupEpicBoardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read Group Epic Board in group %q with id %d", group, epicBoardID)
+	epicBoard, _, err := client.GroupEpicBoards.GetGroupEpicBoard(group, epicBoardID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] Group Epic Board in group %s with id %d not found, removing from state", group, epicBoardID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	d.Set("name", epicBoard.Name)
+	if err := d.Set("lists", flattenGroupEpicBoardLists(epicBoard.Lists)); err != nil {
+		return diag.Errorf("failed to set lists to state: %v", err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupEpicBoardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		query := GraphQLQuery{
+			Query: `mutation($boardId: BoardsEpicBoardID!, $name: String!) {
+				epicBoardUpdate(input: {boardId: $boardId, name: $name}) { errors }
+			}`,
+			Variables: map[string]interface{}{
+				"boardId": groupEpicBoardGlobalID(epicBoardID),
+				"name":    d.Get("name").(string),
+			},
+		}
+
+		log.Printf("[DEBUG] update Group Epic Board %d in group %q", epicBoardID, group)
+		var response struct {
+			Data struct {
+				EpicBoardUpdate struct {
+					Errors []string `json:"errors"`
+				} `json:"epicBoardUpdate"`
+			} `json:"data"`
+		}
+		if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+			return diag.Errorf("failed to update Group Epic Board %d in group %q: %v", epicBoardID, group, err)
+		}
+		if errs := response.Data.EpicBoardUpdate.Errors; len(errs) > 0 {
+			return diag.Errorf("failed to update Group Epic Board %d in group %q: %s", epicBoardID, group, strings.Join(errs, ", "))
+		}
+	}
+
+	if d.HasChange("lists") {
+		// NOTE: since we do not have a straightforward way to know which lists have been changed, we just re-create all lists
+		oldLists, _ := d.GetChange("lists")
+		for _, listData := range oldLists.([]interface{}) {
+			listID := listData.(map[string]interface{})["id"].(int)
+			log.Printf("[DEBUG] deleting list %d for Group Epic Board %d in group %q", listID, epicBoardID, group)
+			if err := resourceGitlabGroupEpicBoardDeleteList(ctx, client, listID); err != nil {
+				return diag.Errorf("failed to delete list %d for Group Epic Board %d in group %q: %v", listID, epicBoardID, group, err)
+			}
+		}
+
+		if err := resourceGitlabGroupEpicBoardCreateLists(ctx, client, epicBoardID, d.Get("lists").([]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabGroupEpicBoardRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupEpicBoardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	query := GraphQLQuery{
+		Query: `mutation($id: BoardsEpicBoardID!) {
+			destroyEpicBoard(input: {id: $id}) { errors }
+		}`,
+		Variables: map[string]interface{}{
+			"id": groupEpicBoardGlobalID(epicBoardID),
+		},
+	}
+
+	log.Printf("[DEBUG] delete Group Epic Board in group %q with id %d", group, epicBoardID)
+	var response struct {
+		Data struct {
+			DestroyEpicBoard struct {
+				Errors []string `json:"errors"`
+			} `json:"destroyEpicBoard"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return diag.Errorf("failed to delete Group Epic Board %d in group %q: %v", epicBoardID, group, err)
+	}
+	if errs := response.Data.DestroyEpicBoard.Errors; len(errs) > 0 {
+		return diag.Errorf("failed to delete Group Epic Board %d in group %q: %s", epicBoardID, group, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupEpicBoardBuildID(group string, epicBoardID int) string {
+	return fmt.Sprintf("%s:%d", group, epicBoardID)
+}
+
+func resourceGitlabGroupEpicBoardParseID(id string) (string, int, error) {
+	group, rawEpicBoardID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	epicBoardID, err := strconv.Atoi(rawEpicBoardID)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return group, epicBoardID, nil
+}
+
+func groupEpicBoardGlobalID(epicBoardID int) string {
+	return fmt.Sprintf("gid://gitlab/Boards::EpicBoard/%d", epicBoardID)
+}
+
+func resourceGitlabGroupEpicBoardCreateLists(ctx context.Context, client *gitlab.Client, epicBoardID int, lists []interface{}) error {
+	log.Printf("[DEBUG] creating lists for Group Epic Board %d", epicBoardID)
+	for i, listData := range lists {
+		position := i + 1
+		labelID := listData.(map[string]interface{})["label_id"].(int)
+
+		query := GraphQLQuery{
+			Query: `mutation($boardId: BoardsEpicBoardID!, $labelId: LabelID!) {
+				epicBoardListCreate(input: {boardId: $boardId, labelId: $labelId}) { errors }
+			}`,
+			Variables: map[string]interface{}{
+				"boardId": groupEpicBoardGlobalID(epicBoardID),
+				"labelId": fmt.Sprintf("gid://gitlab/GroupLabel/%d", labelID),
+			},
+		}
+
+		log.Printf("[DEBUG] creating list at position %d for Group Epic Board %d", position, epicBoardID)
+		var response struct {
+			Data struct {
+				EpicBoardListCreate struct {
+					Errors []string `json:"errors"`
+				} `json:"epicBoardListCreate"`
+			} `json:"data"`
+		}
+		if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+			return fmt.Errorf("failed to create list at position %d for Group Epic Board %d: %v", position, epicBoardID, err)
+		}
+		if errs := response.Data.EpicBoardListCreate.Errors; len(errs) > 0 {
+			return fmt.Errorf("failed to create list at position %d for Group Epic Board %d: %s", position, epicBoardID, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupEpicBoardDeleteList(ctx context.Context, client *gitlab.Client, listID int) error {
+	query := GraphQLQuery{
+		Query: `mutation($listId: BoardsEpicListID!) {
+			epicBoardListDestroy(input: {listId: $listId}) { errors }
+		}`,
+		Variables: map[string]interface{}{
+			"listId": fmt.Sprintf("gid://gitlab/Boards::EpicList/%d", listID),
+		},
+	}
+
+	var response struct {
+		Data struct {
+			EpicBoardListDestroy struct {
+				Errors []string `json:"errors"`
+			} `json:"epicBoardListDestroy"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return err
+	}
+	if errs := response.Data.EpicBoardListDestroy.Errors; len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func flattenGroupEpicBoardLists(lists []*gitlab.BoardList) (values []map[string]interface{}) {
+	// GitLab returns the lists in arbitrary order, so we need to sort them by position first
+	sort.Slice(lists, func(i, j int) bool {
+		return lists[i].Position < lists[j].Position
+	})
+	for _, list := range lists {
+		// The default `Open` and `Closed` lists of the board have no label and are not managed.
+		if list.Label == nil {
+			continue
+		}
+
+		values = append(values, map[string]interface{}{
+			"id":       list.ID,
+			"label_id": list.Label.ID,
+			"position": list.Position,
+		})
+	}
+	return values
+}
@@ -0,0 +1,115 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupEpicBoard_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	var labels []*gitlab.GroupLabel
+	for _, name := range []string{"priority::high", "priority::low"} {
+		label, _, err := testGitlabClient.GroupLabels.CreateGroupLabel(testGroup.ID, &gitlab.CreateGroupLabelOptions{
+			Name:  gitlab.String(name),
+			Color: gitlab.String("#FFAABB"),
+		})
+		if err != nil {
+			t.Fatalf("failed to create group label %q: %v", name, err)
+		}
+		labels = append(labels, label)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupEpicBoardDestroy,
+		Steps: []resource.TestStep{
+			// Create an epic board with two label lists
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_epic_board" "this" {
+						group = "%s"
+						name  = "Priorities"
+
+						lists {
+							label_id = %d
+						}
+						lists {
+							label_id = %d
+						}
+					}
+				`, testGroup.FullPath, labels[0].ID, labels[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.0.label_id", fmt.Sprintf("%d", labels[0].ID)),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.1.label_id", fmt.Sprintf("%d", labels[1].ID)),
+					resource.TestCheckResourceAttrSet("gitlab_group_epic_board.this", "lists.0.id"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_epic_board.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Rename the board and reverse the order of the lists
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_epic_board" "this" {
+						group = "%s"
+						name  = "Reversed Priorities"
+
+						lists {
+							label_id = %d
+						}
+						lists {
+							label_id = %d
+						}
+					}
+				`, testGroup.FullPath, labels[1].ID, labels[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "name", "Reversed Priorities"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.0.label_id", fmt.Sprintf("%d", labels[1].ID)),
+					resource.TestCheckResourceAttr("gitlab_group_epic_board.this", "lists.1.label_id", fmt.Sprintf("%d", labels[0].ID)),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_epic_board.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupEpicBoardDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_epic_board" {
+			continue
+		}
+
+		group, epicBoardID, err := resourceGitlabGroupEpicBoardParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.GroupEpicBoards.GetGroupEpicBoard(group, epicBoardID)
+		if err == nil {
+			return fmt.Errorf("Group Epic Board %d in group %s still exists", epicBoardID, group)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -24,12 +24,12 @@ var _ = registerResource("gitlab_group_hook", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: gitlabGroupHookSchema(),
+		Schema: gitlabGroupHookResourceSchema(),
 	}
 })
 
 func resourceGitlabGroupHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	options := &gitlab.AddGroupHookOptions{
 		URL:                      gitlab.String(d.Get("url").(string)),
@@ -47,6 +47,7 @@ func resourceGitlabGroupHookCreate(ctx context.Context, d *schema.ResourceData,
 		DeploymentEvents:         gitlab.Bool(d.Get("deployment_events").(bool)),
 		ReleasesEvents:           gitlab.Bool(d.Get("releases_events").(bool)),
 		SubGroupEvents:           gitlab.Bool(d.Get("subgroup_events").(bool)),
+		MemberEvents:             gitlab.Bool(d.Get("member_events").(bool)),
 		EnableSSLVerification:    gitlab.Bool(d.Get("enable_ssl_verification").(bool)),
 	}
 
@@ -54,6 +55,10 @@ func resourceGitlabGroupHookCreate(ctx context.Context, d *schema.ResourceData,
 		options.Token = gitlab.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("custom_headers"); ok {
+		options.CustomHeaders = expandHookCustomHeaders(v)
+	}
+
 	log.Printf("[DEBUG] create gitlab group hook %q", *options.URL)
 
 	hook, _, err := client.Groups.AddGroupHook(group, options, gitlab.WithContext(ctx))
@@ -74,7 +79,7 @@ func resourceGitlabGroupHookRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	log.Printf("[DEBUG] read gitlab group hook %s/%d", group, hookID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	hook, _, err := client.Groups.GetGroupHook(group, hookID, gitlab.WithContext(ctx))
 	if err != nil {
 		if is404(err) {
@@ -98,7 +103,7 @@ func resourceGitlabGroupHookUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	options := &gitlab.EditGroupHookOptions{
 		URL:                      gitlab.String(d.Get("url").(string)),
 		PushEvents:               gitlab.Bool(d.Get("push_events").(bool)),
@@ -115,6 +120,7 @@ func resourceGitlabGroupHookUpdate(ctx context.Context, d *schema.ResourceData,
 		DeploymentEvents:         gitlab.Bool(d.Get("deployment_events").(bool)),
 		ReleasesEvents:           gitlab.Bool(d.Get("releases_events").(bool)),
 		SubGroupEvents:           gitlab.Bool(d.Get("subgroup_events").(bool)),
+		MemberEvents:             gitlab.Bool(d.Get("member_events").(bool)),
 		EnableSSLVerification:    gitlab.Bool(d.Get("enable_ssl_verification").(bool)),
 	}
 
@@ -129,6 +135,21 @@ func resourceGitlabGroupHookUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("custom_headers") {
+		oldHeaders, newHeaders := d.GetChange("custom_headers")
+		changed, removed := changedHookCustomHeaders(oldHeaders, newHeaders)
+		for key, value := range changed {
+			if _, err := client.Groups.SetGroupCustomHeader(group, hookID, key, &gitlab.SetHookCustomHeaderOptions{Value: gitlab.String(value)}, gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("failed to set custom header %q of group hook %s: %v", key, d.Id(), err)
+			}
+		}
+		for _, key := range removed {
+			if _, err := client.Groups.DeleteGroupCustomHeader(group, hookID, key, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+				return diag.Errorf("failed to delete custom header %q of group hook %s: %v", key, d.Id(), err)
+			}
+		}
+	}
+
 	return resourceGitlabGroupHookRead(ctx, d, meta)
 }
 
@@ -139,7 +160,7 @@ func resourceGitlabGroupHookDelete(ctx context.Context, d *schema.ResourceData,
 	}
 	log.Printf("[DEBUG] Delete gitlab group hook %s/%d", group, hookID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	_, err = client.Groups.DeleteGroupHook(group, hookID, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
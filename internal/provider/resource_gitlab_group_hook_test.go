@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -59,6 +60,7 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 						deployment_events          = true
 						releases_events            = true
 						subgroup_events            = true
+						member_events              = true
 					}
 				`, testGroup.FullPath),
 			},
@@ -89,6 +91,74 @@ func TestAccGitlabGroupHook_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroupHook_subgroupAndReleaseEvents(t *testing.T) {
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupHookDestroy,
+		Steps: []resource.TestStep{
+			// Create a Group Hook only for subgroup, release and member events
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_hook" "this" {
+						group           = "%s"
+						url             = "http://example.com"
+						push_events     = false
+						subgroup_events = true
+						releases_events = true
+						member_events   = true
+					}
+				`, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "push_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "subgroup_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "releases_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "member_events", "true"),
+					func(s *terraform.State) error {
+						hookID, err := strconv.Atoi(s.RootModule().Resources["gitlab_group_hook.this"].Primary.Attributes["hook_id"])
+						if err != nil {
+							return err
+						}
+						hook, _, err := testGitlabClient.Groups.GetGroupHook(testGroup.ID, hookID)
+						if err != nil {
+							return err
+						}
+						if !hook.SubGroupEvents || !hook.ReleasesEvents || !hook.MemberEvents {
+							return fmt.Errorf("expected subgroup, releases and member events to be enabled, got %+v", hook)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_group_hook.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Disable the subgroup and release events
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_hook" "this" {
+						group       = "%s"
+						url         = "http://example.com"
+						push_events = false
+					}
+				`, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "subgroup_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "releases_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_group_hook.this", "member_events", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabGroupHookDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_group_hook" {
@@ -31,12 +31,12 @@ var _ = registerResource("gitlab_group_label", func() *schema.Resource {
 				Description: "The name or id of the group to add the label to.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"name": {
-				Description: "The name of the label.",
+				Description: "The name of the label. Changing the name renames the existing label.",
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 			},
 			"color": {
 				Description: "The color of the label given in 6-digit hex notation with leading '#' sign (e.g. #FFAABB) or one of the [CSS color names](https://developer.mozilla.org/en-US/docs/Web/CSS/color_value#Color_keywords).",
@@ -53,7 +53,7 @@ var _ = registerResource("gitlab_group_label", func() *schema.Resource {
 })
 
 func resourceGitlabGroupLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	options := &gitlab.CreateGroupLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
@@ -76,7 +76,7 @@ func resourceGitlabGroupLabelCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabGroupLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	labelName := d.Id()
 	log.Printf("[DEBUG] read gitlab group label %s/%s", group, labelName)
@@ -97,41 +97,47 @@ func resourceGitlabGroupLabelRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabGroupLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	options := &gitlab.UpdateGroupLabelOptions{
-		Name:  gitlab.String(d.Get("name").(string)),
+		Name:  gitlab.String(d.Id()),
 		Color: gitlab.String(d.Get("color").(string)),
 	}
 
+	if d.HasChange("name") {
+		options.NewName = gitlab.String(d.Get("name").(string))
+	}
 	if d.HasChange("description") {
 		options.Description = gitlab.String(d.Get("description").(string))
 	}
 
 	log.Printf("[DEBUG] update gitlab group label %s", d.Id())
 
-	_, _, err := client.GroupLabels.UpdateGroupLabel(group, options, gitlab.WithContext(ctx))
+	label, _, err := client.GroupLabels.UpdateGroupLabel(group, nil, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The resource is identified by the label name, which changes when the label is renamed.
+	d.SetId(label.Name)
+
 	return resourceGitlabGroupLabelRead(ctx, d, meta)
 }
 
 func resourceGitlabGroupLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	log.Printf("[DEBUG] Delete gitlab group label %s", d.Id())
 	options := &gitlab.DeleteGroupLabelOptions{
 		Name: gitlab.String(d.Id()),
 	}
 
-	_, err := client.GroupLabels.DeleteGroupLabel(group, options, gitlab.WithContext(ctx))
+	_, err := client.GroupLabels.DeleteGroupLabel(group, nil, options, gitlab.WithContext(ctx))
 	return diag.FromErr(err)
 }
 
 func resourceGitlabGroupLabelImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	parts := strings.SplitN(d.Id(), ":", 2)
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("invalid label id (should be <group ID>:<label name>): %s", d.Id())
@@ -64,6 +64,68 @@ func TestAccGitlabGroupLabel_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroupLabel_renameKeepsLabel(t *testing.T) {
+	testGroup := testAccCreateGroups(t, 1)[0]
+	var labelID int
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_label" "this" {
+						group       = "%d"
+						name        = "before-rename"
+						color       = "#ffcc00"
+						description = "before"
+					}
+				`, testGroup.ID),
+				Check: func(s *terraform.State) error {
+					label, _, err := testGitlabClient.GroupLabels.GetGroupLabel(testGroup.ID, "before-rename")
+					if err != nil {
+						return err
+					}
+					labelID = label.ID
+					return nil
+				},
+			},
+			// Rename the label and change its color and description in a single apply
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_label" "this" {
+						group       = "%d"
+						name        = "after-rename"
+						color       = "#ff0000"
+						description = "after"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_label.this", "id", "after-rename"),
+					resource.TestCheckResourceAttr("gitlab_group_label.this", "color", "#ff0000"),
+					func(s *terraform.State) error {
+						label, _, err := testGitlabClient.GroupLabels.GetGroupLabel(testGroup.ID, "after-rename")
+						if err != nil {
+							return err
+						}
+						if label.ID != labelID {
+							return fmt.Errorf("expected label %d to be renamed, got new label %d", labelID, label.ID)
+						}
+						return nil
+					},
+				),
+			},
+			// Import by the group path
+			{
+				ResourceName:      "gitlab_group_label.this",
+				ImportStateId:     fmt.Sprintf("%s:after-rename", testGroup.FullPath),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func getGroupLabelImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -16,6 +16,8 @@ var _ = registerResource("gitlab_group_ldap_link", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_group_ldap_link`" + ` resource allows to manage the lifecycle of an LDAP integration with a group.
 
+-> Requires LDAP to be configured on the GitLab instance. Linking an LDAP ` + "`filter`" + ` instead of a ` + "`cn`" + ` requires GitLab Premium.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#ldap-group-links)`,
 
 		CreateContext: resourceGitlabGroupLdapLinkCreate,
@@ -33,10 +35,18 @@ var _ = registerResource("gitlab_group_ldap_link", func() *schema.Resource {
 				ForceNew:    true,
 			},
 			"cn": {
-				Description: "The CN of the LDAP group to link with.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:  "The CN of the LDAP group to link with.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cn", "filter"},
+			},
+			"filter": {
+				Description:  "The LDAP filter for the users to link with, e.g. `(department=engineering)`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cn", "filter"},
 			},
 			"access_level": {
 				Description:      fmt.Sprintf("Minimum access level for members of the LDAP group. Valid values are: %s", renderValueListForDocs(validGroupAccessLevelNames)),
@@ -74,10 +84,9 @@ var _ = registerResource("gitlab_group_ldap_link", func() *schema.Resource {
 })
 
 func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	groupId := d.Get("group_id").(string)
-	cn := d.Get("cn").(string)
 
 	var groupAccess gitlab.AccessLevelValue
 	if v, ok := d.GetOk("group_access"); ok {
@@ -92,11 +101,17 @@ func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceDa
 	force := d.Get("force").(bool)
 
 	options := &gitlab.AddGroupLDAPLinkOptions{
-		CN:          &cn,
 		GroupAccess: &groupAccess,
 		Provider:    &ldap_provider,
 	}
 
+	if v, ok := d.GetOk("cn"); ok {
+		options.CN = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("filter"); ok {
+		options.Filter = gitlab.String(v.(string))
+	}
+
 	if force {
 		if err := resourceGitlabGroupLdapLinkDelete(ctx, d, meta); err != nil {
 			return err
@@ -106,16 +121,19 @@ func resourceGitlabGroupLdapLinkCreate(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[DEBUG] Create GitLab group LdapLink %s", d.Id())
 	LdapLink, _, err := client.Groups.AddGroupLDAPLink(groupId, options, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			return diag.Errorf("LDAP group links are not available for group %q, make sure LDAP is configured on the GitLab instance: %v", groupId, err)
+		}
 		return diag.FromErr(err)
 	}
 
-	d.SetId(buildTwoPartID(&LdapLink.Provider, &LdapLink.CN))
+	d.SetId(buildTwoPartID(&LdapLink.Provider, gitlabGroupLdapLinkKey(LdapLink)))
 
 	return resourceGitlabGroupLdapLinkRead(ctx, d, meta)
 }
 
 func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	groupId := d.Get("group_id").(string)
 
 	// Try to fetch all group links from GitLab
@@ -124,16 +142,10 @@ func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		// The read/GET API wasn't implemented in GitLab until version 12.8 (March 2020, well after the add and delete APIs).
 		// If we 404, assume GitLab is at an older version and take things on faith.
-		switch err.(type) { // nolint // TODO: Resolve this golangci-lint issue: S1034: assigning the result of this type assertion to a variable (switch err := err.(type)) could eliminate type assertions in switch cases (gosimple)
-		case *gitlab.ErrorResponse:
-			if err.(*gitlab.ErrorResponse).Response.StatusCode == 404 { // nolint // TODO: Resolve this golangci-lint issue: S1034(related information): could eliminate this type assertion (gosimple)
-				log.Printf("[WARNING] This GitLab instance doesn't have the GET API for group_ldap_sync.  Please upgrade to 12.8 or later for best results.")
-			} else {
-				return diag.FromErr(err)
-			}
-		default:
+		if !is404(err) {
 			return diag.FromErr(err)
 		}
+		log.Printf("[WARNING] This GitLab instance doesn't have the GET API for group_ldap_sync.  Please upgrade to 12.8 or later for best results.")
 	}
 
 	// If we got here and don't have links, assume GitLab is below version 12.8 and skip the check
@@ -141,9 +153,10 @@ func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData
 		// Check if the LDAP link exists in the returned list of links
 		found := false
 		for _, ldapLink := range ldapLinks {
-			if buildTwoPartID(&ldapLink.Provider, &ldapLink.CN) == d.Id() {
+			if buildTwoPartID(&ldapLink.Provider, gitlabGroupLdapLinkKey(ldapLink)) == d.Id() {
 				d.Set("group_id", groupId)
 				d.Set("cn", ldapLink.CN)
+				d.Set("filter", ldapLink.Filter)
 				d.Set("group_access", accessLevelValueToName[ldapLink.GroupAccess])
 				d.Set("ldap_provider", ldapLink.Provider)
 				found = true
@@ -161,25 +174,26 @@ func resourceGitlabGroupLdapLinkRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabGroupLdapLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	groupId := d.Get("group_id").(string)
-	cn := d.Get("cn").(string)
-	ldap_provider := d.Get("ldap_provider").(string)
+	options := &gitlab.DeleteGroupLDAPLinkWithCNOrFilterOptions{
+		Provider: gitlab.String(d.Get("ldap_provider").(string)),
+	}
+	if v, ok := d.GetOk("cn"); ok {
+		options.CN = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("filter"); ok {
+		options.Filter = gitlab.String(v.(string))
+	}
 
 	log.Printf("[DEBUG] Delete GitLab group LdapLink %s", d.Id())
-	_, err := client.Groups.DeleteGroupLDAPLinkForProvider(groupId, ldap_provider, cn, gitlab.WithContext(ctx))
+	_, err := client.Groups.DeleteGroupLDAPLinkWithCNOrFilter(groupId, options, gitlab.WithContext(ctx))
 	if err != nil {
-		switch err.(type) { // nolint // TODO: Resolve this golangci-lint issue: S1034: assigning the result of this type assertion to a variable (switch err := err.(type)) could eliminate type assertions in switch cases (gosimple)
-		case *gitlab.ErrorResponse:
-			// Ignore LDAP links that don't exist
-			if strings.Contains(string(err.(*gitlab.ErrorResponse).Message), "Linked LDAP group not found") { // nolint // TODO: Resolve this golangci-lint issue: S1034(related information): could eliminate this type assertion (gosimple)
-				log.Printf("[WARNING] %s", err)
-			} else {
-				return diag.FromErr(err)
-			}
-		default:
+		// Ignore LDAP links that don't exist, i.e. "Linked LDAP group not found"
+		if !is404(err) {
 			return diag.FromErr(err)
 		}
+		log.Printf("[WARNING] %s", err)
 	}
 
 	return nil
@@ -188,7 +202,7 @@ func resourceGitlabGroupLdapLinkDelete(ctx context.Context, d *schema.ResourceDa
 func resourceGitlabGroupLdapLinkImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.SplitN(d.Id(), ":", 3)
 	if len(parts) != 3 {
-		return nil, fmt.Errorf("invalid ldap link import id (should be <group id>:<ldap provider>:<ladp cn>): %s", d.Id())
+		return nil, fmt.Errorf("invalid ldap link import id (should be <group id>:<ldap provider>:<ldap cn or filter>): %s", d.Id())
 	}
 
 	groupId, ldapProvider, ldapCN := parts[0], parts[1], parts[2]
@@ -202,3 +216,12 @@ func resourceGitlabGroupLdapLinkImporter(ctx context.Context, d *schema.Resource
 	}
 	return []*schema.ResourceData{d}, nil
 }
+
+// gitlabGroupLdapLinkKey returns the CN of an LDAP group link or, for links with an LDAP filter, the filter.
+// Together with the provider it identifies the link within its group.
+func gitlabGroupLdapLinkKey(ldapLink *gitlab.LDAPGroupLink) *string {
+	if ldapLink.CN != "" {
+		return &ldapLink.CN
+	}
+	return &ldapLink.Filter
+}
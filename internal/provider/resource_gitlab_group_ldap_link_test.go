@@ -64,6 +64,44 @@ func TestAccGitlabGroupLdapLink_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroupLdapLink_filter(t *testing.T) {
+	testAccCheckEE(t)
+	testAccCheckLDAPEnabled(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupLdapLinkDestroy,
+		Steps: []resource.TestStep{
+			// Link the group with an LDAP filter
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_ldap_link" "this" {
+						group_id      = "%d"
+						filter        = "(department=engineering)"
+						group_access  = "developer"
+						ldap_provider = "ldapmain"
+					}
+				`, testGroup.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_ldap_link.this", "filter", "(department=engineering)"),
+					resource.TestCheckResourceAttr("gitlab_group_ldap_link.this", "cn", ""),
+					resource.TestCheckResourceAttr("gitlab_group_ldap_link.this", "id", "ldapmain:(department=engineering)"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group_ldap_link.this",
+				ImportStateIdFunc:       getGitlabGroupLdapLinkImportID("gitlab_group_ldap_link.this"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
 func getGitlabGroupLdapLinkImportID(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -81,7 +119,10 @@ func getGitlabGroupLdapLinkImportID(resourceName string) resource.ImportStateIdF
 		}
 		ldapCN := rs.Primary.Attributes["cn"]
 		if ldapCN == "" {
-			return "", fmt.Errorf("No LDAP CN is set")
+			ldapCN = rs.Primary.Attributes["filter"]
+		}
+		if ldapCN == "" {
+			return "", fmt.Errorf("No LDAP CN or filter is set")
 		}
 
 		return fmt.Sprintf("%s:%s:%s", groupID, ldapProvider, ldapCN), nil
@@ -168,14 +209,7 @@ func testAccGetGitlabGroupLdapLink(ldapLink *gitlab.LDAPGroupLink, resourceState
 	if err != nil {
 		// The read/GET API wasn't implemented in GitLab until version 12.8 (March 2020, well after the add and delete APIs).
 		// If we 404, assume GitLab is at an older version and take things on faith.
-		switch err.(type) { // nolint // TODO: Resolve this golangci-lint issue: S1034: assigning the result of this type assertion to a variable (switch err := err.(type)) could eliminate type assertions in switch cases (gosimple)
-		case *gitlab.ErrorResponse:
-			if err.(*gitlab.ErrorResponse).Response.StatusCode == 404 { // nolint // TODO: Resolve this golangci-lint issue: S1034(related information): could eliminate this type assertion (gosimple)
-				// Do nothing
-			} else {
-				return err
-			}
-		default:
+		if !is404(err) {
 			return err
 		}
 	}
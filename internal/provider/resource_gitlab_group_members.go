@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_members", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_members`" + ` resource allows to manage all direct members of a group in a single resource.
+
+~> This resource is authoritative: direct members of the group which are not listed in a ` + "`member`" + ` block are removed from the group, except the user the provider is authenticated as. Do not use this resource together with the ` + "`gitlab_group_membership`" + ` resource for the same group.
+
+-> When the resource is destroyed all members are removed from the group, except the user the provider is authenticated as, because a group cannot lose its last owner.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/members.html)`,
+
+		CreateContext: resourceGitlabGroupMembersCreate,
+		ReadContext:   resourceGitlabGroupMembersRead,
+		UpdateContext: resourceGitlabGroupMembersUpdate,
+		DeleteContext: resourceGitlabGroupMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The ID or full path of the group.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"member": {
+				Description: "A direct member of the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         hashGitlabGroupMember,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Description: "The id of the user.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"access_level": {
+							Description:      fmt.Sprintf("Access level for the member. Valid values are: %s.", renderValueListForDocs(validGroupAccessLevelNames)),
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validGroupAccessLevelNames, false)),
+							Required:         true,
+						},
+						"expires_at": {
+							Description:      "Expiration date for the group membership. Format: `YYYY-MM-DD`. RFC3339 timestamps are accepted and normalized to their date.",
+							Type:             schema.TypeString,
+							ValidateFunc:     validateMembershipExpiresAtFunc,
+							DiffSuppressFunc: suppressEquivalentMembershipExpiresAt,
+							Optional:         true,
+						},
+					},
+				},
+			},
+			"ignore_missing_users": {
+				Description: "Whether members whose user does not exist (anymore) should be skipped instead of failing the apply.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"missing_user_ids": {
+				Description: "The IDs of the users of `member` blocks which do not exist (anymore). Only populated if `ignore_missing_users` is enabled.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+	}
+})
+
+// gitlabGroupMember is a direct member of a group as configured in a `member` block.
+type gitlabGroupMember struct {
+	UserID      int
+	AccessLevel string
+	ExpiresAt   string
+}
+
+func resourceGitlabGroupMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] create gitlab group members for group %s", groupID)
+
+	if err := resourceGitlabGroupMembersApply(ctx, d, meta, groupID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(groupID)
+	return resourceGitlabGroupMembersRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	groupID := d.Id()
+
+	log.Printf("[DEBUG] read gitlab group members for group %s", groupID)
+
+	currentMembers, err := listGitlabGroupDirectMembers(ctx, client, groupID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing group members from state", groupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// NOTE: the group ID is only unknown after an import, in which case all members are read.
+	imported := d.Get("group_id").(string) == ""
+	configuredMembers := make(map[int]gitlabGroupMember)
+	for _, member := range expandGitlabGroupMembers(d.Get("member").(*schema.Set)) {
+		configuredMembers[member.UserID] = member
+	}
+
+	members := make([]interface{}, 0, len(currentMembers))
+	var currentUser *gitlab.User
+	for userID, member := range currentMembers {
+		// The current user is never removed from the group, thus it is only read if it's configured,
+		// otherwise it would show up as change on every plan.
+		if _, ok := configuredMembers[userID]; !ok && !imported {
+			if currentUser == nil {
+				currentUser, _, err = client.Users.CurrentUser(gitlab.WithContext(ctx))
+				if err != nil {
+					return diag.Errorf("failed to get the current user: %v", err)
+				}
+			}
+			if userID == currentUser.ID {
+				continue
+			}
+		}
+		members = append(members, flattenGitlabGroupMember(member))
+	}
+
+	// Keep members of users which do not exist anymore, otherwise they would show up as changes on every plan.
+	// Users which are already known to be missing are not looked up again, because deleted user IDs are never reused.
+	missingUserIDs := make([]int, 0)
+	if d.Get("ignore_missing_users").(bool) {
+		knownMissingUserIDs := d.Get("missing_user_ids").(*schema.Set)
+		for userID, member := range configuredMembers {
+			if _, ok := currentMembers[userID]; ok {
+				continue
+			}
+			if !knownMissingUserIDs.Contains(userID) {
+				_, _, err := client.Users.GetUser(userID, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+				if err == nil {
+					continue
+				}
+				if !is404(err) {
+					return diag.Errorf("failed to get user %d: %v", userID, err)
+				}
+			}
+			members = append(members, flattenGitlabGroupMember(member))
+			missingUserIDs = append(missingUserIDs, userID)
+		}
+	}
+
+	d.Set("group_id", groupID)
+	if err := d.Set("member", members); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("missing_user_ids", missingUserIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] update gitlab group members for group %s", d.Id())
+
+	if err := resourceGitlabGroupMembersApply(ctx, d, meta, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceGitlabGroupMembersRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	groupID := d.Id()
+
+	currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to get the current user: %v", err)
+	}
+
+	for _, member := range expandGitlabGroupMembers(d.Get("member").(*schema.Set)) {
+		if member.UserID == currentUser.ID {
+			continue
+		}
+
+		log.Printf("[DEBUG] delete gitlab group member %d of group %s", member.UserID, groupID)
+		if _, err := client.GroupMembers.RemoveGroupMember(groupID, member.UserID, nil, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+			return diag.Errorf("failed to remove user %d from group %s: %v", member.UserID, groupID, err)
+		}
+	}
+	return nil
+}
+
+// resourceGitlabGroupMembersApply adds, updates and removes direct members of the group
+// until they match the configured `member` blocks.
+func resourceGitlabGroupMembersApply(ctx context.Context, d *schema.ResourceData, meta interface{}, groupID string) error {
+	client := meta.(*ProviderMeta).Client
+	ignoreMissingUsers := d.Get("ignore_missing_users").(bool)
+
+	desiredMembers := make(map[int]gitlabGroupMember)
+	for _, member := range expandGitlabGroupMembers(d.Get("member").(*schema.Set)) {
+		if _, ok := desiredMembers[member.UserID]; ok {
+			return fmt.Errorf("user %d is listed more than once in the members of group %s", member.UserID, groupID)
+		}
+		desiredMembers[member.UserID] = member
+	}
+
+	currentMembers, err := listGitlabGroupDirectMembers(ctx, client, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to list members of group %s: %w", groupID, err)
+	}
+
+	currentUser, _, err := client.Users.CurrentUser(gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get the current user: %w", err)
+	}
+
+	for userID, member := range desiredMembers {
+		accessLevel := accessLevelNameToValue[member.AccessLevel]
+		expiresAt := member.ExpiresAt
+
+		currentMember, ok := currentMembers[userID]
+		if !ok {
+			log.Printf("[DEBUG] add user %d to gitlab group %s", userID, groupID)
+			options := &gitlab.AddGroupMemberOptions{
+				UserID:      gitlab.Int(userID),
+				AccessLevel: &accessLevel,
+				ExpiresAt:   &expiresAt,
+			}
+			if _, _, err := client.GroupMembers.AddGroupMember(groupID, options, gitlab.WithContext(ctx)); err != nil {
+				if ignoreMissingUsers && is404(err) {
+					log.Printf("[WARN] user %d does not exist, not adding it to gitlab group %s", userID, groupID)
+					continue
+				}
+				return fmt.Errorf("failed to add user %d to group %s: %w", userID, groupID, err)
+			}
+			continue
+		}
+
+		if currentMember == member {
+			continue
+		}
+
+		log.Printf("[DEBUG] update user %d in gitlab group %s", userID, groupID)
+		options := &gitlab.EditGroupMemberOptions{
+			AccessLevel: &accessLevel,
+			ExpiresAt:   &expiresAt,
+		}
+		if _, _, err := client.GroupMembers.EditGroupMember(groupID, userID, options, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to update user %d in group %s: %w", userID, groupID, err)
+		}
+	}
+
+	for userID := range currentMembers {
+		if _, ok := desiredMembers[userID]; ok {
+			continue
+		}
+		// NOTE: the current user is not removed, because a group cannot lose its last owner.
+		if userID == currentUser.ID {
+			log.Printf("[DEBUG] not removing the current user %d from gitlab group %s", userID, groupID)
+			continue
+		}
+
+		log.Printf("[DEBUG] remove user %d from gitlab group %s", userID, groupID)
+		if _, err := client.GroupMembers.RemoveGroupMember(groupID, userID, nil, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+			return fmt.Errorf("failed to remove user %d from group %s: %w", userID, groupID, err)
+		}
+	}
+
+	return nil
+}
+
+// listGitlabGroupDirectMembers returns the direct members of a group by their user ID.
+func listGitlabGroupDirectMembers(ctx context.Context, client *gitlab.Client, groupID string) (map[int]gitlabGroupMember, error) {
+	options := &gitlab.ListGroupMembersOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+
+	members := make(map[int]gitlabGroupMember)
+	for options.Page != 0 {
+		groupMembers, resp, err := client.Groups.ListGroupMembers(groupID, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, groupMember := range groupMembers {
+			member := gitlabGroupMember{
+				UserID:      groupMember.ID,
+				AccessLevel: accessLevelValueToName[groupMember.AccessLevel],
+			}
+			if groupMember.ExpiresAt != nil {
+				member.ExpiresAt = groupMember.ExpiresAt.String()
+			}
+			members[member.UserID] = member
+		}
+
+		options.Page = resp.NextPage
+	}
+	return members, nil
+}
+
+func expandGitlabGroupMembers(set *schema.Set) []gitlabGroupMember {
+	var members []gitlabGroupMember
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+		expiresAt, _ := normalizeMembershipExpiresAt(m["expires_at"].(string))
+		members = append(members, gitlabGroupMember{
+			UserID:      m["user_id"].(int),
+			AccessLevel: m["access_level"].(string),
+			ExpiresAt:   expiresAt,
+		})
+	}
+	return members
+}
+
+func flattenGitlabGroupMember(member gitlabGroupMember) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":      member.UserID,
+		"access_level": member.AccessLevel,
+		"expires_at":   member.ExpiresAt,
+	}
+}
+
+// hashGitlabGroupMember hashes a `member` block with its normalized expiration date,
+// so that equivalent dates do not result in a different set element.
+func hashGitlabGroupMember(v interface{}) int {
+	m := v.(map[string]interface{})
+	expiresAt, err := normalizeMembershipExpiresAt(m["expires_at"].(string))
+	if err != nil {
+		expiresAt = m["expires_at"].(string)
+	}
+	return schema.HashString(fmt.Sprintf("%d-%s-%s", m["user_id"].(int), m["access_level"].(string), expiresAt))
+}
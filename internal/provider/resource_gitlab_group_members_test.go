@@ -0,0 +1,192 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupMembers_basic(t *testing.T) {
+	currentUser := testAccCurrentUser(t)
+	users := testAccCreateUsers(t, 3)
+	group := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupMembersDestroy(group.ID, users),
+		Steps: []resource.TestStep{
+			// Add two developers
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_members" "foo" {
+  group_id = "%d"
+
+  member {
+    user_id      = %d
+    access_level = "owner"
+  }
+
+  member {
+    user_id      = %d
+    access_level = "developer"
+  }
+
+  member {
+    user_id      = %d
+    access_level = "developer"
+  }
+}
+`, group.ID, currentUser.ID, users[0].ID, users[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "member.#", "3"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.DeveloperPermissions,
+						users[1].ID:    gitlab.DeveloperPermissions,
+					}),
+				),
+			},
+			{
+				ResourceName:            "gitlab_group_members.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_missing_users"},
+			},
+			// Change an access level, remove a member and add a member in a single apply
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_members" "foo" {
+  group_id = "%d"
+
+  member {
+    user_id      = %d
+    access_level = "owner"
+  }
+
+  member {
+    user_id      = %d
+    access_level = "maintainer"
+  }
+
+  member {
+    user_id      = %d
+    access_level = "guest"
+    expires_at   = "2099-01-01"
+  }
+}
+`, group.ID, currentUser.ID, users[0].ID, users[2].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "member.#", "3"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.MaintainerPermissions,
+						users[2].ID:    gitlab.GuestPermissions,
+					}),
+				),
+			},
+			{
+				ResourceName:            "gitlab_group_members.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_missing_users"},
+			},
+			// Skip a user which does not exist
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_members" "foo" {
+  group_id             = "%d"
+  ignore_missing_users = true
+
+  member {
+    user_id      = %d
+    access_level = "owner"
+  }
+
+  member {
+    user_id      = %d
+    access_level = "maintainer"
+  }
+
+  member {
+    user_id      = 999999999
+    access_level = "developer"
+  }
+}
+`, group.ID, currentUser.ID, users[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "member.#", "3"),
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "missing_user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("gitlab_group_members.foo", "missing_user_ids.*", "999999999"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.MaintainerPermissions,
+					}),
+				),
+			},
+			// Keep the current user if it's not listed
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_members" "foo" {
+  group_id = "%d"
+
+  member {
+    user_id      = %d
+    access_level = "maintainer"
+  }
+}
+`, group.ID, users[0].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_members.foo", "member.#", "1"),
+					testAccCheckGitlabGroupMembers(group.ID, map[int]gitlab.AccessLevelValue{
+						currentUser.ID: gitlab.OwnerPermissions,
+						users[0].ID:    gitlab.MaintainerPermissions,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupMembers(groupID int, expected map[int]gitlab.AccessLevelValue) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, _, err := testGitlabClient.Groups.ListGroupMembers(groupID, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(members) != len(expected) {
+			return fmt.Errorf("expected %d members in group %d, got %d", len(expected), groupID, len(members))
+		}
+		for _, member := range members {
+			accessLevel, ok := expected[member.ID]
+			if !ok {
+				return fmt.Errorf("unexpected member %d in group %d", member.ID, groupID)
+			}
+			if member.AccessLevel != accessLevel {
+				return fmt.Errorf("expected access level %d for member %d, got %d", accessLevel, member.ID, member.AccessLevel)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckGitlabGroupMembersDestroy(groupID int, users []*gitlab.User) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, user := range users {
+			_, _, err := testGitlabClient.GroupMembers.GetGroupMember(groupID, user.ID)
+			if err == nil {
+				return fmt.Errorf("user %d is still a member of group %d", user.ID, groupID)
+			}
+			if !is404(err) {
+				return err
+			}
+		}
+		return nil
+	}
+}
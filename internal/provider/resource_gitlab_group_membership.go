@@ -49,10 +49,11 @@ var _ = registerResource("gitlab_group_membership", func() *schema.Resource {
 				Required:         true,
 			},
 			"expires_at": {
-				Description:  "Expiration date for the group membership. Format: `YYYY-MM-DD`",
-				Type:         schema.TypeString,
-				ValidateFunc: validateDateFunc,
-				Optional:     true,
+				Description:      "Expiration date for the group membership. Format: `YYYY-MM-DD`. RFC3339 timestamps are accepted and normalized to their date.",
+				Type:             schema.TypeString,
+				ValidateFunc:     validateMembershipExpiresAtFunc,
+				DiffSuppressFunc: suppressEquivalentMembershipExpiresAt,
+				Optional:         true,
 			},
 			"skip_subresources_on_destroy": {
 				Description: "Whether the deletion of direct memberships of the removed member in subgroups and projects should be skipped. Only used during a destroy.",
@@ -71,11 +72,12 @@ var _ = registerResource("gitlab_group_membership", func() *schema.Resource {
 })
 
 func resourceGitlabGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userId := d.Get("user_id").(int)
 	groupId := d.Get("group_id").(string)
-	expiresAt := d.Get("expires_at").(string)
+	// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+	expiresAt, _ := normalizeMembershipExpiresAt(d.Get("expires_at").(string))
 	accessLevelId := accessLevelNameToValue[d.Get("access_level").(string)]
 
 	options := &gitlab.AddGroupMemberOptions{
@@ -95,7 +97,7 @@ func resourceGitlabGroupMembershipCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab group groupMember %s", id)
 
@@ -131,11 +133,12 @@ func groupIdAndUserIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userId := d.Get("user_id").(int)
 	groupId := d.Get("group_id").(string)
-	expiresAt := d.Get("expires_at").(string)
+	// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+	expiresAt, _ := normalizeMembershipExpiresAt(d.Get("expires_at").(string))
 	accessLevelId := accessLevelNameToValue[strings.ToLower(d.Get("access_level").(string))]
 
 	options := gitlab.EditGroupMemberOptions{
@@ -153,7 +156,7 @@ func resourceGitlabGroupMembershipUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id := d.Id()
 	groupId, userId, err := groupIdAndUserIdFromId(id)
@@ -92,6 +92,37 @@ func TestAccGitlabGroupMembership_skipRemoveFromSubgroup(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroupMembership_rfc3339ExpiresAt(t *testing.T) {
+	testUser := testAccCreateUsers(t, 1)[0]
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	config := fmt.Sprintf(`
+resource "gitlab_group_membership" "foo" {
+  group_id     = "%d"
+  user_id      = %d
+  access_level = "developer"
+  expires_at   = "2099-12-31T00:00:00Z"
+}
+`, testGroup.ID, testUser.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupMembershipDestroy,
+		Steps: []resource.TestStep{
+			// Create the membership with a RFC3339 expiration date
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("gitlab_group_membership.foo", "expires_at", "2099-12-31"),
+			},
+			// Verify that there is no diff after a refresh
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabGroupMembershipExists(n string, membership *gitlab.GroupMember) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_milestone", func() *schema.Resource {
+	validMilestoneStates := []string{"active", "closed"}
+
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_milestone`" + ` resource allows to manage the lifecycle of a group milestone.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_milestones.html)`,
+
+		CreateContext: resourceGitlabGroupMilestoneCreate,
+		ReadContext:   resourceGitlabGroupMilestoneRead,
+		UpdateContext: resourceGitlabGroupMilestoneUpdate,
+		DeleteContext: resourceGitlabGroupMilestoneDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or URL-encoded path of the group.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"title": {
+				Description: "The title of the milestone.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the milestone.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"due_date": {
+				Description:      "The due date of the milestone. Date time string in the format YYYY-MM-DD, for example 2016-03-11.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isISO6801Date,
+			},
+			"start_date": {
+				Description:      "The start date of the milestone. Date time string in the format YYYY-MM-DD, for example 2016-03-11.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isISO6801Date,
+			},
+			// NOTE: not part of `CREATE`, but part of `UPDATE` with the `state_event` field.
+			"state": {
+				Description:      fmt.Sprintf("The state of the milestone. Valid values are: %s.", renderValueListForDocs(validMilestoneStates)),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "active",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validMilestoneStates, false)),
+			},
+			"created_at": {
+				Description: "The time of creation of the milestone. Date time string, ISO 8601 formatted, for example 2016-03-11T03:45:40Z.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expired": {
+				Description: "Bool, true if the milestone expired.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"iid": {
+				Description: "The ID of the group's milestone.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"milestone_id": {
+				Description: "The instance-wide ID of the group's milestone.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"group_id": {
+				Description: "The group ID of the milestone.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The last update time of the milestone. Date time string, ISO 8601 formatted, for example 2016-03-11T03:45:40Z.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupMilestoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Get("group").(string)
+	title := d.Get("title").(string)
+
+	options := &gitlab.CreateGroupMilestoneOptions{
+		Title: &title,
+	}
+	if description, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(description.(string))
+	}
+	if startDate, ok := d.GetOk("start_date"); ok {
+		parsedStartDate, err := parseISO8601Date(startDate.(string))
+		if err != nil {
+			return diag.Errorf("Failed to parse start_date: %s. %v", startDate.(string), err)
+		}
+		options.StartDate = parsedStartDate
+	}
+	if dueDate, ok := d.GetOk("due_date"); ok {
+		parsedDueDate, err := parseISO8601Date(dueDate.(string))
+		if err != nil {
+			return diag.Errorf("Failed to parse due_date: %s. %v", dueDate.(string), err)
+		}
+		options.DueDate = parsedDueDate
+	}
+
+	log.Printf("[DEBUG] create gitlab milestone in group %s with title %s", group, title)
+	milestone, _, err := client.GroupMilestones.CreateGroupMilestone(group, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create milestone %q in group %s: %v", title, group, err)
+	}
+	d.SetId(resourceGitlabGroupMilestoneBuildID(group, milestone.ID))
+
+	// Milestones are always created active, other states can only be reached with a `state_event`.
+	if state := d.Get("state").(string); state != milestone.State {
+		options := &gitlab.UpdateGroupMilestoneOptions{
+			StateEvent: gitlab.String(milestoneStateToStateEvent[state]),
+		}
+		if _, _, err := client.GroupMilestones.UpdateGroupMilestone(group, milestone.ID, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to update milestone %d in group %s right after creation: %v", milestone.ID, group, err)
+		}
+	}
+
+	return resourceGitlabGroupMilestoneRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupMilestoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, milestoneID, err := resourceGitlabGroupMilestoneParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab milestone in group %s with ID %d", group, milestoneID)
+	milestone, _, err := client.GroupMilestones.GetGroupMilestone(group, milestoneID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[WARN] gitlab milestone %d in group %s not found, removing from state", milestoneID, group)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read milestone %d in group %s: %v", milestoneID, group, err)
+	}
+
+	stateMap := gitlabGroupMilestoneToStateMap(group, milestone)
+	if err = setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupMilestoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, milestoneID, err := resourceGitlabGroupMilestoneParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	options := &gitlab.UpdateGroupMilestoneOptions{}
+	if d.HasChange("title") {
+		options.Title = gitlab.String(d.Get("title").(string))
+	}
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+	if d.HasChange("start_date") {
+		startDate := d.Get("start_date").(string)
+		parsedStartDate, err := parseISO8601Date(startDate)
+		if err != nil {
+			return diag.Errorf("Failed to parse start_date: %s. %v", startDate, err)
+		}
+		options.StartDate = parsedStartDate
+	}
+	if d.HasChange("due_date") {
+		dueDate := d.Get("due_date").(string)
+		parsedDueDate, err := parseISO8601Date(dueDate)
+		if err != nil {
+			return diag.Errorf("Failed to parse due_date: %s. %v", dueDate, err)
+		}
+		options.DueDate = parsedDueDate
+	}
+	if d.HasChange("state") {
+		options.StateEvent = gitlab.String(milestoneStateToStateEvent[d.Get("state").(string)])
+	}
+
+	log.Printf("[DEBUG] update gitlab milestone in group %s with ID %d", group, milestoneID)
+	if _, _, err := client.GroupMilestones.UpdateGroupMilestone(group, milestoneID, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("failed to update milestone %d in group %s: %v", milestoneID, group, err)
+	}
+
+	return resourceGitlabGroupMilestoneRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupMilestoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, milestoneID, err := resourceGitlabGroupMilestoneParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab milestone in group %s with ID %d", group, milestoneID)
+	if _, err := client.GroupMilestones.DeleteGroupMilestone(group, milestoneID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to delete milestone %d in group %s: %v", milestoneID, group, err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupMilestoneParseID(id string) (string, int, error) {
+	group, milestone, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	milestoneID, err := strconv.Atoi(milestone)
+	if err != nil {
+		return "", 0, fmt.Errorf("expected milestone ID to be a number in %q: %w", id, err)
+	}
+
+	return group, milestoneID, nil
+}
+
+func resourceGitlabGroupMilestoneBuildID(group string, milestoneID int) string {
+	stringMilestoneID := strconv.Itoa(milestoneID)
+	return buildTwoPartID(&group, &stringMilestoneID)
+}
+
+func gitlabGroupMilestoneToStateMap(group string, milestone *gitlab.GroupMilestone) map[string]interface{} {
+	stateMap := make(map[string]interface{})
+	stateMap["iid"] = milestone.IID
+	stateMap["milestone_id"] = milestone.ID
+	stateMap["group"] = group
+	stateMap["group_id"] = milestone.GroupID
+	stateMap["title"] = milestone.Title
+	stateMap["description"] = milestone.Description
+	if milestone.DueDate != nil {
+		stateMap["due_date"] = milestone.DueDate.String()
+	} else {
+		stateMap["due_date"] = nil
+	}
+	if milestone.StartDate != nil {
+		stateMap["start_date"] = milestone.StartDate.String()
+	} else {
+		stateMap["start_date"] = nil
+	}
+	if milestone.UpdatedAt != nil {
+		stateMap["updated_at"] = milestone.UpdatedAt.Format(time.RFC3339)
+	} else {
+		stateMap["updated_at"] = nil
+	}
+	if milestone.CreatedAt != nil {
+		stateMap["created_at"] = milestone.CreatedAt.Format(time.RFC3339)
+	} else {
+		stateMap["created_at"] = nil
+	}
+	stateMap["state"] = milestone.State
+	if milestone.Expired != nil {
+		stateMap["expired"] = milestone.Expired
+	} else {
+		stateMap["expired"] = false
+	}
+
+	return stateMap
+}
@@ -0,0 +1,128 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabGroupMilestone_basic(t *testing.T) {
+	rInt1, rInt2 := acctest.RandInt(), acctest.RandInt()
+	group := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupMilestoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				// create Milestone with required values only
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_milestone" "this" {
+					group = "%v"
+					title = "test-%d"
+				}`, group.FullPath, rInt1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.this", "state", "active"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.this", "group_id", fmt.Sprintf("%d", group.ID)),
+					resource.TestCheckResourceAttrSet("gitlab_group_milestone.this", "iid"),
+					resource.TestCheckResourceAttrSet("gitlab_group_milestone.this", "milestone_id"),
+					resource.TestCheckResourceAttrSet("gitlab_group_milestone.this", "updated_at"),
+					resource.TestCheckResourceAttrSet("gitlab_group_milestone.this", "created_at"),
+					resource.TestCheckResourceAttrSet("gitlab_group_milestone.this", "expired"),
+				),
+			},
+			{
+				// verify import
+				ResourceName:      "gitlab_group_milestone.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// update some Milestone attributes and close it
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_milestone" "this" {
+					group       = "%[1]v"
+					title       = "test-%[2]d"
+					description = "test-%[2]d"
+					start_date  = "2022-04-10"
+					due_date    = "2022-04-15"
+					state       = "closed"
+				}`, group.FullPath, rInt2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_milestone.this", "title", fmt.Sprintf("test-%d", rInt2)),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.this", "state", "closed"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.this", "start_date", "2022-04-10"),
+					resource.TestCheckResourceAttr("gitlab_group_milestone.this", "due_date", "2022-04-15"),
+				),
+			},
+			{
+				// verify import
+				ResourceName:      "gitlab_group_milestone.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// reopen the Milestone
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_milestone" "this" {
+					group       = "%[1]v"
+					title       = "test-%[2]d"
+					description = "test-%[2]d"
+					start_date  = "2022-04-10"
+					due_date    = "2022-04-15"
+					state       = "active"
+				}`, group.FullPath, rInt2),
+				Check: resource.TestCheckResourceAttr("gitlab_group_milestone.this", "state", "active"),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroupMilestone_createClosed(t *testing.T) {
+	group := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupMilestoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_group_milestone" "this" {
+					group = "%d"
+					title = "closed"
+					state = "closed"
+				}`, group.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_milestone.this", "state", "closed"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupMilestoneDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_milestone" {
+			continue
+		}
+		group, milestoneID, err := resourceGitlabGroupMilestoneParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		milestone, _, err := testGitlabClient.GroupMilestones.GetGroupMilestone(group, milestoneID)
+		if err == nil && milestone != nil {
+			return errors.New("Milestone still exists")
+		}
+		if !is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
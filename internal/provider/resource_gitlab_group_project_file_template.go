@@ -53,7 +53,7 @@ For more information about which file types are available as templates, view
 })
 
 func resourceGitLabGroupProjectFileTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	groupID := d.Get("group_id").(int)
 	group, _, err := client.Groups.GetGroup(groupID, nil, gitlab.WithContext(ctx))
@@ -78,7 +78,7 @@ func resourceGitLabGroupProjectFileTemplateRead(ctx context.Context, d *schema.R
 }
 
 func resourceGitLabGroupProjectFileTemplateCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	groupID := d.Get("group_id").(int)
 	projectID := gitlab.Int(d.Get("file_template_project_id").(int))
@@ -97,7 +97,7 @@ func resourceGitLabGroupProjectFileTemplateCreateOrUpdate(ctx context.Context, d
 }
 
 func resourceGitLabGroupProjectFileTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	groupID := d.Get("group_id").(int)
 	options := &gitlab.UpdateGroupOptions{}
 
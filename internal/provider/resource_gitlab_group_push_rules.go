@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_push_rules", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_push_rules`" + ` resource allows to manage the lifecycle of the push rules of a group.
+
+-> This resource requires a GitLab Enterprise instance.
+
+~> A group has exactly one set of push rules. Creating this resource edits already existing push rules of the group instead of failing.
+
+-> Group push rules are the default for projects which are created in the group or its subgroups afterwards. They are copied to a new project when it is created, so later changes of the group push rules do not affect existing projects, and push rules of a project always take precedence over those of its group.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#push-rules)`,
+
+		CreateContext: resourceGitlabGroupPushRulesCreate,
+		ReadContext:   resourceGitlabGroupPushRulesRead,
+		UpdateContext: resourceGitlabGroupPushRulesUpdate,
+		DeleteContext: resourceGitlabGroupPushRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: gitlabGroupPushRulesGetSchema(),
+	}
+})
+
+func gitlabGroupPushRulesGetSchema() map[string]*schema.Schema {
+	pushRulesSchema := gitlabPushRulesResourceGetSchema()
+	pushRulesSchema["group"] = &schema.Schema{
+		Description: "The ID or full path of the group.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+	return pushRulesSchema
+}
+
+func resourceGitlabGroupPushRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Get("group").(string)
+
+	// NOTE: push rules id `0` indicates that there haven't been any push rules set.
+	pushRules, _, err := client.Groups.GetGroupPushRules(group, gitlab.WithContext(ctx))
+	if err != nil && !is404(err) {
+		return diag.Errorf("failed to get push rules for group %q: %v", group, err)
+	}
+
+	if err != nil || pushRules.ID == 0 {
+		log.Printf("[DEBUG] create gitlab group push rules for group %q", group)
+		options := &gitlab.AddGroupPushRuleOptions{
+			AuthorEmailRegex:           gitlab.String(d.Get("author_email_regex").(string)),
+			BranchNameRegex:            gitlab.String(d.Get("branch_name_regex").(string)),
+			CommitCommitterCheck:       gitlab.Bool(d.Get("commit_committer_check").(bool)),
+			CommitCommitterNameCheck:   gitlab.Bool(d.Get("commit_committer_name_check").(bool)),
+			CommitMessageNegativeRegex: gitlab.String(d.Get("commit_message_negative_regex").(string)),
+			CommitMessageRegex:         gitlab.String(d.Get("commit_message_regex").(string)),
+			DenyDeleteTag:              gitlab.Bool(d.Get("deny_delete_tag").(bool)),
+			FileNameRegex:              gitlab.String(d.Get("file_name_regex").(string)),
+			MaxFileSize:                gitlab.Int(d.Get("max_file_size").(int)),
+			MemberCheck:                gitlab.Bool(d.Get("member_check").(bool)),
+			PreventSecrets:             gitlab.Bool(d.Get("prevent_secrets").(bool)),
+			RejectUnsignedCommits:      gitlab.Bool(d.Get("reject_unsigned_commits").(bool)),
+			RejectNonDCOCommits:        gitlab.Bool(d.Get("reject_non_dco_commits").(bool)),
+		}
+		if _, _, err := client.Groups.AddGroupPushRule(group, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to create push rules for group %q: %v", group, err)
+		}
+	} else {
+		// Push rules are a singleton per group, so existing push rules are taken over.
+		log.Printf("[DEBUG] gitlab group push rules already exist for group %q, editing them", group)
+		if err := resourceGitlabGroupPushRulesEdit(ctx, client, group, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(group)
+	return resourceGitlabGroupPushRulesRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupPushRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Id()
+
+	log.Printf("[DEBUG] read gitlab group push rules for group %q", group)
+
+	pushRules, _, err := client.Groups.GetGroupPushRules(group, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group push rules for group %q not found, removing from state", group)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to get push rules for group %q: %v", group, err)
+	}
+
+	if pushRules.ID == 0 {
+		log.Printf("[DEBUG] gitlab group %q has no push rules, removing from state", group)
+		d.SetId("")
+		return nil
+	}
+
+	stateMap := gitlabGroupPushRulesToStateMap(group, pushRules)
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupPushRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	log.Printf("[DEBUG] update gitlab group push rules for group %q", d.Id())
+
+	if err := resourceGitlabGroupPushRulesEdit(ctx, client, d.Id(), d); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceGitlabGroupPushRulesRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupPushRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	log.Printf("[DEBUG] delete gitlab group push rules for group %q", d.Id())
+
+	if _, err := client.Groups.DeleteGroupPushRule(d.Id(), gitlab.WithContext(ctx)); err != nil {
+		if is404(err) {
+			return nil
+		}
+		return diag.Errorf("failed to delete push rules for group %q: %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceGitlabGroupPushRulesEdit(ctx context.Context, client *gitlab.Client, group string, d *schema.ResourceData) error {
+	options := &gitlab.EditGroupPushRuleOptions{
+		AuthorEmailRegex:           gitlab.String(d.Get("author_email_regex").(string)),
+		BranchNameRegex:            gitlab.String(d.Get("branch_name_regex").(string)),
+		CommitCommitterCheck:       gitlab.Bool(d.Get("commit_committer_check").(bool)),
+		CommitCommitterNameCheck:   gitlab.Bool(d.Get("commit_committer_name_check").(bool)),
+		CommitMessageNegativeRegex: gitlab.String(d.Get("commit_message_negative_regex").(string)),
+		CommitMessageRegex:         gitlab.String(d.Get("commit_message_regex").(string)),
+		DenyDeleteTag:              gitlab.Bool(d.Get("deny_delete_tag").(bool)),
+		FileNameRegex:              gitlab.String(d.Get("file_name_regex").(string)),
+		MaxFileSize:                gitlab.Int(d.Get("max_file_size").(int)),
+		MemberCheck:                gitlab.Bool(d.Get("member_check").(bool)),
+		PreventSecrets:             gitlab.Bool(d.Get("prevent_secrets").(bool)),
+		RejectUnsignedCommits:      gitlab.Bool(d.Get("reject_unsigned_commits").(bool)),
+		RejectNonDCOCommits:        gitlab.Bool(d.Get("reject_non_dco_commits").(bool)),
+	}
+
+	_, _, err := client.Groups.EditGroupPushRule(group, options, gitlab.WithContext(ctx))
+	return err
+}
+
+func gitlabGroupPushRulesToStateMap(group string, pushRules *gitlab.GroupPushRules) map[string]interface{} {
+	stateMap := make(map[string]interface{})
+	stateMap["group"] = group
+	stateMap["author_email_regex"] = pushRules.AuthorEmailRegex
+	stateMap["branch_name_regex"] = pushRules.BranchNameRegex
+	stateMap["commit_committer_check"] = pushRules.CommitCommitterCheck
+	stateMap["commit_committer_name_check"] = pushRules.CommitCommitterNameCheck
+	stateMap["commit_message_negative_regex"] = pushRules.CommitMessageNegativeRegex
+	stateMap["commit_message_regex"] = pushRules.CommitMessageRegex
+	stateMap["deny_delete_tag"] = pushRules.DenyDeleteTag
+	stateMap["file_name_regex"] = pushRules.FileNameRegex
+	stateMap["max_file_size"] = pushRules.MaxFileSize
+	stateMap["member_check"] = pushRules.MemberCheck
+	stateMap["prevent_secrets"] = pushRules.PreventSecrets
+	stateMap["reject_unsigned_commits"] = pushRules.RejectUnsignedCommits
+	stateMap["reject_non_dco_commits"] = pushRules.RejectNonDCOCommits
+	return stateMap
+}
@@ -0,0 +1,91 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabGroupPushRules_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	group := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupPushRulesDestroy(group.ID),
+		Steps: []resource.TestStep{
+			// Create push rules
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_push_rules" "foo" {
+  group                = %d
+  commit_message_regex = "^(feat|fix): "
+  prevent_secrets      = true
+}
+`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.foo", "commit_message_regex", "^(feat|fix): "),
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.foo", "prevent_secrets", "true"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Disable prevent_secrets
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_push_rules" "foo" {
+  group                = %d
+  commit_message_regex = "^(feat|fix): "
+  prevent_secrets      = false
+}
+`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.foo", "prevent_secrets", "false"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_group_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Enable prevent_secrets again
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_group_push_rules" "foo" {
+  group                = %d
+  commit_message_regex = "^(feat|fix): "
+  prevent_secrets      = true
+}
+`, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_group_push_rules.foo", "prevent_secrets", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupPushRulesDestroy(groupID int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		pushRules, _, err := testGitlabClient.Groups.GetGroupPushRules(groupID)
+		if err != nil {
+			if is404(err) {
+				return nil
+			}
+			return err
+		}
+		if pushRules.ID != 0 {
+			return fmt.Errorf("push rules of group %d still exist", groupID)
+		}
+		return nil
+	}
+}
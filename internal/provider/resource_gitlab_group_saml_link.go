@@ -23,6 +23,8 @@ var _ = registerResource("gitlab_group_saml_link", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_group_saml_link`" + ` resource allows to manage the lifecycle of an SAML integration with a group.
 
+-> This resource requires a GitLab Enterprise instance with SAML authentication enabled.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/groups.html#saml-group-links)`,
 
 		CreateContext: resourceGitlabGroupSamlLinkCreate,
@@ -57,7 +59,7 @@ var _ = registerResource("gitlab_group_saml_link", func() *schema.Resource {
 })
 
 func resourceGitlabGroupSamlLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group := d.Get("group").(string)
 	samlGroupName := d.Get("saml_group_name").(string)
@@ -79,7 +81,7 @@ func resourceGitlabGroupSamlLinkCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupSamlLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group, samlGroupName, parse_err := parseTwoPartID(d.Id())
 	if parse_err != nil {
 		return diag.FromErr(parse_err)
@@ -105,7 +107,7 @@ func resourceGitlabGroupSamlLinkRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabGroupSamlLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group, samlGroupName, parse_err := parseTwoPartID(d.Id())
 	if parse_err != nil {
 		return diag.FromErr(parse_err)
@@ -14,6 +14,7 @@ import (
 func TestAccGitlabGroupSamlLink_basic(t *testing.T) {
 	testAccCheckEE(t)
 	testAccRequiresAtLeast(t, "15.3")
+	testAccCheckSAMLEnabled(t)
 
 	testGroup := testAccCreateGroups(t, 1)[0]
 
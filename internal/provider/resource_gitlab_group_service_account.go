@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_service_account", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_service_account`" + ` resource allows to manage the lifecycle of a service account user of a group.
+
+-> Service accounts can only be created in top-level groups and require the owner role of the group.
+
+-> This resource requires a GitLab Enterprise instance with at least GitLab 16.1. Deleting a service account requires at least GitLab 17.1.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_service_accounts.html)`,
+
+		CreateContext: resourceGitlabGroupServiceAccountCreate,
+		ReadContext:   resourceGitlabGroupServiceAccountRead,
+		DeleteContext: resourceGitlabGroupServiceAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or URL-encoded path of the top-level group the service account belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the service account user. Generated by GitLab if not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"username": {
+				Description: "The username of the service account user. Generated by GitLab if not set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "The ID of the service account user.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Get("group").(string)
+
+	options := &gitlab.CreateServiceAccountOptions{}
+	if v, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("username"); ok {
+		options.Username = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] create gitlab service account in group %s", group)
+	serviceAccount, _, err := client.Groups.CreateServiceAccount(group, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create service account in group %s: %v", group, err)
+	}
+
+	d.SetId(resourceGitlabGroupServiceAccountBuildID(group, serviceAccount.ID))
+	return resourceGitlabGroupServiceAccountRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, userID, err := resourceGitlabGroupServiceAccountParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab service account %d of group %s", userID, group)
+	serviceAccount, err := resourceGitlabGroupServiceAccountFind(ctx, client, group, userID)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab group %s not found, removing service account %d from state", group, userID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read service accounts of group %s: %v", group, err)
+	}
+	if serviceAccount == nil {
+		log.Printf("[DEBUG] gitlab service account %d not found in group %s, removing from state", userID, group)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group", group)
+	d.Set("name", serviceAccount.Name)
+	d.Set("username", serviceAccount.UserName)
+	d.Set("user_id", serviceAccount.ID)
+
+	return nil
+}
+
+func resourceGitlabGroupServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, userID, err := resourceGitlabGroupServiceAccountParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab service account %d of group %s", userID, group)
+	if _, err := client.Groups.DeleteServiceAccount(group, userID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to delete service account %d of group %s: %v", userID, group, err)
+	}
+
+	return nil
+}
+
+// resourceGitlabGroupServiceAccountFind returns the service account with the given user ID
+// or nil if the group doesn't have such a service account.
+func resourceGitlabGroupServiceAccountFind(ctx context.Context, client *gitlab.Client, group string, userID int) (*gitlab.GroupServiceAccount, error) {
+	options := &gitlab.ListServiceAccountsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+			Page:    1,
+		},
+	}
+
+	for options.Page != 0 {
+		serviceAccounts, resp, err := client.Groups.ListServiceAccounts(group, options, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, serviceAccount := range serviceAccounts {
+			if serviceAccount.ID == userID {
+				return serviceAccount, nil
+			}
+		}
+		options.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+func resourceGitlabGroupServiceAccountBuildID(group string, userID int) string {
+	return fmt.Sprintf("%s:%d", group, userID)
+}
+
+func resourceGitlabGroupServiceAccountParseID(id string) (string, int, error) {
+	group, rawUserID, err := parseTwoPartID(id)
+	if err != nil {
+		return "", 0, err
+	}
+
+	userID, err := strconv.Atoi(rawUserID)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid service account user id %q in %q, expected integer", rawUserID, id)
+	}
+
+	return group, userID, nil
+}
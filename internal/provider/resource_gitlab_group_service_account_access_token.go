@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_group_service_account_access_token", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_group_service_account_access_token`" + ` resource allows to manage the lifecycle of a personal access token of a group service account.
+
+-> Reading the token requires administrator privileges, because it uses the personal access tokens API to look the token up.
+
+-> This resource requires a GitLab Enterprise instance with at least GitLab 16.1.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_service_accounts.html#create-a-personal-access-token-for-a-service-account-user)`,
+
+		CreateContext: resourceGitlabGroupServiceAccountAccessTokenCreate,
+		ReadContext:   resourceGitlabGroupServiceAccountAccessTokenRead,
+		DeleteContext: resourceGitlabGroupServiceAccountAccessTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Description: "The ID or URL-encoded path of the top-level group the service account belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "The ID of the service account user.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the personal access token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scopes": {
+				Description: fmt.Sprintf("The scopes of the personal access token. Valid values are: %s.", renderValueListForDocs(validPersonalAccessTokenScopes)),
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(validPersonalAccessTokenScopes, false),
+				},
+			},
+			"expires_at": {
+				Description:      "The token expires at midnight UTC on that date. The date must be in the format YYYY-MM-DD. Defaults to the maximum lifetime allowed by GitLab.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: isISO6801Date,
+			},
+			"active": {
+				Description: "True if the token is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"revoked": {
+				Description: "True if the token is revoked.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Time the token has been created, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"token": {
+				Description: "The personal access token. This is only populated when creating a new token. This attribute is not available for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+})
+
+func resourceGitlabGroupServiceAccountAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group := d.Get("group").(string)
+	userID := d.Get("user_id").(int)
+
+	options := &gitlab.CreateServiceAccountPersonalAccessTokenOptions{
+		Name:   gitlab.String(d.Get("name").(string)),
+		Scopes: stringSetToStringSlice(d.Get("scopes").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		parsedExpiresAt, err := parseISO8601Date(v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse expires_at '%s' as ISO8601 formatted date: %v", v.(string), err)
+		}
+		options.ExpiresAt = parsedExpiresAt
+	}
+
+	log.Printf("[DEBUG] create gitlab personal access token %s for service account %d of group %s", *options.Name, userID, group)
+	token, _, err := client.Groups.CreateServiceAccountPersonalAccessToken(group, userID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create personal access token for service account %d of group %s: %v", userID, group, err)
+	}
+
+	d.SetId(resourceGitlabGroupServiceAccountAccessTokenBuildID(group, userID, token.ID))
+	// NOTE: the token can only be read once after creating it
+	d.Set("token", token.Token)
+
+	return resourceGitlabGroupServiceAccountAccessTokenRead(ctx, d, meta)
+}
+
+func resourceGitlabGroupServiceAccountAccessTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	group, userID, tokenID, err := resourceGitlabGroupServiceAccountAccessTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab personal access token %d of service account %d", tokenID, userID)
+	token, err := resourceGitlabPersonalAccessTokenFind(ctx, client, userID, tokenID)
+	if errors.Is(err, errResourceGitlabPersonalAccessTokenNotFound) {
+		log.Printf("[DEBUG] gitlab personal access token %d of service account %d not found, removing from state", tokenID, userID)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("group", group)
+	d.Set("user_id", userID)
+	d.Set("name", token.Name)
+	if token.ExpiresAt != nil {
+		d.Set("expires_at", token.ExpiresAt.String())
+	}
+	d.Set("active", token.Active)
+	d.Set("revoked", token.Revoked)
+	if token.CreatedAt != nil {
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+	if err := d.Set("scopes", token.Scopes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupServiceAccountAccessTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	_, _, tokenID, err := resourceGitlabGroupServiceAccountAccessTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] revoke gitlab personal access token %s", d.Id())
+	if _, err := client.PersonalAccessTokens.RevokePersonalAccessToken(tokenID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to revoke personal access token %d: %v", tokenID, err)
+	}
+
+	return nil
+}
+
+func resourceGitlabGroupServiceAccountAccessTokenBuildID(group string, userID int, tokenID int) string {
+	return fmt.Sprintf("%s:%d:%d", group, userID, tokenID)
+}
+
+func resourceGitlabGroupServiceAccountAccessTokenParseID(id string) (string, int, int, error) {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return "", 0, 0, fmt.Errorf("unexpected ID format (%q), expected group:user_id:token_id", id)
+	}
+
+	userID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid service account user id %q in %q, expected integer", parts[1], id)
+	}
+
+	tokenID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid token id %q in %q, expected integer", parts[2], id)
+	}
+
+	return parts[0], userID, tokenID, nil
+}
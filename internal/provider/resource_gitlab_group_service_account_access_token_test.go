@@ -0,0 +1,93 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabGroupServiceAccountAccessToken_basic(t *testing.T) {
+	testAccCheckEE(t)
+	testAccRequiresAtLeast(t, "16.1")
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	serviceAccount, _, err := testGitlabClient.Groups.CreateServiceAccount(testGroup.ID, &gitlab.CreateServiceAccountOptions{})
+	if err != nil {
+		t.Fatalf("failed to create service account: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupServiceAccountAccessTokenDestroy,
+		Steps: []resource.TestStep{
+			// Create a token for the service account
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_service_account_access_token" "this" {
+						group      = "%d"
+						user_id    = %d
+						name       = "ci"
+						scopes     = ["api"]
+						expires_at = "2099-01-01"
+					}
+				`, testGroup.ID, serviceAccount.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_group_service_account_access_token.this", "token"),
+					resource.TestCheckResourceAttrSet("gitlab_group_service_account_access_token.this", "created_at"),
+					resource.TestCheckResourceAttr("gitlab_group_service_account_access_token.this", "revoked", "false"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group_service_account_access_token.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Recreate the token with other scopes
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_service_account_access_token" "this" {
+						group      = "%d"
+						user_id    = %d
+						name       = "ci"
+						scopes     = ["read_api"]
+						expires_at = "2099-01-01"
+					}
+				`, testGroup.ID, serviceAccount.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_group_service_account_access_token.this", "scopes.#", "1"),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupServiceAccountAccessTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_service_account_access_token" {
+			continue
+		}
+
+		_, userID, tokenID, err := resourceGitlabGroupServiceAccountAccessTokenParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = resourceGitlabPersonalAccessTokenFind(context.Background(), testGitlabClient, userID, tokenID)
+		if errors.Is(err, errResourceGitlabPersonalAccessTokenNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("personal access token %d of service account %d is still active", tokenID, userID)
+	}
+	return nil
+}
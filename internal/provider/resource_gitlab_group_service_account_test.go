@@ -0,0 +1,93 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabGroupServiceAccount_basic(t *testing.T) {
+	testAccCheckEE(t)
+	testAccRequiresAtLeast(t, "17.1")
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	username := fmt.Sprintf("service-account-%s", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			// Create a service account with a token
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group_service_account" "this" {
+						group    = "%d"
+						name     = "Deployment bot"
+						username = "%s"
+					}
+
+					resource "gitlab_group_service_account_access_token" "this" {
+						group      = gitlab_group_service_account.this.group
+						user_id    = gitlab_group_service_account.this.user_id
+						name       = "deploy"
+						scopes     = ["read_api", "read_registry"]
+						expires_at = "2099-01-01"
+					}
+				`, testGroup.ID, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_group_service_account.this", "user_id"),
+					resource.TestCheckResourceAttr("gitlab_group_service_account.this", "name", "Deployment bot"),
+					resource.TestCheckResourceAttr("gitlab_group_service_account.this", "username", username),
+					resource.TestCheckResourceAttrSet("gitlab_group_service_account_access_token.this", "token"),
+					resource.TestCheckResourceAttr("gitlab_group_service_account_access_token.this", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_group_service_account_access_token.this", "scopes.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_group_service_account_access_token.this", "expires_at", "2099-01-01"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_group_service_account.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "gitlab_group_service_account_access_token.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabGroupServiceAccountDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_group_service_account" {
+			continue
+		}
+
+		group, userID, err := resourceGitlabGroupServiceAccountParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		serviceAccount, err := resourceGitlabGroupServiceAccountFind(context.Background(), testGitlabClient, group, userID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if serviceAccount != nil {
+			return fmt.Errorf("service account %d of group %s still exists", userID, group)
+		}
+	}
+	return nil
+}
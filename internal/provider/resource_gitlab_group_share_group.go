@@ -47,11 +47,12 @@ var _ = registerResource("gitlab_group_share_group", func() *schema.Resource {
 				Required:         true,
 			},
 			"expires_at": {
-				Description:  "Share expiration date. Format: `YYYY-MM-DD`",
-				Type:         schema.TypeString,
-				ValidateFunc: validateDateFunc,
-				ForceNew:     true,
-				Optional:     true,
+				Description:      "Share expiration date. Format: `YYYY-MM-DD`. RFC3339 timestamps are accepted and normalized to their date.",
+				Type:             schema.TypeString,
+				ValidateFunc:     validateMembershipExpiresAtFunc,
+				DiffSuppressFunc: suppressEquivalentMembershipExpiresAt,
+				ForceNew:         true,
+				Optional:         true,
 			},
 		},
 	}
@@ -61,13 +62,15 @@ func resourceGitlabGroupShareGroupCreate(ctx context.Context, d *schema.Resource
 	groupId := d.Get("group_id").(string)
 	shareGroupId := d.Get("share_group_id").(int)
 	groupAccess := accessLevelNameToValue[d.Get("group_access").(string)]
+	// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+	expiresAt, _ := normalizeMembershipExpiresAt(d.Get("expires_at").(string))
 	options := &gitlab.ShareWithGroupOptions{
 		GroupID:     &shareGroupId,
 		GroupAccess: &groupAccess,
-		ExpiresAt:   gitlab.String(d.Get("expires_at").(string)),
+		ExpiresAt:   &expiresAt,
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	log.Printf("[DEBUG] create gitlab group share for %d in %s", shareGroupId, groupId)
 
 	_, _, err := client.GroupMembers.ShareWithGroup(groupId, options, gitlab.WithContext(ctx))
@@ -82,7 +85,7 @@ func resourceGitlabGroupShareGroupCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabGroupShareGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab shared groups %s", id)
 
@@ -127,7 +130,7 @@ func resourceGitlabGroupShareGroupRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupShareGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	id := d.Id()
 
 	groupId, sharedGroupId, err := groupIdsFromId(id)
@@ -139,6 +142,9 @@ func resourceGitlabGroupShareGroupDelete(ctx context.Context, d *schema.Resource
 
 	_, err = client.GroupMembers.DeleteShareWithGroup(groupId, sharedGroupId, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
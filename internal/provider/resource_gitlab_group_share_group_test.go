@@ -63,6 +63,16 @@ func TestAccGitlabGroupShareGroup_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Use an equivalent RFC3339 expiration date, which must not result in a change
+			{
+				Config: testAccGitlabGroupShareGroupConfig(mainGroup.ID, sharedGroup.ID,
+					`
+					group_access 	 = "guest"
+					expires_at     = "2099-01-01T00:00:00Z"
+					`,
+				),
+				PlanOnly: true,
+			},
 		},
 	})
 }
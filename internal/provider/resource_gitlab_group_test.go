@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -241,6 +242,57 @@ func TestAccGitlabGroup_nested(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroup_transferToParentWithSamePath(t *testing.T) {
+	var nestedGroup gitlab.Group
+	rInt := acctest.RandInt()
+
+	config := func(parent string) string {
+		return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name             = "foo-name-%[1]d"
+  path             = "foo-path-%[1]d"
+  visibility_level = "public"
+}
+
+resource "gitlab_group" "foo2" {
+  name             = "foo2-name-%[1]d"
+  path             = "foo2-path-%[1]d"
+  visibility_level = "public"
+}
+
+resource "gitlab_group" "existing" {
+  name             = "existing-name-%[1]d"
+  path             = "nfoo-path-%[1]d"
+  parent_id        = gitlab_group.foo2.id
+  visibility_level = "public"
+}
+
+resource "gitlab_group" "nested_foo" {
+  name             = "nfoo-name-%[1]d"
+  path             = "nfoo-path-%[1]d"
+  parent_id        = gitlab_group.%[2]s.id
+  visibility_level = "public"
+}
+`, rInt, parent)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("foo"),
+				Check:  testAccCheckGitlabGroupExists("gitlab_group.nested_foo", &nestedGroup),
+			},
+			// The new parent already has a subgroup with the same path
+			{
+				Config:      config("foo2"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("the namespace foo2-path-%d/nfoo-path-%d already exists", rInt, rInt)),
+			},
+		},
+	})
+}
+
 func TestAccGitlabGroup_disappears(t *testing.T) {
 	var group gitlab.Group
 	rInt := acctest.RandInt()
@@ -289,9 +341,132 @@ func TestAccGitlabGroup_PreventForkingOutsideGroup(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroup_projectCreationLevelAndAvatar(t *testing.T) {
+	var group gitlab.Group
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			// Create a group allowing developers to create projects
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "foo" {
+						name                   = "foo-name-%[1]d"
+						path                   = "foo-path-%[1]d"
+						visibility_level       = "public"
+						project_creation_level = "developer"
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "project_creation_level", "developer"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "avatar_url", ""),
+				),
+			},
+			// Restrict project creation to maintainers and upload an avatar
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "foo" {
+						name                   = "foo-name-%[1]d"
+						path                   = "foo-path-%[1]d"
+						visibility_level       = "public"
+						project_creation_level = "maintainer"
+						avatar                 = "${path.module}/testdata/avatar.png"
+						avatar_hash            = filesha256("${path.module}/testdata/avatar.png")
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					func(_ *terraform.State) error {
+						if group.ProjectCreationLevel != gitlab.MaintainerProjectCreation {
+							return fmt.Errorf("got project creation level %q, expected %q", group.ProjectCreationLevel, gitlab.MaintainerProjectCreation)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttrSet("gitlab_group.foo", "avatar_url"),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "avatar_hash", "8d29d9c393facb9d86314eb347a03fde503f2c0422bf55af7df086deb126107e"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_group.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"avatar", "avatar_hash"},
+			},
+			// Drop the configured hash, the computed hash doesn't upload the avatar again
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "foo" {
+						name                   = "foo-name-%[1]d"
+						path                   = "foo-path-%[1]d"
+						visibility_level       = "public"
+						project_creation_level = "maintainer"
+						avatar                 = "${path.module}/testdata/avatar.png"
+					}`, rInt),
+				PlanOnly: true,
+			},
+			// Clear the avatar
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "foo" {
+						name                   = "foo-name-%[1]d"
+						path                   = "foo-path-%[1]d"
+						visibility_level       = "public"
+						project_creation_level = "maintainer"
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "avatar_url", ""),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "avatar_hash", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabGroup_membershipLock(t *testing.T) {
+	var group gitlab.Group
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccCheckEE(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "foo" {
+						name             = "foo-name-%[1]d"
+						path             = "foo-path-%[1]d"
+						visibility_level = "public"
+						membership_lock  = true
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "membership_lock", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_group" "foo" {
+						name             = "foo-name-%[1]d"
+						path             = "foo-path-%[1]d"
+						visibility_level = "public"
+						membership_lock  = false
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabGroupExists("gitlab_group.foo", &group),
+					resource.TestCheckResourceAttr("gitlab_group.foo", "membership_lock", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabGroupDisappears(group *gitlab.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		_, err := testGitlabClient.Groups.DeleteGroup(group.ID)
+		_, err := testGitlabClient.Groups.DeleteGroup(group.ID, nil)
 		if err != nil {
 			return err
 		}
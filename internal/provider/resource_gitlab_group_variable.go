@@ -15,6 +15,8 @@ var _ = registerResource("gitlab_group_variable", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_group_variable` + "`" + ` resource allows to manage the lifecycle of a CI/CD variable for a group.
 
+~> **Important:** A group variable is identified by its key and its environment scope. Variables with the same key and the environment scopes ` + "`*`" + ` and ` + "`production`" + ` are distinct variables, GitLab uses the most specific one in jobs of the ` + "`production`" + ` environment. Two resources with the same key and the same environment scope refer to the same variable and GitLab rejects the creation of the second one. Terraform cannot detect this during plan, because a resource does not know the other resources of a plan.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/group_level_variables.html)`,
 
 		CreateContext: resourceGitlabGroupVariableCreate,
@@ -24,13 +26,14 @@ var _ = registerResource("gitlab_group_variable", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: setDefaultVariableMasked,
 
 		Schema: gitlabGroupVariableGetSchema(),
 	}
 })
 
 func resourceGitlabGroupVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
@@ -62,7 +65,7 @@ func resourceGitlabGroupVariableCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group, key, err := parseTwoPartID(d.Id())
 	if err != nil {
@@ -81,6 +84,7 @@ func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData
 	v, _, err := client.GroupVariables.GetVariable(
 		group,
 		key,
+		nil,
 		gitlab.WithContext(ctx),
 		withEnvironmentScopeFilter(ctx, scope),
 	)
@@ -101,7 +105,7 @@ func resourceGitlabGroupVariableRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabGroupVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
@@ -134,7 +138,7 @@ func resourceGitlabGroupVariableUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabGroupVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	group := d.Get("group").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
@@ -200,6 +200,22 @@ func TestAccGitlabGroupVariable_scope(t *testing.T) {
 	})
 }
 
+func TestAccGitlabGroupVariable_duplicateKeyAndScope(t *testing.T) {
+	rString := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabGroupVariableDestroy,
+		Steps: []resource.TestStep{
+			// Create a group and two variables with the same key and environment scope
+			{
+				Config:      testAccGitlabGroupVariableScopeConfig(rString, "*", "*", "value-a", "value-b"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`A variable with the key "key_%s" already exists`, rString)),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.GroupVariable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -215,7 +231,7 @@ func testAccCheckGitlabGroupVariableExists(n string, groupVariable *gitlab.Group
 		if key == "" {
 			return fmt.Errorf("No variable key is set")
 		}
-		gotVariable, _, err := testGitlabClient.GroupVariables.GetVariable(repoName, key, withEnvironmentScopeFilter(context.Background(), rs.Primary.Attributes["environment_scope"]))
+		gotVariable, _, err := testGitlabClient.GroupVariables.GetVariable(repoName, key, nil, withEnvironmentScopeFilter(context.Background(), rs.Primary.Attributes["environment_scope"]))
 		if err != nil {
 			return err
 		}
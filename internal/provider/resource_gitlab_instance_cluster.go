@@ -95,6 +95,7 @@ var _ = registerResource("gitlab_instance_cluster", func() *schema.Resource {
 				Description: "TLS certificate (needed if API is using a self-signed TLS certificate).",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return strings.TrimSpace(old) == strings.TrimSpace(new)
 				},
@@ -122,7 +123,7 @@ var _ = registerResource("gitlab_instance_cluster", func() *schema.Resource {
 })
 
 func resourceGitlabInstanceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	pk := gitlab.AddPlatformKubernetesOptions{
 		APIURL: gitlab.String(d.Get("kubernetes_api_url").(string)),
@@ -171,7 +172,7 @@ func resourceGitlabInstanceClusterCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabInstanceClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	clusterId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -213,7 +214,7 @@ func resourceGitlabInstanceClusterRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabInstanceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	clusterId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -272,7 +273,7 @@ func resourceGitlabInstanceClusterUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabInstanceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	clusterId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -281,7 +282,7 @@ func resourceGitlabInstanceClusterDelete(ctx context.Context, d *schema.Resource
 	log.Printf("[DEBUG] delete gitlab instance cluster %d", clusterId)
 
 	_, err = client.InstanceCluster.DeleteCluster(clusterId, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -2,9 +2,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gitlab "github.com/xanzy/go-gitlab"
 )
@@ -13,6 +16,8 @@ var _ = registerResource("gitlab_instance_variable", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_instance_variable` + "`" + ` resource allows to manage the lifecycle of an instance-level CI/CD variable.
 
+~> Instance-level variables cannot be scoped to an environment, because the GitLab API does not support an ` + "`environment_scope`" + ` for them. Use a ` + "`gitlab_group_variable`" + ` or ` + "`gitlab_project_variable`" + ` if the variable must only be available to specific environments.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/instance_level_ci_variables.html)`,
 
 		CreateContext: resourceGitlabInstanceVariableCreate,
@@ -20,21 +25,27 @@ var _ = registerResource("gitlab_instance_variable", func() *schema.Resource {
 		UpdateContext: resourceGitlabInstanceVariableUpdate,
 		DeleteContext: resourceGitlabInstanceVariableDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceGitlabInstanceVariableImporter,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			setDefaultVariableMasked,
+			validateMaskedVariableValue,
+		),
 
 		Schema: gitlabInstanceVariableGetSchema(),
 	}
 })
 
 func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	raw := d.Get("raw").(bool)
+	description := d.Get("description").(string)
 
 	options := gitlab.CreateInstanceVariableOptions{
 		Key:          &key,
@@ -42,6 +53,8 @@ func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.Resourc
 		VariableType: variableType,
 		Protected:    &protected,
 		Masked:       &masked,
+		Raw:          &raw,
+		Description:  &description,
 	}
 	log.Printf("[DEBUG] create gitlab instance level CI variable %s", key)
 
@@ -55,7 +68,7 @@ func resourceGitlabInstanceVariableCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabInstanceVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	key := d.Id()
 
@@ -85,19 +98,23 @@ func resourceGitlabInstanceVariableRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabInstanceVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 	variableType := stringToVariableType(d.Get("variable_type").(string))
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
+	raw := d.Get("raw").(bool)
+	description := d.Get("description").(string)
 
 	options := &gitlab.UpdateInstanceVariableOptions{
 		Value:        &value,
 		Protected:    &protected,
 		VariableType: variableType,
 		Masked:       &masked,
+		Raw:          &raw,
+		Description:  &description,
 	}
 	log.Printf("[DEBUG] update gitlab instance level CI variable %s", key)
 
@@ -109,7 +126,7 @@ func resourceGitlabInstanceVariableUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabInstanceVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	key := d.Get("key").(string)
 	log.Printf("[DEBUG] Delete gitlab instance level CI variable %s", key)
 
@@ -120,3 +137,14 @@ func resourceGitlabInstanceVariableDelete(ctx context.Context, d *schema.Resourc
 
 	return nil
 }
+
+func resourceGitlabInstanceVariableImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Instance-level variables are unique by key, because they cannot be scoped to an environment.
+	// Reject IDs in the `key:environment_scope` format of the project and group variables early,
+	// instead of failing with a confusing "not found" error.
+	if strings.Contains(d.Id(), ":") {
+		return nil, fmt.Errorf("invalid instance variable id (should be <key>): %s. Instance-level variables cannot be scoped to an environment", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
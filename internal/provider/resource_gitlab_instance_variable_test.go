@@ -101,6 +101,117 @@ func TestAccGitlabInstanceVariable_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabInstanceVariable_raw(t *testing.T) {
+	testAccRequiresAtLeast(t, "15.7")
+
+	var instanceVariable gitlab.InstanceVariable
+	rString := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabInstanceVariableDestroy,
+		Steps: []resource.TestStep{
+			// Create a raw variable with a value containing variable references
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_instance_variable" "foo" {
+  key   = "key_%s"
+  value = "pa$$word"
+  raw   = true
+}
+				`, rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceVariableExists("gitlab_instance_variable.foo", &instanceVariable),
+					testAccCheckGitlabInstanceVariableAttributes(&instanceVariable, &testAccGitlabInstanceVariableExpectedAttributes{
+						Key:   fmt.Sprintf("key_%s", rString),
+						Value: "pa$$word",
+						Raw:   true,
+					}),
+					resource.TestCheckResourceAttr("gitlab_instance_variable.foo", "value", "pa$$word"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_instance_variable.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Verify that an import ID with an environment scope is rejected
+			{
+				ResourceName:  "gitlab_instance_variable.foo",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("key_%s:*", rString),
+				ExpectError:   regexp.MustCompile(`invalid instance variable id \(should be <key>\)`),
+			},
+			// Update the variable to be expanded again
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_instance_variable" "foo" {
+  key   = "key_%s"
+  value = "pa$$word"
+}
+				`, rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceVariableExists("gitlab_instance_variable.foo", &instanceVariable),
+					testAccCheckGitlabInstanceVariableAttributes(&instanceVariable, &testAccGitlabInstanceVariableExpectedAttributes{
+						Key:   fmt.Sprintf("key_%s", rString),
+						Value: "pa$$word",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabInstanceVariable_description(t *testing.T) {
+	testAccRequiresAtLeast(t, "16.2")
+
+	var instanceVariable gitlab.InstanceVariable
+	rString := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabInstanceVariableDestroy,
+		Steps: []resource.TestStep{
+			// Create a variable with a description
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_instance_variable" "foo" {
+  key         = "key_%s"
+  value       = "value-%s"
+  description = "my description"
+}
+				`, rString, rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceVariableExists("gitlab_instance_variable.foo", &instanceVariable),
+					testAccCheckGitlabInstanceVariableAttributes(&instanceVariable, &testAccGitlabInstanceVariableExpectedAttributes{
+						Key:         fmt.Sprintf("key_%s", rString),
+						Value:       fmt.Sprintf("value-%s", rString),
+						Description: "my description",
+					}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_instance_variable.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the description
+			{
+				Config: testAccGitlabInstanceVariableConfig(rString),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabInstanceVariableExists("gitlab_instance_variable.foo", &instanceVariable),
+					testAccCheckGitlabInstanceVariableAttributes(&instanceVariable, &testAccGitlabInstanceVariableExpectedAttributes{
+						Key:   fmt.Sprintf("key_%s", rString),
+						Value: fmt.Sprintf("value-%s", rString),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabInstanceVariableExists(n string, instanceVariable *gitlab.InstanceVariable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -146,10 +257,12 @@ func testAccCheckGitlabInstanceVariableDestroy(s *terraform.State) error {
 }
 
 type testAccGitlabInstanceVariableExpectedAttributes struct {
-	Key       string
-	Value     string
-	Protected bool
-	Masked    bool
+	Key         string
+	Value       string
+	Protected   bool
+	Masked      bool
+	Raw         bool
+	Description string
 }
 
 func testAccCheckGitlabInstanceVariableAttributes(variable *gitlab.InstanceVariable, want *testAccGitlabInstanceVariableExpectedAttributes) resource.TestCheckFunc {
@@ -170,6 +283,14 @@ func testAccCheckGitlabInstanceVariableAttributes(variable *gitlab.InstanceVaria
 			return fmt.Errorf("got masked %t; want %t", variable.Masked, want.Masked)
 		}
 
+		if variable.Raw != want.Raw {
+			return fmt.Errorf("got raw %t; want %t", variable.Raw, want.Raw)
+		}
+
+		if variable.Description != want.Description {
+			return fmt.Errorf("got description %q; want %q", variable.Description, want.Description)
+		}
+
 		return nil
 	}
 }
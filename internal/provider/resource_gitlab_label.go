@@ -34,12 +34,12 @@ var _ = registerResource("gitlab_label", func() *schema.Resource {
 				Description: "The name or id of the project to add the label to.",
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 			},
 			"name": {
-				Description: "The name of the label.",
+				Description: "The name of the label. Changing the name renames the existing label.",
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 			},
 			"color": {
 				Description: "The color of the label given in 6-digit hex notation with leading '#' sign (e.g. #FFAABB) or one of the [CSS color names](https://developer.mozilla.org/en-US/docs/Web/CSS/color_value#Color_keywords).",
@@ -56,7 +56,7 @@ var _ = registerResource("gitlab_label", func() *schema.Resource {
 })
 
 func resourceGitlabLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.CreateLabelOptions{
 		Name:  gitlab.String(d.Get("name").(string)),
@@ -80,7 +80,7 @@ func resourceGitlabLabelCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	labelName := d.Id()
 	log.Printf("[DEBUG] read gitlab label %s/%s", project, labelName)
@@ -102,36 +102,42 @@ func resourceGitlabLabelRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceGitlabLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.UpdateLabelOptions{
-		Name:  gitlab.String(d.Get("name").(string)),
+		Name:  gitlab.String(d.Id()),
 		Color: gitlab.String(d.Get("color").(string)),
 	}
 
+	if d.HasChange("name") {
+		options.NewName = gitlab.String(d.Get("name").(string))
+	}
 	if d.HasChange("description") {
 		options.Description = gitlab.String(d.Get("description").(string))
 	}
 
 	log.Printf("[DEBUG] update gitlab label %s", d.Id())
 
-	_, _, err := client.Labels.UpdateLabel(project, options, gitlab.WithContext(ctx))
+	label, _, err := client.Labels.UpdateLabel(project, nil, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	// The resource is identified by the label name, which changes when the label is renamed.
+	d.SetId(label.Name)
+
 	return resourceGitlabLabelRead(ctx, d, meta)
 }
 
 func resourceGitlabLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] Delete gitlab label %s", d.Id())
 	options := &gitlab.DeleteLabelOptions{
 		Name: gitlab.String(d.Id()),
 	}
 
-	_, err := client.Labels.DeleteLabel(project, options, gitlab.WithContext(ctx))
+	_, err := client.Labels.DeleteLabel(project, nil, options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -140,7 +146,7 @@ func resourceGitlabLabelDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabLabelImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	parts := strings.SplitN(d.Id(), ":", 2)
 	if len(parts) != 2 {
 		return nil, fmt.Errorf("invalid label id (should be <project ID>.<label name>): %s", d.Id())
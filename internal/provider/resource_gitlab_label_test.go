@@ -68,6 +68,65 @@ func TestAccGitlabLabel_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabLabel_renameKeepsLabel(t *testing.T) {
+	testProject := testAccCreateProject(t)
+	var labelID int
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabLabelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_label" "this" {
+						project = "%d"
+						name    = "before-rename"
+						color   = "#ffcc00"
+					}
+				`, testProject.ID),
+				Check: func(s *terraform.State) error {
+					label, _, err := testGitlabClient.Labels.GetLabel(testProject.ID, "before-rename")
+					if err != nil {
+						return err
+					}
+					labelID = label.ID
+					return nil
+				},
+			},
+			// Rename the label and change its color in a single apply
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_label" "this" {
+						project = "%d"
+						name    = "after-rename"
+						color   = "#ff0000"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_label.this", "id", "after-rename"),
+					resource.TestCheckResourceAttr("gitlab_label.this", "color", "#ff0000"),
+					func(s *terraform.State) error {
+						label, _, err := testGitlabClient.Labels.GetLabel(testProject.ID, "after-rename")
+						if err != nil {
+							return err
+						}
+						if label.ID != labelID {
+							return fmt.Errorf("expected label %d to be renamed, got new label %d", labelID, label.ID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "gitlab_label.this",
+				ImportStateIdFunc: getLabelImportID("gitlab_label.this"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func getLabelImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
@@ -118,7 +118,7 @@ func testAccCheckGitlabManagedLicenseStatus(resource string, status string, lice
 		}
 
 		for _, gotLicense := range licenses {
-			approvalStatus, err := stringToApprovalStatus(context.TODO(), testGitlabClient, status)
+			approvalStatus, err := stringToApprovalStatus(context.TODO(), &ProviderMeta{Client: testGitlabClient}, status)
 			if err != nil {
 				return err
 			}
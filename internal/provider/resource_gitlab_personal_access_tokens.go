@@ -23,6 +23,10 @@ var validPersonalAccessTokenScopes = []string{
 	"read_registry",
 	"write_registry",
 	"sudo",
+	"admin_mode",
+	"create_runner",
+	"ai_features",
+	"k8s_proxy",
 }
 
 var _ = registerResource("gitlab_personal_access_token", func() *schema.Resource {
@@ -95,7 +99,7 @@ var _ = registerResource("gitlab_personal_access_token", func() *schema.Resource
 })
 
 func resourceGitlabPersonalAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	currentUserAdmin, err := isCurrentUserAdmin(ctx, client)
 	if err != nil {
@@ -136,7 +140,7 @@ func resourceGitlabPersonalAccessTokenCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabPersonalAccessTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userID, tokenID, err := resourceGitLabPersonalAccessTokenParseId(d.Id())
 	if err != nil {
@@ -174,7 +178,7 @@ func resourceGitlabPersonalAccessTokenRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabPersonalAccessTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	_, tokenID, err := resourceGitLabPersonalAccessTokenParseId(d.Id())
 	if err != nil {
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
@@ -75,6 +76,17 @@ func TestAccGitlabPersonalAccessToken_basic(t *testing.T) {
 				// The token is only known during creating. We explicitly mention this limitation in the docs.
 				ImportStateVerifyIgnore: []string{"token"},
 			},
+			// Verify that unknown scopes are rejected during plan.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_personal_access_token" "foo" {
+					user_id = %d
+					name    = "foo"
+					scopes  = ["api", "unknown_scope"]
+				}
+				`, user.ID),
+				ExpectError: regexp.MustCompile(`expected scopes.* to be one of`),
+			},
 		},
 	})
 }
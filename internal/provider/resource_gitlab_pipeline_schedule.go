@@ -65,7 +65,7 @@ var _ = registerResource("gitlab_pipeline_schedule", func() *schema.Resource {
 })
 
 func resourceGitlabPipelineScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.CreatePipelineScheduleOptions{
 		Description:  gitlab.String(d.Get("description").(string)),
@@ -88,7 +88,7 @@ func resourceGitlabPipelineScheduleCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabPipelineScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	pipelineScheduleID, err := strconv.Atoi(d.Id())
 
@@ -117,7 +117,7 @@ func resourceGitlabPipelineScheduleRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabPipelineScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.EditPipelineScheduleOptions{
 		Description:  gitlab.String(d.Get("description").(string)),
@@ -164,7 +164,7 @@ func resourceGitlabPipelineScheduleUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabPipelineScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] Delete gitlab PipelineSchedule %s", d.Id())
 
@@ -55,7 +55,7 @@ var _ = registerResource("gitlab_pipeline_schedule_variable", func() *schema.Res
 })
 
 func resourceGitlabPipelineScheduleVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	scheduleID := d.Get("pipeline_schedule_id").(int)
 
@@ -78,15 +78,21 @@ func resourceGitlabPipelineScheduleVariableCreate(ctx context.Context, d *schema
 }
 
 func resourceGitlabPipelineScheduleVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	scheduleID := d.Get("pipeline_schedule_id").(int)
 	pipelineVariableKey := d.Get("key").(string)
 
 	log.Printf("[DEBUG] read gitlab PipelineSchedule %s/%d", project, scheduleID)
 
+	// There is no endpoint to get a single pipeline schedule variable, but the pipeline schedule contains all its variables.
 	pipelineSchedule, _, err := client.PipelineSchedules.GetPipelineSchedule(project, scheduleID, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] pipeline schedule not found %s/%d, removing variable from state", project, scheduleID)
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -110,7 +116,7 @@ func resourceGitlabPipelineScheduleVariableRead(ctx context.Context, d *schema.R
 }
 
 func resourceGitlabPipelineScheduleVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	variableKey := d.Get("key").(string)
 	scheduleID := d.Get("pipeline_schedule_id").(int)
@@ -132,7 +138,7 @@ func resourceGitlabPipelineScheduleVariableUpdate(ctx context.Context, d *schema
 }
 
 func resourceGitlabPipelineScheduleVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	variableKey := d.Get("key").(string)
 	scheduleID := d.Get("pipeline_schedule_id").(int)
@@ -145,13 +151,13 @@ func resourceGitlabPipelineScheduleVariableDelete(ctx context.Context, d *schema
 
 func resourceGitlabPipelineScheduleVariableImporter(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	s := strings.Split(d.Id(), ":")
-	if len(s) != 3 {
-		return nil, fmt.Errorf("invalid pipeline schedule variable import format; expected '{project_id}:{pipeline_schedule_id}:{key}'")
+	if len(s) != 3 || s[0] == "" || s[2] == "" {
+		return nil, fmt.Errorf("invalid pipeline schedule variable import format %q; expected '{project_id}:{pipeline_schedule_id}:{key}'", d.Id())
 	}
 	project, pipelineScheduleId, key := s[0], s[1], s[2]
 	psid, err := strconv.Atoi(pipelineScheduleId)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("invalid pipeline schedule variable import format %q; the pipeline schedule id %q must be an integer", d.Id(), pipelineScheduleId)
 	}
 	d.SetId(buildTwoPartID(&pipelineScheduleId, &key))
 	if err := d.Set("project", project); err != nil {
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -73,6 +74,13 @@ func TestAccGitlabPipelineScheduleVariable_basic(t *testing.T) {
 				ImportStateIdFunc: getPipelineScheduleVariableID("gitlab_pipeline_schedule_variable.schedule_var"),
 				ImportStateVerify: true,
 			},
+			// Verify Import with an invalid ID
+			{
+				ResourceName:  "gitlab_pipeline_schedule_variable.schedule_var",
+				ImportState:   true,
+				ImportStateId: "TERRAFORMED_TEST_VALUE",
+				ExpectError:   regexp.MustCompile(`invalid pipeline schedule variable import format "TERRAFORMED_TEST_VALUE"`),
+			},
 		},
 	})
 }
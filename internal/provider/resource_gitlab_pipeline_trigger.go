@@ -16,6 +16,8 @@ var _ = registerResource("gitlab_pipeline_trigger", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_pipeline_trigger` + "`" + ` resource allows to manage the lifecycle of a pipeline trigger.
 
+-> Changing the ` + "`rotation_nonce`" + ` replaces the pipeline trigger with a new one with the same description, which rotates its token.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/pipeline_triggers.html)`,
 
 		CreateContext: resourceGitlabPipelineTriggerCreate,
@@ -43,12 +45,18 @@ var _ = registerResource("gitlab_pipeline_trigger", func() *schema.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"rotation_nonce": {
+				Description: "An arbitrary value which rotates the token when changed, by recreating the pipeline trigger. It is only stored in the state and not imported.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
 		},
 	}
 })
 
 func resourceGitlabPipelineTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.AddPipelineTriggerOptions{
 		Description: gitlab.String(d.Get("description").(string)),
@@ -67,7 +75,7 @@ func resourceGitlabPipelineTriggerCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabPipelineTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	pipelineTriggerID, err := strconv.Atoi(d.Id())
 
@@ -94,7 +102,7 @@ func resourceGitlabPipelineTriggerRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabPipelineTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.EditPipelineTriggerOptions{
 		Description: gitlab.String(d.Get("description").(string)),
@@ -121,7 +129,7 @@ func resourceGitlabPipelineTriggerUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabPipelineTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	log.Printf("[DEBUG] Delete gitlab PipelineTrigger %s", d.Id())
 
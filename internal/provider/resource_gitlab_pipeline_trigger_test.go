@@ -77,6 +77,63 @@ func TestAccGitlabPipelineTrigger_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabPipelineTrigger_rotation(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	var trigger gitlab.PipelineTrigger
+	var rotatedTrigger gitlab.PipelineTrigger
+
+	config := func(nonce string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_pipeline_trigger" "trigger" {
+				project        = %d
+				description    = "Rotated Pipeline Trigger"
+				rotation_nonce = "%s"
+			}
+		`, testProject.ID, nonce)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabPipelineTriggerDestroy,
+		Steps: []resource.TestStep{
+			// Create a pipeline trigger with an initial nonce
+			{
+				Config: config("1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabPipelineTriggerExists("gitlab_pipeline_trigger.trigger", &trigger),
+					resource.TestCheckResourceAttrPtr("gitlab_pipeline_trigger.trigger", "token", &trigger.Token),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_pipeline_trigger.trigger",
+				ImportStateIdFunc:       getPipelineTriggerImportID("gitlab_pipeline_trigger.trigger"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_nonce"},
+			},
+			// Change the nonce to rotate the token
+			{
+				Config: config("2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabPipelineTriggerExists("gitlab_pipeline_trigger.trigger", &rotatedTrigger),
+					testAccCheckGitlabPipelineTriggerAttributes(&rotatedTrigger, &testAccGitlabPipelineTriggerExpectedAttributes{
+						Description: "Rotated Pipeline Trigger",
+					}),
+					resource.TestCheckResourceAttrPtr("gitlab_pipeline_trigger.trigger", "token", &rotatedTrigger.Token),
+					func(*terraform.State) error {
+						if rotatedTrigger.ID == trigger.ID || rotatedTrigger.Token == trigger.Token {
+							return fmt.Errorf("expected the pipeline trigger token to be rotated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func getPipelineTriggerImportID(n string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[n]
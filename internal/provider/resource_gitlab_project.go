@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
+	"sort"
 	"strconv"
 	"time"
 
@@ -59,7 +61,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Computed:    true,
 	},
 	"namespace_id": {
-		Description: "The namespace (group or user) of the project. Defaults to your user.",
+		Description: "The namespace (group or user) of the project. Defaults to your user. Changing it transfers the project to the new namespace, keeping its ID.",
 		Type:        schema.TypeInt,
 		Optional:    true,
 		Computed:    true,
@@ -76,11 +78,24 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Computed:    true,
 	},
 	"import_url": {
-		Description: "Git URL to a repository to be imported.",
+		Description: "Git URL to a repository to be imported. Together with `mirror = true` it will setup a Pull Mirror. Provide the credentials in `import_url_username` and `import_url_password` instead of the URL. The creation waits for the import to finish, see the `create` timeout.",
 		Type:        schema.TypeString,
 		Optional:    true,
 		ForceNew:    true,
 	},
+	"import_url_username": {
+		Description:  "The username for the `import_url`. The value of this field is used to construct a valid `import_url` and is only related to the provider. This field cannot be imported using `terraform import`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"import_url"},
+	},
+	"import_url_password": {
+		Description:  "The password for the `import_url`. The value of this field is used to construct a valid `import_url` and is only related to the provider. This field cannot be imported using `terraform import`.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		RequiredWith: []string{"import_url"},
+	},
 	"request_access_enabled": {
 		Description: "Allow users to request member access.",
 		Type:        schema.TypeBool,
@@ -249,64 +264,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Computed:    true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"author_email_regex": {
-					Description: "All commit author emails must match this regex, e.g. `@my-company.com$`.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"branch_name_regex": {
-					Description: "All branch names must match this regex, e.g. `(feature|hotfix)\\/*`.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"commit_message_regex": {
-					Description: "All commit messages must match this regex, e.g. `Fixed \\d+\\..*`.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"commit_message_negative_regex": {
-					Description: "No commit message is allowed to match this regex, for example `ssh\\:\\/\\/`.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"file_name_regex": {
-					Description: "All commited filenames must not match this regex, e.g. `(jar|exe)$`.",
-					Type:        schema.TypeString,
-					Optional:    true,
-				},
-				"commit_committer_check": {
-					Description: "Users can only push commits to this repository that were committed with one of their own verified emails.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-				},
-				"deny_delete_tag": {
-					Description: "Deny deleting a tag.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-				},
-				"member_check": {
-					Description: "Restrict commits by author (email) to existing GitLab users.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-				},
-				"prevent_secrets": {
-					Description: "GitLab will reject any files that are likely to contain secrets.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-				},
-				"reject_unsigned_commits": {
-					Description: "Reject commit when it’s not signed through GPG.",
-					Type:        schema.TypeBool,
-					Optional:    true,
-				},
-				"max_file_size": {
-					Description:  "Maximum file size (MB).",
-					Type:         schema.TypeInt,
-					Optional:     true,
-					ValidateFunc: validation.IntAtLeast(0),
-				},
-			},
+			Schema: gitlabPushRulesGetSchema(),
 		},
 	},
 	"template_name": {
@@ -370,6 +328,26 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Default:      false,
 		RequiredWith: []string{"import_url"},
 	},
+	"mirror_sync_on_create": {
+		Description:  "Start the pull mirroring process right after the project is created, instead of waiting for the next scheduled update. Only applies if `mirror` is enabled. This attribute is only used during resource creation, thus changes are suppressed and the attribute cannot be imported.",
+		Type:         schema.TypeBool,
+		Optional:     true,
+		RequiredWith: []string{"import_url"},
+		DiffSuppressFunc: func(string, string, string, *schema.ResourceData) bool {
+			return true
+		},
+	},
+	"mirror_sync_trigger": {
+		Description:  "An arbitrary value, e.g. a timestamp, which starts the pull mirroring process whenever it changes. Only applies if `mirror` is enabled. The attribute cannot be imported.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		RequiredWith: []string{"import_url"},
+	},
+	"mirror_last_update_at": {
+		Description: "The time the pull mirror was last updated. Only set if `mirror` is enabled and the pull mirror details are available, which requires a GitLab Premium license.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	},
 	"build_coverage_regex": {
 		Description: "Test coverage parsing for the project. This is deprecated feature in GitLab 15.0.",
 		Type:        schema.TypeString,
@@ -565,7 +543,7 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validProjectAccessLevels, false)),
 	},
 	"topics": {
-		Description: "The list of topics for the project.",
+		Description: "The list of topics for the project. Topics can be changed without recreating the project, their order is not significant.",
 		Type:        schema.TypeSet,
 		Set:         schema.HashString,
 		Elem:        &schema.Schema{Type: schema.TypeString},
@@ -595,6 +573,18 @@ var resourceGitLabProjectSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Computed:    true,
 	},
+	"ci_separated_caches": {
+		Description: "Use separate caches for protected branches.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+	},
+	"keep_latest_artifact": {
+		Description: "Keep the artifacts of the most recent successful jobs, regardless of their expiry time.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+	},
 }
 
 var validContainerExpirationPolicyAttributesCadenceValues = []string{
@@ -684,17 +674,26 @@ This attribute is only used during resource creation, thus changes are suppresse
 					return true
 				},
 			},
-		}),
+			"migrate_default_branch_protection": {
+				Description: "If `true`, the protection of the old default branch is moved to the new default branch when `default_branch` changes. An existing protection of the new default branch is kept. This attribute is only used by the provider and cannot be imported.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+		}, avatarSchema()),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("path_with_namespace", namespaceOrPathChanged),
 			customdiff.ComputedIf("ssh_url_to_repo", namespaceOrPathChanged),
 			customdiff.ComputedIf("http_url_to_repo", namespaceOrPathChanged),
 			customdiff.ComputedIf("web_url", namespaceOrPathChanged),
+			avatarHashCustomizeDiff,
 		),
 	}
 })
 
-func resourceGitlabProjectSetToState(ctx context.Context, client *gitlab.Client, d *schema.ResourceData, project *gitlab.Project) error {
+func resourceGitlabProjectSetToState(ctx context.Context, meta *ProviderMeta, d *schema.ResourceData, project *gitlab.Project) error {
 	d.SetId(fmt.Sprintf("%d", project.ID))
 	d.Set("name", project.Name)
 	d.Set("path", project.Path)
@@ -725,7 +724,7 @@ func resourceGitlabProjectSetToState(ctx context.Context, client *gitlab.Client,
 		return err
 	}
 	d.Set("archived", project.Archived)
-	if supportsSquashOption, err := isGitLabVersionAtLeast(ctx, client, "14.1")(); err != nil {
+	if supportsSquashOption, err := meta.supportsFeature(ctx, "14.1"); err != nil {
 		return err
 	} else if supportsSquashOption {
 		d.Set("squash_option", project.SquashOption)
@@ -769,9 +768,13 @@ func resourceGitlabProjectSetToState(ctx context.Context, client *gitlab.Client,
 	d.Set("requirements_access_level", string(project.RequirementsAccessLevel))
 	d.Set("security_and_compliance_access_level", string(project.SecurityAndComplianceAccessLevel))
 	d.Set("snippets_access_level", string(project.SnippetsAccessLevel))
-	if err := d.Set("topics", project.Topics); err != nil {
+	// NOTE: sort the topics for a stable state, GitLab doesn't guarantee their order.
+	topics := append([]string{}, project.Topics...)
+	sort.Strings(topics)
+	if err := d.Set("topics", topics); err != nil {
 		return fmt.Errorf("error setting topics: %v", err)
 	}
+	d.Set("avatar_url", project.AvatarURL)
 	d.Set("wiki_access_level", string(project.WikiAccessLevel))
 	d.Set("squash_commit_template", project.SquashCommitTemplate)
 	d.Set("merge_commit_template", project.MergeCommitTemplate)
@@ -780,12 +783,14 @@ func resourceGitlabProjectSetToState(ctx context.Context, client *gitlab.Client,
 	d.Set("build_coverage_regex", project.BuildCoverageRegex)
 
 	d.Set("ci_default_git_depth", project.CIDefaultGitDepth)
+	d.Set("ci_separated_caches", project.CISeperateCache)
+	d.Set("keep_latest_artifact", project.KeepLatestArtifact)
 
 	return nil
 }
 
 func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.CreateProjectOptions{
 		Name:                             gitlab.String(d.Get("name").(string)),
@@ -842,8 +847,12 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		options.InitializeWithReadme = gitlab.Bool(v.(bool))
 	}
 
-	if v, ok := d.GetOk("import_url"); ok {
-		options.ImportURL = gitlab.String(v.(string))
+	if _, ok := d.GetOk("import_url"); ok {
+		importURL, err := resourceGitlabProjectImportURL(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		options.ImportURL = gitlab.String(importURL)
 	}
 
 	if v, ok := d.GetOk("template_name"); ok {
@@ -982,6 +991,18 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		options.WikiAccessLevel = stringToAccessControlValue(v.(string))
 	}
 
+	if v, ok := d.GetOk("avatar"); ok {
+		avatarFile, err := openAvatar(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		defer avatarFile.Close()
+		options.Avatar = &gitlab.ProjectAvatar{
+			Filename: v.(string),
+			Image:    avatarFile,
+		}
+	}
+
 	if v, ok := d.GetOk("squash_commit_template"); ok {
 		options.SquashCommitTemplate = gitlab.String(v.(string))
 	}
@@ -990,7 +1011,7 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		options.MergeCommitTemplate = gitlab.String(v.(string))
 	}
 
-	if supportsSquashOption, err := isGitLabVersionAtLeast(ctx, client, "14.1")(); err != nil {
+	if supportsSquashOption, err := meta.(*ProviderMeta).supportsFeature(ctx, "14.1"); err != nil {
 		return diag.FromErr(err)
 	} else if supportsSquashOption {
 		if v, ok := d.GetOk("squash_option"); ok {
@@ -1011,26 +1032,14 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 
 	// An import can be triggered by import_url or by creating the project from a template.
 	if project.ImportStatus != "none" {
-		log.Printf("[DEBUG] waiting for project %q import to finish", *options.Name)
-
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"scheduled", "started"},
-			Target:  []string{"finished"},
-			Timeout: 10 * time.Minute,
-			Refresh: func() (interface{}, string, error) {
-				status, _, err := client.ProjectImportExport.ImportStatus(d.Id(), gitlab.WithContext(ctx))
-				if err != nil {
-					return nil, "", err
-				}
-
-				return status, status.ImportStatus, nil
-			},
-		}
+		log.Printf("[DEBUG] waiting for project %q import to finish, the import is %s", *options.Name, project.ImportStatus)
 
-		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if err := resourceGitlabProjectWaitForImport(ctx, client, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return diag.Errorf("error while waiting for project %q import to finish: %s", *options.Name, err)
 		}
 
+		log.Printf("[DEBUG] import of project %q finished", *options.Name)
+
 		// Read the project again, so that we can detect the default branch.
 		project, _, err = client.Projects.GetProject(project.ID, nil, gitlab.WithContext(ctx))
 		if err != nil {
@@ -1057,12 +1066,12 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// see: https://gitlab.com/gitlab-org/gitlab/-/issues/333426
-	noDefaultBranchAPISupport, err := isGitLabVersionLessThan(ctx, client, "14.10")()
+	supportsDefaultBranchAPI, err := meta.(*ProviderMeta).supportsFeature(ctx, "14.10")
 	if err != nil {
 		return diag.Errorf("unable to get information if `default_branch` handling is supported in the GitLab instance: %v", err)
 	}
 
-	if noDefaultBranchAPISupport {
+	if !supportsDefaultBranchAPI {
 		// default_branch cannot always be set during creation.
 		// If the branch does not exist, the update will fail, so we also create it here.
 		// This logic may be removed when the above issue is resolved.
@@ -1165,18 +1174,23 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 
 	var editProjectOptions gitlab.EditProjectOptions
 
+	importURL, err := resourceGitlabProjectImportURL(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
 	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
 	if v, ok := d.GetOkExists("mirror_overwrites_diverged_branches"); ok {
 		editProjectOptions.MirrorOverwritesDivergedBranches = gitlab.Bool(v.(bool))
-		editProjectOptions.ImportURL = gitlab.String(d.Get("import_url").(string))
+		editProjectOptions.ImportURL = gitlab.String(importURL)
 	}
 
 	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
 	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
 	if v, ok := d.GetOkExists("only_mirror_protected_branches"); ok {
 		editProjectOptions.OnlyMirrorProtectedBranches = gitlab.Bool(v.(bool))
-		editProjectOptions.ImportURL = gitlab.String(d.Get("import_url").(string))
+		editProjectOptions.ImportURL = gitlab.String(importURL)
 	}
 
 	if v, ok := d.GetOk("issues_template"); ok {
@@ -1209,17 +1223,35 @@ func resourceGitlabProjectCreate(ctx context.Context, d *schema.ResourceData, me
 		editProjectOptions.CIForwardDeploymentEnabled = gitlab.Bool(v.(bool))
 	}
 
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("ci_separated_caches"); ok {
+		editProjectOptions.CISeperateCache = gitlab.Bool(v.(bool))
+	}
+
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("keep_latest_artifact"); ok {
+		editProjectOptions.KeepLatestArtifact = gitlab.Bool(v.(bool))
+	}
+
 	if (editProjectOptions != gitlab.EditProjectOptions{}) {
 		if _, _, err := client.Projects.EditProject(d.Id(), &editProjectOptions, gitlab.WithContext(ctx)); err != nil {
 			return diag.Errorf("Could not update project %q: %s", d.Id(), err)
 		}
 	}
 
+	if d.Get("mirror").(bool) && d.Get("mirror_sync_on_create").(bool) {
+		if err := resourceGitlabProjectStartPullMirroring(ctx, client, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceGitlabProjectRead(ctx, d, meta)
 }
 
 func resourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	log.Printf("[DEBUG] read gitlab project %s", d.Id())
 
 	project, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
@@ -1237,7 +1269,7 @@ func resourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	}
 
-	if err := resourceGitlabProjectSetToState(ctx, client, d, project); err != nil {
+	if err := resourceGitlabProjectSetToState(ctx, meta.(*ProviderMeta), d, project); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -1253,11 +1285,26 @@ func resourceGitlabProjectRead(ctx context.Context, d *schema.ResourceData, meta
 	if err := d.Set("push_rules", flattenProjectPushRules(pushRules)); err != nil {
 		return diag.FromErr(err)
 	}
+
+	mirrorLastUpdateAt := ""
+	if project.Mirror {
+		log.Printf("[DEBUG] read gitlab project %q pull mirror details", d.Id())
+		mirrorDetails, _, err := client.Projects.GetProjectPullMirrorDetails(d.Id(), gitlab.WithContext(ctx))
+		// NOTE: the pull mirror details require the Maintainer role, which isn't needed to read the project itself.
+		if is404(err) || is403(err) {
+			log.Printf("[DEBUG] Failed to get pull mirror details for project %q: %v", d.Id(), err)
+		} else if err != nil {
+			return diag.Errorf("Failed to get pull mirror details for project %q: %s", d.Id(), err)
+		} else if mirrorDetails.LastUpdateAt != nil {
+			mirrorLastUpdateAt = mirrorDetails.LastUpdateAt.Format(time.RFC3339)
+		}
+	}
+	d.Set("mirror_last_update_at", mirrorLastUpdateAt)
 	return nil
 }
 
 func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	// Always send the name field, to satisfy the requirement of having one
 	// of the project attributes listed below in the update call
@@ -1268,6 +1315,11 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	transferOptions := &gitlab.TransferProjectOptions{}
 
+	importURL, err := resourceGitlabProjectImportURL(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	if d.HasChange("name") {
 		options.Name = gitlab.String(d.Get("name").(string))
 	}
@@ -1284,8 +1336,12 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.Description = gitlab.String(d.Get("description").(string))
 	}
 
-	if d.HasChange("default_branch") {
-		options.DefaultBranch = gitlab.String(d.Get("default_branch").(string))
+	if d.HasChange("default_branch") && d.Get("default_branch").(string) != "" {
+		defaultBranch := d.Get("default_branch").(string)
+		if err := resourceGitlabProjectCheckDefaultBranch(ctx, client, d.Id(), defaultBranch); err != nil {
+			return diag.FromErr(err)
+		}
+		options.DefaultBranch = gitlab.String(defaultBranch)
 	}
 
 	if d.HasChange("visibility_level") {
@@ -1340,7 +1396,9 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.SharedRunnersEnabled = gitlab.Bool(d.Get("shared_runners_enabled").(bool))
 	}
 
-	if d.HasChange("tags") {
+	// NOTE: tags and topics are the same attribute in GitLab. Sending both would replace
+	//       the new topics with the stale tags, so topics take precedence.
+	if d.HasChange("tags") && !d.HasChange("topics") {
 		options.TagList = stringSetToStringSlice(d.Get("tags").(*schema.Set))
 	}
 
@@ -1352,7 +1410,7 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.LFSEnabled = gitlab.Bool(d.Get("lfs_enabled").(bool))
 	}
 
-	if supportsSquashOption, err := isGitLabVersionAtLeast(ctx, client, "14.1")(); err != nil {
+	if supportsSquashOption, err := meta.(*ProviderMeta).supportsFeature(ctx, "14.1"); err != nil {
 		return diag.FromErr(err)
 	} else if supportsSquashOption && d.HasChange("squash_option") {
 		options.SquashOption = stringToSquashOptionValue(d.Get("squash_option").(string))
@@ -1375,25 +1433,29 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("mirror") {
-		options.ImportURL = gitlab.String(d.Get("import_url").(string))
+		options.ImportURL = gitlab.String(importURL)
 		options.Mirror = gitlab.Bool(d.Get("mirror").(bool))
 	}
 
 	if d.HasChange("mirror_trigger_builds") {
-		options.ImportURL = gitlab.String(d.Get("import_url").(string))
+		options.ImportURL = gitlab.String(importURL)
 		options.MirrorTriggerBuilds = gitlab.Bool(d.Get("mirror_trigger_builds").(bool))
 	}
 
 	if d.HasChange("only_mirror_protected_branches") {
-		options.ImportURL = gitlab.String(d.Get("import_url").(string))
+		options.ImportURL = gitlab.String(importURL)
 		options.OnlyMirrorProtectedBranches = gitlab.Bool(d.Get("only_mirror_protected_branches").(bool))
 	}
 
 	if d.HasChange("mirror_overwrites_diverged_branches") {
-		options.ImportURL = gitlab.String(d.Get("import_url").(string))
+		options.ImportURL = gitlab.String(importURL)
 		options.MirrorOverwritesDivergedBranches = gitlab.Bool(d.Get("mirror_overwrites_diverged_branches").(bool))
 	}
 
+	if d.HasChanges("import_url_username", "import_url_password") {
+		options.ImportURL = gitlab.String(importURL)
+	}
+
 	if d.HasChange("build_coverage_regex") {
 		options.IssuesTemplate = gitlab.String(d.Get("build_coverage_regex").(string))
 	}
@@ -1522,6 +1584,26 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.WikiAccessLevel = stringToAccessControlValue(d.Get("wiki_access_level").(string))
 	}
 
+	if d.HasChanges("avatar", "avatar_hash") {
+		avatarPath := d.Get("avatar").(string)
+		// NOTE: the avatar should be removed
+		if avatarPath == "" {
+			options.Avatar = &gitlab.ProjectAvatar{}
+			// terraform doesn't care to remove this from state, thus, we do.
+			d.Set("avatar_hash", "")
+		} else {
+			avatarFile, err := openAvatar(avatarPath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			defer avatarFile.Close()
+			options.Avatar = &gitlab.ProjectAvatar{
+				Filename: avatarPath,
+				Image:    avatarFile,
+			}
+		}
+	}
+
 	if d.HasChange("squash_commit_template") {
 		options.SquashCommitTemplate = gitlab.String(d.Get("squash_commit_template").(string))
 	}
@@ -1534,6 +1616,14 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		options.CIDefaultGitDepth = gitlab.Int(d.Get("ci_default_git_depth").(int))
 	}
 
+	if d.HasChange("ci_separated_caches") {
+		options.CISeperateCache = gitlab.Bool(d.Get("ci_separated_caches").(bool))
+	}
+
+	if d.HasChange("keep_latest_artifact") {
+		options.KeepLatestArtifact = gitlab.Bool(d.Get("keep_latest_artifact").(bool))
+	}
+
 	if *options != (gitlab.EditProjectOptions{}) {
 		log.Printf("[DEBUG] update gitlab project %s", d.Id())
 		_, _, err := client.Projects.EditProject(d.Id(), options, gitlab.WithContext(ctx))
@@ -1542,11 +1632,22 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if options.DefaultBranch != nil && d.Get("migrate_default_branch_protection").(bool) {
+		oldDefaultBranch, _ := d.GetChange("default_branch")
+		if oldDefaultBranch.(string) != "" {
+			if err := resourceGitlabProjectMigrateBranchProtection(ctx, client, d.Id(), oldDefaultBranch.(string), *options.DefaultBranch); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	if *transferOptions != (gitlab.TransferProjectOptions{}) {
-		log.Printf("[DEBUG] transferring project %s to namespace %d", d.Id(), transferOptions.Namespace)
+		namespaceID := d.Get("namespace_id").(int)
+		log.Printf("[DEBUG] transferring project %s to namespace %d", d.Id(), namespaceID)
 		_, _, err := client.Projects.TransferProject(d.Id(), transferOptions, gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			// NOTE: GitLab rejects the transfer if the target namespace already contains a project with the same name or path.
+			return diag.Errorf("failed to transfer project %s to namespace %d, make sure the namespace doesn't already contain a project with the same name or path: %s", d.Id(), namespaceID, err)
 		}
 	}
 
@@ -1573,50 +1674,112 @@ func resourceGitlabProjectUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.Get("mirror").(bool) && d.HasChange("mirror_sync_trigger") {
+		if err := resourceGitlabProjectStartPullMirroring(ctx, client, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceGitlabProjectRead(ctx, d, meta)
 }
 
-func resourceGitlabProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+// resourceGitlabProjectCheckDefaultBranch makes sure that the given branch exists before it is used as the default branch.
+// Projects with an empty repository are exempt, because there is no branch to check yet.
+func resourceGitlabProjectCheckDefaultBranch(ctx context.Context, client *gitlab.Client, project string, branch string) error {
+	_, _, err := client.Branches.GetBranch(project, branch, gitlab.WithContext(ctx))
+	if err == nil {
+		return nil
+	}
+	if !is404(err) {
+		return fmt.Errorf("failed to check if branch %q exists in project %s: %w", branch, project, err)
+	}
 
-	if !d.Get("archive_on_destroy").(bool) {
-		log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
-		_, err := client.Projects.DeleteProject(d.Id(), gitlab.WithContext(ctx))
-		if err != nil {
-			return diag.FromErr(err)
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to read project %s: %w", project, err)
+	}
+	if p.EmptyRepo {
+		return nil
+	}
+
+	return fmt.Errorf("unable to set default branch of project %s to %q, because the branch does not exist. Create the branch first, e.g. using the `gitlab_branch` resource", project, branch)
+}
+
+// resourceGitlabProjectMigrateBranchProtection moves the protection of the old default branch to the new default branch.
+// An existing protection of the new default branch is kept.
+func resourceGitlabProjectMigrateBranchProtection(ctx context.Context, client *gitlab.Client, project string, oldBranch string, newBranch string) error {
+	log.Printf("[DEBUG] check for protection on old default branch %q for project %q", oldBranch, project)
+	protectedBranch, _, err := client.ProtectedBranches.GetProtectedBranch(project, oldBranch, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] old default branch %q for project %q is not protected, nothing to migrate", oldBranch, project)
+			return nil
 		}
+		return fmt.Errorf("failed to read protection of branch %q for project %s: %w", oldBranch, project, err)
+	}
 
-		// Wait for the project to be deleted.
-		// Deleting a project in gitlab is async.
-		stateConf := &resource.StateChangeConf{
-			Pending: []string{"Deleting"},
-			Target:  []string{"Deleted"},
-			Refresh: func() (interface{}, string, error) {
-				out, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
-				if err != nil {
-					if is404(err) {
-						return out, "Deleted", nil
-					}
-					log.Printf("[ERROR] Received error: %#v", err)
-					return out, "Error", err
-				}
-				if out.MarkedForDeletionAt != nil {
-					// Represents a Gitlab EE soft-delete
-					return out, "Deleted", nil
-				}
-				return out, "Deleting", nil
-			},
+	_, _, err = client.ProtectedBranches.GetProtectedBranch(project, newBranch, gitlab.WithContext(ctx))
+	if err != nil && !is404(err) {
+		return fmt.Errorf("failed to read protection of branch %q for project %s: %w", newBranch, project, err)
+	}
+	if is404(err) {
+		log.Printf("[DEBUG] protect new default branch %q for project %q", newBranch, project)
+		options := &gitlab.ProtectRepositoryBranchesOptions{
+			Name:                      gitlab.String(newBranch),
+			AllowForcePush:            gitlab.Bool(protectedBranch.AllowForcePush),
+			CodeOwnerApprovalRequired: gitlab.Bool(protectedBranch.CodeOwnerApprovalRequired),
+		}
+		options.PushAccessLevel, options.AllowedToPush = splitBranchAccessDescriptions(protectedBranch.PushAccessLevels)
+		options.MergeAccessLevel, options.AllowedToMerge = splitBranchAccessDescriptions(protectedBranch.MergeAccessLevels)
+		options.UnprotectAccessLevel, options.AllowedToUnprotect = splitBranchAccessDescriptions(protectedBranch.UnprotectAccessLevels)
+		if _, _, err := client.ProtectedBranches.ProtectRepositoryBranches(project, options, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to protect new default branch %q for project %s: %w", newBranch, project, err)
+		}
+	}
 
-			Timeout:    10 * time.Minute,
-			MinTimeout: 3 * time.Second,
-			Delay:      5 * time.Second,
+	log.Printf("[DEBUG] unprotect old default branch %q for project %q", oldBranch, project)
+	if _, err := client.ProtectedBranches.UnprotectRepositoryBranches(project, oldBranch, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return fmt.Errorf("failed to unprotect old default branch %q for project %s: %w", oldBranch, project, err)
+	}
+	return nil
+}
+
+// splitBranchAccessDescriptions returns the role based access level and the user, group and deploy key based permissions
+// of a protected branch, which are set using different options when protecting a branch.
+func splitBranchAccessDescriptions(descriptions []*gitlab.BranchAccessDescription) (*gitlab.AccessLevelValue, *[]*gitlab.BranchPermissionOptions) {
+	var accessLevel *gitlab.AccessLevelValue
+	var permissions []*gitlab.BranchPermissionOptions
+	for _, description := range descriptions {
+		switch {
+		case description.UserID != 0:
+			permissions = append(permissions, &gitlab.BranchPermissionOptions{UserID: gitlab.Int(description.UserID)})
+		case description.GroupID != 0:
+			permissions = append(permissions, &gitlab.BranchPermissionOptions{GroupID: gitlab.Int(description.GroupID)})
+		case description.DeployKeyID != 0:
+			permissions = append(permissions, &gitlab.BranchPermissionOptions{DeployKeyID: gitlab.Int(description.DeployKeyID)})
+		default:
+			accessLevel = gitlab.AccessLevel(description.AccessLevel)
 		}
+	}
+	if len(permissions) == 0 {
+		return accessLevel, nil
+	}
+	return accessLevel, &permissions
+}
+
+func resourceGitlabProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
 
-		_, err = stateConf.WaitForStateContext(ctx)
+	if !d.Get("archive_on_destroy").(bool) {
+		log.Printf("[DEBUG] Delete gitlab project %s", d.Id())
+		_, err := client.Projects.DeleteProject(d.Id(), nil, gitlab.WithContext(ctx))
 		if err != nil {
-			return diag.Errorf("error waiting for project (%s) to become deleted: %s", d.Id(), err)
+			return diag.FromErr(err)
 		}
 
+		if err := resourceGitlabProjectWaitForDeletion(ctx, client, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
 	} else {
 		log.Printf("[DEBUG] Archive gitlab project %s", d.Id())
 		_, _, err := client.Projects.ArchiveProject(d.Id(), gitlab.WithContext(ctx))
@@ -1628,6 +1791,94 @@ func resourceGitlabProjectDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// resourceGitlabProjectWaitForImport waits for the import of the project to finish
+// and returns the error reported by GitLab if the import failed.
+func resourceGitlabProjectWaitForImport(ctx context.Context, client *gitlab.Client, projectID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"scheduled", "started"},
+		Target:  []string{"finished"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			status, _, err := client.ProjectImportExport.ImportStatus(projectID, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] import of gitlab project %s is %s", projectID, status.ImportStatus)
+			if status.ImportStatus == "failed" {
+				return nil, "", fmt.Errorf("import failed: %s", status.ImportError)
+			}
+
+			return status, status.ImportStatus, nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// resourceGitlabProjectImportURL returns the import_url with the credentials
+// from import_url_username and import_url_password added to it.
+func resourceGitlabProjectImportURL(d *schema.ResourceData) (string, error) {
+	importURL := d.Get("import_url").(string)
+	username := d.Get("import_url_username").(string)
+	password := d.Get("import_url_password").(string)
+	if importURL == "" || (username == "" && password == "") {
+		return importURL, nil
+	}
+
+	u, err := url.Parse(importURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse import_url: %s", err)
+	}
+	u.User = url.UserPassword(username, password)
+	return u.String(), nil
+}
+
+// resourceGitlabProjectWaitForDeletion waits for the project to be deleted.
+// Deleting a project in gitlab is async.
+func resourceGitlabProjectWaitForDeletion(ctx context.Context, client *gitlab.Client, projectID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Deleting"},
+		Target:  []string{"Deleted"},
+		Refresh: func() (interface{}, string, error) {
+			out, _, err := client.Projects.GetProject(projectID, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				if is404(err) {
+					return out, "Deleted", nil
+				}
+				log.Printf("[ERROR] Received error: %#v", err)
+				return out, "Error", err
+			}
+			if out.MarkedForDeletionAt != nil {
+				// Represents a Gitlab EE soft-delete
+				return out, "Deleted", nil
+			}
+			return out, "Deleting", nil
+		},
+
+		Timeout:    10 * time.Minute,
+		MinTimeout: 3 * time.Second,
+		Delay:      5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for project (%s) to become deleted: %s", projectID, err)
+	}
+
+	return nil
+}
+
+// resourceGitlabProjectStartPullMirroring starts the pull mirroring process of the project,
+// which otherwise only runs periodically.
+func resourceGitlabProjectStartPullMirroring(ctx context.Context, client *gitlab.Client, projectID string) error {
+	log.Printf("[DEBUG] start pull mirroring of gitlab project %q", projectID)
+	if _, err := client.Projects.StartMirroringProject(projectID, gitlab.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to start pull mirroring of project %q: %w", projectID, err)
+	}
+	return nil
+}
+
 func editOrAddPushRules(ctx context.Context, client *gitlab.Client, projectID string, d *schema.ResourceData) error {
 	log.Printf("[DEBUG] Editing push rules for project %q", projectID)
 
@@ -19,14 +19,18 @@ var _ = registerResource("gitlab_project_access_token", func() *schema.Resource
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_project_access_token` + "`" + ` resource allows to manage the lifecycle of a project access token.
 
+-> Changing ` + "`expires_at`" + ` or configuring a ` + "`rotation_configuration`" + ` rotates the token in place instead of recreating it. Rotating a token revokes the previous token and requires GitLab 16.0 or later. Removing ` + "`expires_at`" + ` from the configuration neither rotates nor recreates the token, because GitLab may assign an expiration date to tokens created without one.
+
 **Upstream API**: [GitLab API docs](https://docs.gitlab.com/ee/api/project_access_tokens.html)`,
 
 		CreateContext: resourceGitlabProjectAccessTokenCreate,
 		ReadContext:   resourceGitlabProjectAccessTokenRead,
+		UpdateContext: resourceGitlabProjectAccessTokenUpdate,
 		DeleteContext: resourceGitlabProjectAccessTokenDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceGitlabProjectAccessTokenCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"project": {
@@ -52,11 +56,35 @@ var _ = registerResource("gitlab_project_access_token", func() *schema.Resource
 				},
 			},
 			"expires_at": {
-				Description:      "Time the token will expire it, YYYY-MM-DD format. Will not expire per default.",
+				Description:      "Time the token will expire it, YYYY-MM-DD format. Will not expire per default. Must be in the future and within the maximum access token lifetime of the instance. Changing it rotates the token. Removing it from the configuration has no effect, the token keeps its current expiration date.",
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ValidateDiagFunc: isISO6801Date,
-				ForceNew:         true,
+				ConflictsWith:    []string{"rotation_configuration"},
+			},
+			"rotation_configuration": {
+				Description:   "The configuration for the automatic rotation of the token. When set, the token is rotated during an apply once it expires within `rotate_before_days` days.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"expires_at"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration_days": {
+							Description:  "The number of days after which a new or rotated token expires.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"rotate_before_days": {
+							Description:  "The number of days before the expiration of the token in which it is rotated. Must be less than `expiration_days`.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
 			},
 			"token": {
 				Description: "The secret token. **Note**: the token is not available for imported resources.",
@@ -85,9 +113,9 @@ var _ = registerResource("gitlab_project_access_token", func() *schema.Resource
 				Computed:    true,
 			},
 			"access_level": {
-				Description:      fmt.Sprintf("The access level for the project access token. Valid values are: %s. Default is `%s`.", renderValueListForDocs(validProjectAccessLevelNames), accessLevelValueToName[gitlab.MaintainerPermissions]),
+				Description:      fmt.Sprintf("The access level for the project access token. Valid values are: %s. Default is `%s`.", renderValueListForDocs(validAccessLevels), accessLevelValueToName[gitlab.MaintainerPermissions]),
 				Type:             schema.TypeString,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validProjectAccessLevelNames, false)),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validAccessLevels, false)),
 				Optional:         true,
 				Default:          accessLevelValueToName[gitlab.MaintainerPermissions],
 				ForceNew:         true,
@@ -97,7 +125,7 @@ var _ = registerResource("gitlab_project_access_token", func() *schema.Resource
 })
 
 func resourceGitlabProjectAccessTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	accessLevelId := accessLevelNameToValue[d.Get("access_level").(string)]
 	project := d.Get("project").(string)
 
@@ -118,9 +146,14 @@ func resourceGitlabProjectAccessTokenCreate(ctx context.Context, d *schema.Resou
 		options.ExpiresAt = &parsedExpiresAtISOTime
 	}
 
+	if expirationDays, _, ok := accessTokenRotationConfiguration(d); ok {
+		expiresAt := accessTokenRotationExpiresAt(time.Now(), expirationDays)
+		options.ExpiresAt = &expiresAt
+	}
+
 	projectAccessToken, _, err := client.ProjectAccessTokens.CreateProjectAccessToken(project, options, gitlab.WithContext(ctx))
 	if err != nil {
-		return diag.FromErr(err)
+		return accessTokenCreateDiagnostics(err)
 	}
 
 	PATstring := strconv.Itoa(projectAccessToken.ID)
@@ -136,7 +169,7 @@ func resourceGitlabProjectAccessTokenRead(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("Error parsing ID: %s", d.Id())
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	projectAccessTokenID, err := strconv.Atoi(PATstring)
 	if err != nil {
@@ -172,13 +205,100 @@ func resourceGitlabProjectAccessTokenRead(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+func resourceGitlabProjectAccessTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only a change of the expiration date requires an API call, which rotates the token.
+	// Changes of the rotation configuration are only stored in the state.
+	if !d.HasChange("expires_at") {
+		return resourceGitlabProjectAccessTokenRead(ctx, d, meta)
+	}
+
+	project, patString, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.Errorf("Error parsing ID: %s", d.Id())
+	}
+
+	client := meta.(*ProviderMeta).Client
+
+	projectAccessTokenID, err := strconv.Atoi(patString)
+	if err != nil {
+		return diag.Errorf("%s cannot be converted to int", patString)
+	}
+
+	options := &gitlab.RotateProjectAccessTokenOptions{}
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := parseISO8601Date(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		options.ExpiresAt = expiresAt
+	}
+
+	log.Printf("[DEBUG] rotate gitlab ProjectAccessToken %d, project ID %s", projectAccessTokenID, project)
+
+	// Rotating a token revokes it and creates a new token with a new ID, which replaces the old one in the state.
+	projectAccessToken, _, err := client.ProjectAccessTokens.RotateProjectAccessToken(project, projectAccessTokenID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	PATstring := strconv.Itoa(projectAccessToken.ID)
+	d.SetId(buildTwoPartID(&project, &PATstring))
+	d.Set("token", projectAccessToken.Token)
+
+	return resourceGitlabProjectAccessTokenRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectAccessTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// NOTE: unknown values are zero and are validated once they are known.
+	if expirationDays, rotateBeforeDays, ok := accessTokenRotationConfiguration(d); ok && expirationDays > 0 && rotateBeforeDays > 0 {
+		if err := accessTokenValidateRotationConfiguration(expirationDays, rotateBeforeDays); err != nil {
+			return err
+		}
+	}
+
+	// Only validate an expiration date which is known and about to be applied,
+	// tokens which expire over time must not fail the plan.
+	if d.Id() == "" || d.HasChange("expires_at") {
+		if v, ok := d.GetOk("expires_at"); ok && d.NewValueKnown("expires_at") {
+			maxLifetimeDays := accessTokenMaxLifetimeDays(ctx, meta.(*ProviderMeta).Client)
+			if err := accessTokenValidateExpiresAt(v.(string), maxLifetimeDays, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if expirationDays, rotateBeforeDays, ok := accessTokenRotationConfiguration(d); ok {
+		now := time.Now()
+		if accessTokenNeedsRotation(d.Get("expires_at").(string), rotateBeforeDays, now) {
+			if err := d.SetNew("expires_at", accessTokenRotationExpiresAt(now, expirationDays).String()); err != nil {
+				return err
+			}
+		}
+	}
+
+	// A new expiration date rotates the token, which changes all its computed attributes.
+	if d.HasChange("expires_at") {
+		for _, key := range []string{"token", "created_at", "active", "revoked"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceGitlabProjectAccessTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	project, patString, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return diag.Errorf("Error parsing ID: %s", d.Id())
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	projectAccessTokenID, err := strconv.Atoi(patString)
 	if err != nil {
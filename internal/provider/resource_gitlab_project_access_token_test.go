@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -99,6 +100,134 @@ func TestAccGitlabProjectAccessToken_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectAccessToken_expiresAt(t *testing.T) {
+	project := testAccCreateProject(t)
+	expiresAt := time.Now().AddDate(0, 0, 30).Format("2006-01-02")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectAccessTokenDestroy,
+		Steps: []resource.TestStep{
+			// Reject an expiration date in the past during plan.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_access_token" "foo" {
+					project    = %d
+					name       = "foo"
+					scopes     = ["read_api"]
+					expires_at = %q
+				}
+				`, project.ID, time.Now().AddDate(0, 0, -1).Format("2006-01-02")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be in the future`),
+			},
+			// Create a token with a valid expiration date and the `guest` access level.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_access_token" "foo" {
+					project      = %d
+					name         = "foo"
+					scopes       = ["read_api"]
+					access_level = "guest"
+					expires_at   = %q
+				}
+				`, project.ID, expiresAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "expires_at", expiresAt),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "access_level", "guest"),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "active", "true"),
+				),
+			},
+			// Verify upstream resource with an import.
+			{
+				ResourceName:            "gitlab_project_access_token.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectAccessToken_rotation(t *testing.T) {
+	testAccRequiresAtLeast(t, "16.0")
+	project := testAccCreateProject(t)
+
+	var initialToken string
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectAccessTokenDestroy,
+		Steps: []resource.TestStep{
+			// Create an access token which is not yet within the rotation window.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_access_token" "foo" {
+					project = %d
+					name    = "foo"
+					scopes  = ["api"]
+
+					rotation_configuration {
+						expiration_days    = 10
+						rotate_before_days = 2
+					}
+				}
+				`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "expires_at", time.Now().UTC().AddDate(0, 0, 10).Format("2006-01-02")),
+					func(s *terraform.State) error {
+						initialToken = s.RootModule().Resources["gitlab_project_access_token.foo"].Primary.Attributes["token"]
+						return nil
+					},
+				),
+			},
+			// Widen the rotation window, so that the token is rotated in place.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_access_token" "foo" {
+					project = %d
+					name    = "foo"
+					scopes  = ["api"]
+
+					rotation_configuration {
+						expiration_days    = 20
+						rotate_before_days = 15
+					}
+				}
+				`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "expires_at", time.Now().UTC().AddDate(0, 0, 20).Format("2006-01-02")),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_access_token.foo", "revoked", "false"),
+					func(s *terraform.State) error {
+						rotatedToken := s.RootModule().Resources["gitlab_project_access_token.foo"].Primary.Attributes["token"]
+						if rotatedToken == "" || rotatedToken == initialToken {
+							return fmt.Errorf("expected the token to be rotated")
+						}
+						return nil
+					},
+				),
+			},
+			// A rotation window which is not shorter than the expiration is rejected.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_access_token" "foo" {
+					project = %d
+					name    = "foo"
+					scopes  = ["api"]
+
+					rotation_configuration {
+						expiration_days    = 10
+						rotate_before_days = 10
+					}
+				}
+				`, project.ID),
+				ExpectError: regexp.MustCompile(`rotate_before_days \(10\) must be less than expiration_days \(10\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectAccessTokenDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project_access_token" {
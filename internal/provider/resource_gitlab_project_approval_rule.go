@@ -22,6 +22,8 @@ var _ = registerResource("gitlab_project_approval_rule", func() *schema.Resource
 
 -> This resource requires a GitLab Enterprise instance.
 
+The ID of this resource is composed of the project and the ID of the approval rule, ` + "`<project>:<rule-id>`" + `.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/merge_request_approvals.html#project-level-mr-approvals)`,
 
 		CreateContext: resourceGitlabProjectApprovalRuleCreate,
@@ -98,7 +100,7 @@ func resourceGitlabProjectApprovalRuleCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Project %s create gitlab project-level rule %+v", project, options)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	rule, _, err := client.Projects.CreateProjectApprovalRule(project, &options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -124,7 +126,7 @@ func resourceGitlabProjectApprovalRuleRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	rule, _, err := client.Projects.GetProjectApprovalRule(projectID, ruleID, gitlab.WithContext(ctx))
 	if err != nil {
@@ -177,7 +179,7 @@ func resourceGitlabProjectApprovalRuleUpdate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Project %s update gitlab project-level approval rule %s", projectID, *options.Name)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	_, _, err = client.Projects.UpdateProjectApprovalRule(projectID, ruleIDInt, &options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -200,10 +202,14 @@ func resourceGitlabProjectApprovalRuleDelete(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Project %s delete gitlab project-level approval rule %d", project, ruleIDInt)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	_, err = client.Projects.DeleteProjectApprovalRule(project, ruleIDInt, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] project-level approval rule %s already deleted", d.Id())
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -16,6 +16,8 @@ var _ = registerResource("gitlab_project_badge", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_project_badge`" + ` resource allows to mange the lifecycle of project badges.
 
+-> Badges inherited from a group cannot be managed with this resource, use the ` + "`gitlab_group_badge`" + ` resource instead.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/user/project/badges.html#project-badges)`,
 
 		CreateContext: resourceGitlabProjectBadgeCreate,
@@ -30,6 +32,7 @@ var _ = registerResource("gitlab_project_badge", func() *schema.Resource {
 			"project": {
 				Description: "The id of the project to add the badge to.",
 				Type:        schema.TypeString,
+				ForceNew:    true,
 				Required:    true,
 			},
 			"link_url": {
@@ -62,7 +65,7 @@ var _ = registerResource("gitlab_project_badge", func() *schema.Resource {
 })
 
 func resourceGitlabProjectBadgeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID := d.Get("project").(string)
 	options := &gitlab.AddProjectBadgeOptions{
 		LinkURL:  gitlab.String(d.Get("link_url").(string)),
@@ -85,7 +88,7 @@ func resourceGitlabProjectBadgeCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectBadgeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID, badgeID, err := resourceGitlabProjectBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -103,12 +106,17 @@ func resourceGitlabProjectBadgeRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	// NOTE: the badges of a project include the badges inherited from its groups.
+	if badge.Kind == string(gitlab.GroupBadgeKind) {
+		return diag.Errorf("badge %d of project %s is inherited from a group and cannot be managed by the gitlab_project_badge resource, use the gitlab_group_badge resource instead", badgeID, projectID)
+	}
+
 	resourceGitlabProjectBadgeSetToState(d, badge, &projectID)
 	return nil
 }
 
 func resourceGitlabProjectBadgeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID, badgeID, err := resourceGitlabProjectBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -131,7 +139,7 @@ func resourceGitlabProjectBadgeUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectBadgeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID, badgeID, err := resourceGitlabProjectBadgeParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -140,7 +148,7 @@ func resourceGitlabProjectBadgeDelete(ctx context.Context, d *schema.ResourceDat
 	log.Printf("[DEBUG] Delete gitlab project badge %s/%d", projectID, badgeID)
 
 	_, err = client.ProjectBadges.DeleteProjectBadge(projectID, badgeID, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -5,10 +5,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
 )
 
 func TestAccGitlabProjectBadge_basic(t *testing.T) {
@@ -64,6 +66,39 @@ func TestAccGitlabProjectBadge_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectBadge_inheritedFromGroup(t *testing.T) {
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	groupBadge, _, err := testGitlabClient.GroupBadges.AddGroupBadge(testGroup.ID, &gitlab.AddGroupBadgeOptions{
+		LinkURL:  gitlab.String("https://example.com/group-badge"),
+		ImageURL: gitlab.String("https://example.com/group-badge.svg"),
+	})
+	if err != nil {
+		t.Fatalf("failed to create group badge: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectBadgeDestroy,
+		Steps: []resource.TestStep{
+			// Importing a badge inherited from the group fails
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_badge" "this" {
+						project   = "%d"
+						link_url  = "https://example.com/group-badge"
+						image_url = "https://example.com/group-badge.svg"
+					}
+				`, testProject.ID),
+				ResourceName:  "gitlab_project_badge.this",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%d:%d", testProject.ID, groupBadge.ID),
+				ExpectError:   regexp.MustCompile(`is inherited from a group and cannot be managed by the gitlab_project_badge resource`),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectBadgeDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project_badge" {
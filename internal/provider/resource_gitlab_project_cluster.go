@@ -101,6 +101,7 @@ var _ = registerResource("gitlab_project_cluster", func() *schema.Resource {
 				Description: "TLS certificate (needed if API is using a self-signed TLS certificate).",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return strings.TrimSpace(old) == strings.TrimSpace(new)
 				},
@@ -128,7 +129,7 @@ var _ = registerResource("gitlab_project_cluster", func() *schema.Resource {
 })
 
 func resourceGitlabProjectClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	pk := gitlab.AddPlatformKubernetesOptions{
@@ -182,7 +183,7 @@ func resourceGitlabProjectClusterCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabProjectClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -225,7 +226,7 @@ func resourceGitlabProjectClusterRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
@@ -284,7 +285,7 @@ func resourceGitlabProjectClusterUpdate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabProjectClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, clusterId, err := projectIdAndClusterIdFromId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -293,7 +294,7 @@ func resourceGitlabProjectClusterDelete(ctx context.Context, d *schema.ResourceD
 	log.Printf("[DEBUG] delete gitlab project cluster %q/%d", project, clusterId)
 
 	_, err = client.ProjectCluster.DeleteCluster(project, clusterId, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
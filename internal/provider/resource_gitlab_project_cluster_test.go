@@ -110,6 +110,63 @@ func TestAccGitlabProjectCluster_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectCluster_environmentScope(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	config := func(environmentScope string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_project_cluster" "this" {
+				project            = "%d"
+				name               = "scoped-cluster"
+				environment_scope  = "%s"
+				kubernetes_api_url = "https://123.123.123"
+				kubernetes_token   = "some-token"
+			}
+		`, testProject.ID, environmentScope)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccRequiresLessThan(t, "15.0") },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectClusterDestroy,
+		Steps: []resource.TestStep{
+			// Register the cluster for the production environment
+			{
+				Config: config("production"),
+				Check:  resource.TestCheckResourceAttr("gitlab_project_cluster.this", "environment_scope", "production"),
+			},
+			// Change the environment scope of the cluster in place
+			{
+				Config: config("staging/*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_cluster.this", "environment_scope", "staging/*"),
+					func(s *terraform.State) error {
+						_, clusterID, err := projectIdAndClusterIdFromId(s.RootModule().Resources["gitlab_project_cluster.this"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						cluster, _, err := testGitlabClient.ProjectCluster.GetCluster(testProject.ID, clusterID)
+						if err != nil {
+							return err
+						}
+						if cluster.EnvironmentScope != "staging/*" {
+							return fmt.Errorf("got environment scope %q; want %q", cluster.EnvironmentScope, "staging/*")
+						}
+						return nil
+					},
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project_cluster.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enabled", "kubernetes_token", "managed"},
+			},
+		},
+	})
+}
+
 type testAccGitlabProjectClusterExpectedAttributes struct {
 	Name                        string
 	Domain                      string
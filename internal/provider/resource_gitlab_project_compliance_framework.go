@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_compliance_framework", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_compliance_framework`" + ` resource allows to manage the lifecycle of the compliance frameworks assigned to a project.
+
+-> Compliance frameworks are defined in the top-level group of the project and require GitLab Premium.
+
+-> Assigning multiple compliance frameworks with ` + "`compliance_framework_ids`" + ` requires at least GitLab 17.3.
+
+**Upstream API**: [GitLab GraphQL API docs](https://docs.gitlab.com/ee/api/graphql/reference/#mutationprojectsetcomplianceframework)`,
+
+		CreateContext: resourceGitlabProjectComplianceFrameworkSet,
+		ReadContext:   resourceGitlabProjectComplianceFrameworkRead,
+		UpdateContext: resourceGitlabProjectComplianceFrameworkSet,
+		DeleteContext: resourceGitlabProjectComplianceFrameworkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description:  "The ID or full path of the project to assign the compliance framework to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"compliance_framework_id": {
+				Description:  "The global ID of the compliance framework to assign to the project, e.g. `gid://gitlab/ComplianceManagement::Framework/1`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"compliance_framework_id", "compliance_framework_ids"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"compliance_framework_ids": {
+				Description:  "The global IDs of the compliance frameworks to assign to the project.",
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ExactlyOneOf: []string{"compliance_framework_id", "compliance_framework_ids"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+})
+
+func resourceGitlabProjectComplianceFrameworkSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+
+	var err error
+	if v, ok := d.GetOk("compliance_framework_ids"); ok {
+		err = setProjectComplianceFrameworks(ctx, client, project, *stringSetToStringSlice(v.(*schema.Set)))
+	} else {
+		frameworkID := d.Get("compliance_framework_id").(string)
+		err = setProjectComplianceFramework(ctx, client, project, &frameworkID)
+	}
+	if err != nil {
+		return diag.Errorf("failed to assign compliance framework to project %q: %v", project, err)
+	}
+
+	d.SetId(project)
+	return resourceGitlabProjectComplianceFrameworkRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectComplianceFrameworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Id()
+
+	log.Printf("[DEBUG] read compliance frameworks of gitlab project %s", project)
+	frameworkIDs, err := getProjectComplianceFrameworkIDs(ctx, client, project)
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project %s not found, removing compliance framework from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read compliance frameworks of project %q: %v", project, err)
+	}
+	if len(frameworkIDs) == 0 {
+		log.Printf("[DEBUG] gitlab project %s has no compliance framework, removing from state", project)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	// A single framework is stored in `compliance_framework_id`, unless the frameworks are configured as list.
+	// This also decides which attribute is set on import.
+	if _, ok := d.GetOk("compliance_framework_ids"); ok || len(frameworkIDs) > 1 {
+		d.Set("compliance_framework_id", "")
+		if err := d.Set("compliance_framework_ids", frameworkIDs); err != nil {
+			return diag.Errorf("failed to set compliance_framework_ids to state: %v", err)
+		}
+	} else {
+		d.Set("compliance_framework_id", frameworkIDs[0])
+	}
+
+	return nil
+}
+
+func resourceGitlabProjectComplianceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Id()
+
+	log.Printf("[DEBUG] remove compliance frameworks from gitlab project %s", project)
+	var err error
+	if _, ok := d.GetOk("compliance_framework_ids"); ok {
+		err = setProjectComplianceFrameworks(ctx, client, project, []string{})
+	} else {
+		err = setProjectComplianceFramework(ctx, client, project, nil)
+	}
+	if err != nil && !is404(err) {
+		return diag.Errorf("failed to remove compliance framework from project %q: %v", project, err)
+	}
+
+	return nil
+}
+
+// setProjectComplianceFramework assigns a single compliance framework to the project, replacing
+// any other framework. The framework is removed from the project if frameworkID is nil.
+func setProjectComplianceFramework(ctx context.Context, client *gitlab.Client, project string, frameworkID *string) error {
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	query := GraphQLQuery{
+		Query: `mutation($projectId: ProjectID!, $frameworkId: ComplianceManagementFrameworkID) {
+			projectSetComplianceFramework(input: {projectId: $projectId, complianceFrameworkId: $frameworkId}) { errors }
+		}`,
+		Variables: map[string]interface{}{
+			"projectId":   fmt.Sprintf("gid://gitlab/Project/%d", p.ID),
+			"frameworkId": frameworkID,
+		},
+	}
+
+	var response struct {
+		Data struct {
+			ProjectSetComplianceFramework struct {
+				Errors []string `json:"errors"`
+			} `json:"projectSetComplianceFramework"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return err
+	}
+	if errs := response.Data.ProjectSetComplianceFramework.Errors; len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// setProjectComplianceFrameworks assigns exactly the given compliance frameworks to the project.
+func setProjectComplianceFrameworks(ctx context.Context, client *gitlab.Client, project string, frameworkIDs []string) error {
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	query := GraphQLQuery{
+		Query: `mutation($projectId: ProjectID!, $frameworkIds: [ComplianceManagementFrameworkID!]!) {
+			projectUpdateComplianceFrameworks(input: {projectId: $projectId, complianceFrameworkIds: $frameworkIds}) { errors }
+		}`,
+		Variables: map[string]interface{}{
+			"projectId":    fmt.Sprintf("gid://gitlab/Project/%d", p.ID),
+			"frameworkIds": frameworkIDs,
+		},
+	}
+
+	var response struct {
+		Data struct {
+			ProjectUpdateComplianceFrameworks struct {
+				Errors []string `json:"errors"`
+			} `json:"projectUpdateComplianceFrameworks"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return err
+	}
+	if errs := response.Data.ProjectUpdateComplianceFrameworks.Errors; len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// getProjectComplianceFrameworkIDs returns the global IDs of the compliance frameworks assigned to the project.
+func getProjectComplianceFrameworkIDs(ctx context.Context, client *gitlab.Client, project string) ([]string, error) {
+	// The project is looked up with the REST API first, because the GraphQL API only finds projects by full path.
+	p, _, err := client.Projects.GetProject(project, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	query := GraphQLQuery{
+		Query: `query($fullPath: ID!) {
+			project(fullPath: $fullPath) { complianceFrameworks { nodes { id } } }
+		}`,
+		Variables: map[string]interface{}{
+			"fullPath": p.PathWithNamespace,
+		},
+	}
+
+	var response struct {
+		Data struct {
+			Project struct {
+				ComplianceFrameworks struct {
+					Nodes []struct {
+						ID string `json:"id"`
+					} `json:"nodes"`
+				} `json:"complianceFrameworks"`
+			} `json:"project"`
+		} `json:"data"`
+	}
+	if _, err := SendGraphQLRequest(ctx, client, query, &response); err != nil {
+		return nil, err
+	}
+
+	frameworkIDs := make([]string, 0, len(response.Data.Project.ComplianceFrameworks.Nodes))
+	for _, node := range response.Data.Project.ComplianceFrameworks.Nodes {
+		frameworkIDs = append(frameworkIDs, node.ID)
+	}
+	return frameworkIDs, nil
+}
@@ -0,0 +1,123 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectComplianceFramework_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	frameworkIDs := testAccCreateComplianceFrameworks(t, testGroup, 2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectComplianceFrameworkDestroy,
+		Steps: []resource.TestStep{
+			// Assign a compliance framework to the project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_compliance_framework" "this" {
+						project                 = "%s"
+						compliance_framework_id = "%s"
+					}
+				`, testProject.PathWithNamespace, frameworkIDs[0]),
+				Check: resource.TestCheckResourceAttr("gitlab_project_compliance_framework.this", "compliance_framework_id", frameworkIDs[0]),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_compliance_framework.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Assign another compliance framework to the project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_compliance_framework" "this" {
+						project                 = "%s"
+						compliance_framework_id = "%s"
+					}
+				`, testProject.PathWithNamespace, frameworkIDs[1]),
+				Check: resource.TestCheckResourceAttr("gitlab_project_compliance_framework.this", "compliance_framework_id", frameworkIDs[1]),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectComplianceFramework_multiple(t *testing.T) {
+	testAccCheckEE(t)
+	testAccRequiresAtLeast(t, "17.3")
+
+	testGroup := testAccCreateGroups(t, 1)[0]
+	testProject := testAccCreateProjectWithNamespace(t, testGroup.ID)
+	frameworkIDs := testAccCreateComplianceFrameworks(t, testGroup, 2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectComplianceFrameworkDestroy,
+		Steps: []resource.TestStep{
+			// Assign two compliance frameworks to the project
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_compliance_framework" "this" {
+						project                  = %d
+						compliance_framework_ids = ["%s", "%s"]
+					}
+				`, testProject.ID, frameworkIDs[0], frameworkIDs[1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_compliance_framework.this", "compliance_framework_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("gitlab_project_compliance_framework.this", "compliance_framework_ids.*", frameworkIDs[0]),
+					resource.TestCheckTypeSetElemAttr("gitlab_project_compliance_framework.this", "compliance_framework_ids.*", frameworkIDs[1]),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_compliance_framework.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove one of the compliance frameworks
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_compliance_framework" "this" {
+						project                  = %d
+						compliance_framework_ids = ["%s"]
+					}
+				`, testProject.ID, frameworkIDs[1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_compliance_framework.this", "compliance_framework_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("gitlab_project_compliance_framework.this", "compliance_framework_ids.*", frameworkIDs[1]),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectComplianceFrameworkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_compliance_framework" {
+			continue
+		}
+
+		frameworkIDs, err := getProjectComplianceFrameworkIDs(context.Background(), testGitlabClient, rs.Primary.ID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if len(frameworkIDs) > 0 {
+			return fmt.Errorf("project %s still has compliance frameworks %v", rs.Primary.ID, frameworkIDs)
+		}
+	}
+	return nil
+}
@@ -19,6 +19,8 @@ var _ = registerResource("gitlab_project_custom_attribute", func() *schema.Resou
 		},
 		`The `+"`gitlab_project_custom_attribute`"+` resource allows to manage custom attributes for a project.
 
+-> Requires administrator privileges.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/custom_attributes.html)`,
 	)
 })
@@ -63,6 +63,36 @@ resource "gitlab_project_custom_attribute" "attr" {
 					}),
 				),
 			},
+			// Change the key of the custom attribute, which replaces the attribute
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project" "project" {
+	name = "foo-%d"
+}
+
+resource "gitlab_project_custom_attribute" "attr" {
+	project = gitlab_project.project.id
+	key     = "baz"
+	value   = "updated"
+}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectCustomAttributeExists("gitlab_project_custom_attribute.attr", &customAttribute),
+					testAccCheckGitlabProjectCustomAttributes(&customAttribute, &testAccGitlabProjectExpectedCustomAttributes{
+						Key:   "baz",
+						Value: "updated",
+					}),
+					func(s *terraform.State) error {
+						_, _, err := testGitlabClient.CustomAttribute.GetCustomProjectAttribute(project.ID, "foo")
+						if err == nil {
+							return fmt.Errorf("custom attribute %q of project %d still exists", "foo", project.ID)
+						}
+						if !is404(err) {
+							return err
+						}
+						return nil
+					},
+				),
+			},
 			{
 				ResourceName:      "gitlab_project_custom_attribute.attr",
 				ImportState:       true,
@@ -51,6 +51,13 @@ Set the ` + "`stop_before_destroy`" + ` flag to attempt to automatically stop th
 				Optional:     true,
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
+			"tier": {
+				Description:  fmt.Sprintf("The tier of the environment. Valid values are %s. If not set, GitLab derives the tier from the name of the environment.", renderValueListForDocs(validProjectEnvironmentTiers)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(validProjectEnvironmentTiers, false),
+			},
 			"slug": {
 				Description: "The name of the environment in lowercase, shortened to 63 bytes, and with everything except 0-9 and a-z replaced with -. No leading / trailing -. Use in URLs, host names and domain names.",
 				Type:        schema.TypeString,
@@ -89,12 +96,15 @@ func resourceGitlabProjectEnvironmentCreate(ctx context.Context, d *schema.Resou
 	if externalURL, ok := d.GetOk("external_url"); ok {
 		options.ExternalURL = gitlab.String(externalURL.(string))
 	}
+	if tier, ok := d.GetOk("tier"); ok {
+		options.Tier = gitlab.String(tier.(string))
+	}
 
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] Project %s create gitlab environment %q", project, *options.Name)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	environment, _, err := client.Environments.CreateEnvironment(project, &options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -119,7 +129,7 @@ func resourceGitlabProjectEnvironmentRead(ctx context.Context, d *schema.Resourc
 
 	log.Printf("[DEBUG] Project %s read gitlab environment %d", project, environmentID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	environment, _, err := client.Environments.GetEnvironment(project, environmentID, gitlab.WithContext(ctx))
 	if err != nil {
@@ -135,6 +145,8 @@ func resourceGitlabProjectEnvironmentRead(ctx context.Context, d *schema.Resourc
 	d.Set("name", environment.Name)
 	d.Set("state", environment.State)
 	d.Set("external_url", environment.ExternalURL)
+	d.Set("tier", environment.Tier)
+	d.Set("slug", environment.Slug)
 	d.Set("created_at", environment.CreatedAt.Format(time.RFC3339))
 	if environment.UpdatedAt != nil {
 		d.Set("updated_at", environment.UpdatedAt.Format(time.RFC3339))
@@ -159,9 +171,13 @@ func resourceGitlabProjectEnvironmentUpdate(ctx context.Context, d *schema.Resou
 		options.ExternalURL = gitlab.String(d.Get("external_url").(string))
 	}
 
+	if d.HasChange("tier") {
+		options.Tier = gitlab.String(d.Get("tier").(string))
+	}
+
 	log.Printf("[DEBUG] Project %s update gitlab environment %d", project, environmentID)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	if _, _, err := client.Environments.EditEnvironment(project, environmentID, options, gitlab.WithContext(ctx)); err != nil {
 		return diag.Errorf("error editing gitlab project %s environment %d: %v", project, environmentID, err)
@@ -171,34 +187,33 @@ func resourceGitlabProjectEnvironmentUpdate(ctx context.Context, d *schema.Resou
 }
 
 func resourceGitlabProjectEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, environmentID, err := resourceGitlabProjectEnvironmentParseID(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	stopBeforeDestroy := d.Get("stop_before_destroy").(bool)
-
-	if stopBeforeDestroy {
-		log.Printf("[DEBUG] Stopping environment %d for Project %s before destruction", environmentID, project)
-		_, err = client.Environments.StopEnvironment(project, environmentID, gitlab.WithContext(ctx))
-		if err != nil {
-			return diag.Errorf("error stopping gitlab project %s environment %q: %v", project, environmentID, err)
-		}
-	} else {
-		environment, _, err := client.Environments.GetEnvironment(project, environmentID, gitlab.WithContext(ctx))
-		if err != nil {
-			if is404(err) {
-				log.Printf("[DEBUG] Project %s gitlab environment %d not found, removing from state", project, environmentID)
-				d.SetId("")
-				return nil
-			}
-			return diag.Errorf("error getting gitlab project %s environment %d: %v", project, environmentID, err)
+	environment, _, err := client.Environments.GetEnvironment(project, environmentID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] Project %s gitlab environment %d not found, removing from state", project, environmentID)
+			d.SetId("")
+			return nil
 		}
+		return diag.Errorf("error getting gitlab project %s environment %d: %v", project, environmentID, err)
+	}
 
-		if environment.State != "stopped" {
+	// GitLab refuses to delete environments which are not stopped.
+	if environment.State != "stopped" {
+		if !d.Get("stop_before_destroy").(bool) {
 			return diag.Errorf("[ERROR] cannot destroy gitlab project %s environment %d: Environment must be in a stopped state before deletion. Set stop_before_destroy flag to attempt to auto stop the environment on destruction", project, environmentID)
 		}
+
+		log.Printf("[DEBUG] Stopping environment %d for Project %s before destruction", environmentID, project)
+		_, _, err = client.Environments.StopEnvironment(project, environmentID, nil, gitlab.WithContext(ctx))
+		if err != nil {
+			return diag.Errorf("error stopping gitlab project %s environment %d: %v", project, environmentID, err)
+		}
 	}
 
 	_, err = client.Environments.DeleteEnvironment(project, environmentID, gitlab.WithContext(ctx))
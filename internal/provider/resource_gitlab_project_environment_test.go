@@ -68,6 +68,8 @@ func TestAccGitlabProjectEnvironment_basic(t *testing.T) {
 						State:       "available",
 						ExternalURL: "https://example.com",
 					}),
+					resource.TestCheckResourceAttr("gitlab_project_environment.this", "tier", "staging"),
+					resource.TestCheckResourceAttrSet("gitlab_project_environment.this", "slug"),
 					resource.TestCheckResourceAttrWith("gitlab_project_environment.this", "created_at", func(value string) error {
 						expectedValue := env2.CreatedAt.Format(time.RFC3339)
 						if value != expectedValue {
@@ -240,6 +242,7 @@ resource "gitlab_project_environment" "this" {
   project      = %d
   name         = "ProjectEnvironment-%d"
   external_url = "https://example.com"
+  tier         = "staging"
 }
 `, projectID, rInt)
 }
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_fork", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_fork`" + ` resource allows to manage the lifecycle of a fork of a project.
+
+-> Destroying the resource deletes the forked project. Set ` + "`unlink_on_destroy`" + ` to remove the fork relationship before the fork is deleted.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#fork-project)`,
+
+		CreateContext: resourceGitlabProjectForkCreate,
+		ReadContext:   resourceGitlabProjectForkRead,
+		UpdateContext: resourceGitlabProjectForkUpdate,
+		DeleteContext: resourceGitlabProjectForkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or URL-encoded path of the project to fork.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				// NOTE: the ID and the path of the forked project are equivalent, e.g. imported forks store the path.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != "" && resourceGitlabProjectForkIsForkedFromProject(d, old) && resourceGitlabProjectForkIsForkedFromProject(d, new)
+				},
+			},
+			"namespace": {
+				Description: "The ID or full path of the namespace (group or user) the project is forked into. Defaults to the namespace of the current user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the forked project. Defaults to the name of the forked project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"path": {
+				Description: "The path of the forked project. Defaults to the path of the forked project.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"unlink_on_destroy": {
+				Description: "Remove the fork relationship before deleting the forked project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"forked_from_project_id": {
+				Description: "The ID of the project the fork has been created from. `0` if the fork relationship has been removed.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"forked_from_project_path": {
+				Description: "The path with namespace of the project the fork has been created from. Empty if the fork relationship has been removed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"path_with_namespace": {
+				Description: "The path of the forked project with namespace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"web_url": {
+				Description: "URL that can be used to find the forked project in a browser.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"http_url_to_repo": {
+				Description: "URL that can be provided to `git clone` to clone the forked repository via HTTP.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ssh_url_to_repo": {
+				Description: "URL that can be provided to `git clone` to clone the forked repository via SSH.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: resourceGitlabProjectForkCustomizeDiff,
+	}
+})
+
+// resourceGitlabProjectForkIsForkedFromProject returns true if the given ID or path is the project the fork has been created from.
+func resourceGitlabProjectForkIsForkedFromProject(d *schema.ResourceData, project string) bool {
+	forkedFromProjectID := d.Get("forked_from_project_id").(int)
+	if forkedFromProjectID == 0 {
+		return false
+	}
+	return project == strconv.Itoa(forkedFromProjectID) || project == d.Get("forked_from_project_path").(string)
+}
+
+// resourceGitlabProjectForkCustomizeDiff suppresses the replacement of a fork
+// if the namespace is only changed between its ID and its full path, e.g. after an import.
+func resourceGitlabProjectForkCustomizeDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	if rd.Id() == "" || !rd.HasChange("namespace") || !rd.NewValueKnown("namespace") {
+		return nil
+	}
+
+	client := meta.(*ProviderMeta).Client
+	oldNamespace, newNamespace := rd.GetChange("namespace")
+	if oldNamespace.(string) == "" || newNamespace.(string) == "" {
+		return nil
+	}
+
+	var namespaceIDs []int
+	for _, namespace := range []string{oldNamespace.(string), newNamespace.(string)} {
+		n, _, err := client.Namespaces.GetNamespace(namespace, gitlab.WithContext(ctx))
+		if err != nil {
+			if is404(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to read namespace %s: %w", namespace, err)
+		}
+		namespaceIDs = append(namespaceIDs, n.ID)
+	}
+
+	if namespaceIDs[0] == namespaceIDs[1] {
+		log.Printf("[DEBUG] gitlab namespaces %s and %s are the same, suppressing diff", oldNamespace, newNamespace)
+		return rd.Clear("namespace")
+	}
+	return nil
+}
+
+func resourceGitlabProjectForkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+
+	options := &gitlab.ForkProjectOptions{}
+	if v, ok := d.GetOk("namespace"); ok {
+		namespace := v.(string)
+		if namespaceID, err := strconv.Atoi(namespace); err == nil {
+			options.NamespaceID = gitlab.Int(namespaceID)
+		} else {
+			options.NamespacePath = gitlab.String(namespace)
+		}
+	}
+	if v, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(v.(string))
+	}
+	if v, ok := d.GetOk("path"); ok {
+		options.Path = gitlab.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] fork gitlab project %s", project)
+	fork, _, err := client.Projects.ForkProject(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to fork project %s: %s", project, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", fork.ID))
+
+	// Forking a project is async, the repository is imported in the background.
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"scheduled", "started"},
+		Target:  []string{"finished", "none"},
+		Timeout: 10 * time.Minute,
+		Refresh: func() (interface{}, string, error) {
+			out, _, err := client.Projects.GetProject(fork.ID, nil, gitlab.WithContext(ctx))
+			if err != nil {
+				return nil, "", err
+			}
+			return out, out.ImportStatus, nil
+		},
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error while waiting for fork %d of project %s to finish: %s", fork.ID, project, err)
+	}
+
+	return resourceGitlabProjectForkRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectForkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	log.Printf("[DEBUG] read gitlab project fork %s", d.Id())
+	fork, _, err := client.Projects.GetProject(d.Id(), nil, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project fork %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read project fork %s: %s", d.Id(), err)
+	}
+	if fork.MarkedForDeletionAt != nil {
+		log.Printf("[DEBUG] gitlab project fork %s is marked for deletion, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	forkedFromProjectID := 0
+	forkedFromProjectPath := ""
+	if fork.ForkedFromProject != nil {
+		forkedFromProjectID = fork.ForkedFromProject.ID
+		forkedFromProjectPath = fork.ForkedFromProject.PathWithNamespace
+	}
+
+	// NOTE: the project and namespace can be given as ID or path, thus they are only set when unknown, e.g. after an import.
+	//       Configurations using the other form don't cause a replacement, see the project DiffSuppressFunc and the CustomizeDiff.
+	if d.Get("project").(string) == "" && fork.ForkedFromProject != nil {
+		d.Set("project", fork.ForkedFromProject.PathWithNamespace)
+	}
+	if d.Get("namespace").(string) == "" && fork.Namespace != nil {
+		d.Set("namespace", fork.Namespace.FullPath)
+	}
+
+	d.Set("name", fork.Name)
+	d.Set("path", fork.Path)
+	d.Set("forked_from_project_id", forkedFromProjectID)
+	d.Set("forked_from_project_path", forkedFromProjectPath)
+	d.Set("path_with_namespace", fork.PathWithNamespace)
+	d.Set("web_url", fork.WebURL)
+	d.Set("http_url_to_repo", fork.HTTPURLToRepo)
+	d.Set("ssh_url_to_repo", fork.SSHURLToRepo)
+
+	return nil
+}
+
+func resourceGitlabProjectForkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	options := &gitlab.EditProjectOptions{}
+	if d.HasChange("name") {
+		options.Name = gitlab.String(d.Get("name").(string))
+	}
+	if d.HasChange("path") {
+		options.Path = gitlab.String(d.Get("path").(string))
+	}
+
+	if *options != (gitlab.EditProjectOptions{}) {
+		log.Printf("[DEBUG] update gitlab project fork %s", d.Id())
+		if _, _, err := client.Projects.EditProject(d.Id(), options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to update project fork %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceGitlabProjectForkRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectForkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	if d.Get("unlink_on_destroy").(bool) {
+		log.Printf("[DEBUG] remove fork relationship of gitlab project %s", d.Id())
+		if _, err := client.Projects.DeleteProjectForkRelation(d.Id(), gitlab.WithContext(ctx)); err != nil && !is404(err) {
+			return diag.Errorf("failed to remove fork relationship of project %s: %s", d.Id(), err)
+		}
+	}
+
+	log.Printf("[DEBUG] delete gitlab project fork %s", d.Id())
+	if _, err := client.Projects.DeleteProject(d.Id(), nil, gitlab.WithContext(ctx)); err != nil {
+		if is404(err) {
+			return nil
+		}
+		return diag.Errorf("failed to delete project fork %s: %s", d.Id(), err)
+	}
+
+	if err := resourceGitlabProjectWaitForDeletion(ctx, client, d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabProjectFork_basic(t *testing.T) {
+	testProject := testAccCreateProject(t)
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectForkDestroy,
+		Steps: []resource.TestStep{
+			// Fork the project into a group
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_fork" "this" {
+						project   = "%d"
+						namespace = "%s"
+						name      = "fork"
+						path      = "fork"
+					}
+				`, testProject.ID, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_fork.this", "forked_from_project_id", fmt.Sprintf("%d", testProject.ID)),
+					resource.TestCheckResourceAttr("gitlab_project_fork.this", "forked_from_project_path", testProject.PathWithNamespace),
+					resource.TestCheckResourceAttr("gitlab_project_fork.this", "path_with_namespace", fmt.Sprintf("%s/fork", testGroup.FullPath)),
+					resource.TestCheckResourceAttrSet("gitlab_project_fork.this", "web_url"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_fork.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// NOTE: imported forks store the path of the forked project instead of the configured ID.
+				ImportStateVerifyIgnore: []string{"project", "unlink_on_destroy"},
+			},
+			// Rename the fork in place
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_fork" "this" {
+						project           = "%d"
+						namespace         = "%s"
+						name              = "renamed-fork"
+						path              = "renamed-fork"
+						unlink_on_destroy = true
+					}
+				`, testProject.ID, testGroup.FullPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_fork.this", "forked_from_project_id", fmt.Sprintf("%d", testProject.ID)),
+					resource.TestCheckResourceAttr("gitlab_project_fork.this", "path_with_namespace", fmt.Sprintf("%s/renamed-fork", testGroup.FullPath)),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_fork.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// NOTE: imported forks store the path of the forked project instead of the configured ID.
+				ImportStateVerifyIgnore: []string{"project", "unlink_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectFork_importWithPath(t *testing.T) {
+	testProject := testAccCreateProject(t)
+	testGroup := testAccCreateGroups(t, 1)[0]
+
+	config := fmt.Sprintf(`
+		resource "gitlab_project_fork" "this" {
+			project   = "%s"
+			namespace = "%d"
+			name      = "fork"
+			path      = "fork"
+		}
+	`, testProject.PathWithNamespace, testGroup.ID)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectForkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("gitlab_project_fork.this", "forked_from_project_id", fmt.Sprintf("%d", testProject.ID)),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_project_fork.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// NOTE: imported forks store the full path of the namespace instead of the configured ID.
+				ImportStateVerifyIgnore: []string{"namespace", "unlink_on_destroy"},
+			},
+			// Verify that the import stores the path of the forked project
+			{
+				ResourceName: "gitlab_project_fork.this",
+				ImportState:  true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if project := states[0].Attributes["project"]; project != testProject.PathWithNamespace {
+						return fmt.Errorf("expected imported project %q, got %q", testProject.PathWithNamespace, project)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectForkDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_fork" {
+			continue
+		}
+
+		fork, _, err := testGitlabClient.Projects.GetProject(rs.Primary.ID, nil)
+		if err == nil {
+			if fork.MarkedForDeletionAt == nil {
+				return fmt.Errorf("Project fork %s still exists", rs.Primary.ID)
+			}
+			continue
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
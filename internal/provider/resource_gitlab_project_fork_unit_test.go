@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGitlab_resourceGitlabProjectForkDiff(t *testing.T) {
+	cases := []struct {
+		Name                string
+		StateProject        string
+		Project             string
+		Namespace           string
+		ExpectedRequiresNew bool
+	}{
+		{
+			Name:      "same project and namespace",
+			Project:   "upstream/project",
+			Namespace: "fork-group",
+		},
+		{
+			Name:      "project ID of imported fork",
+			Project:   "42",
+			Namespace: "fork-group",
+		},
+		{
+			Name:      "namespace ID of imported fork",
+			Project:   "upstream/project",
+			Namespace: "7",
+		},
+		{
+			Name:         "project path of fork created with project ID",
+			StateProject: "42",
+			Project:      "upstream/project",
+			Namespace:    "fork-group",
+		},
+		{
+			Name:                "other project",
+			Project:             "43",
+			Namespace:           "fork-group",
+			ExpectedRequiresNew: true,
+		},
+		{
+			Name:                "other project path of fork created with project ID",
+			StateProject:        "42",
+			Project:             "other/project",
+			Namespace:           "fork-group",
+			ExpectedRequiresNew: true,
+		},
+		{
+			Name:                "other namespace",
+			Project:             "upstream/project",
+			Namespace:           "8",
+			ExpectedRequiresNew: true,
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/namespaces/", func(w http.ResponseWriter, r *http.Request) {
+		namespaceIDs := map[string]int{"fork-group": 7, "7": 7, "other-group": 8, "8": 8}
+		id, ok := namespaceIDs[strings.TrimPrefix(r.URL.Path, "/api/v4/namespaces/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id": %d}`, id)
+	})
+	client := newTestGitlabClient(t, mux)
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := allResources["gitlab_project_fork"]()
+			coreSchema := r.CoreConfigSchema()
+			attributes := map[string]cty.Value{}
+			for name, attribute := range coreSchema.Attributes {
+				attributes[name] = cty.NullVal(attribute.Type)
+			}
+			attributes["project"] = cty.StringVal(tc.Project)
+			attributes["namespace"] = cty.StringVal(tc.Namespace)
+			rawConfig := cty.ObjectVal(attributes)
+
+			// The state of an imported fork, unless the project is given
+			stateProject := "upstream/project"
+			if tc.StateProject != "" {
+				stateProject = tc.StateProject
+			}
+			state := &terraform.InstanceState{
+				ID: "99",
+				Attributes: map[string]string{
+					"id":                       "99",
+					"project":                  stateProject,
+					"namespace":                "fork-group",
+					"name":                     "fork",
+					"path":                     "fork",
+					"unlink_on_destroy":        "false",
+					"forked_from_project_id":   "42",
+					"forked_from_project_path": "upstream/project",
+					"path_with_namespace":      "fork-group/fork",
+				},
+				RawConfig: rawConfig,
+			}
+
+			diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(rawConfig, coreSchema), &ProviderMeta{Client: client})
+			if err != nil {
+				t.Fatalf("failed to diff: %v", err)
+			}
+
+			if requiresNew := diff != nil && diff.RequiresNew(); requiresNew != tc.ExpectedRequiresNew {
+				t.Fatalf("expected requires new %t, got %t: %v", tc.ExpectedRequiresNew, requiresNew, diff)
+			}
+		})
+	}
+}
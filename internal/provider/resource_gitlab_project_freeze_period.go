@@ -32,14 +32,16 @@ var _ = registerResource("gitlab_project_freeze_period", func() *schema.Resource
 				ForceNew:    true,
 			},
 			"freeze_start": {
-				Description: "Start of the Freeze Period in cron format (e.g. `0 1 * * *`).",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Start of the Freeze Period in cron format (e.g. `0 1 * * *`).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCronExpressionFunc,
 			},
 			"freeze_end": {
-				Description: "End of the Freeze Period in cron format (e.g. `0 2 * * *`).",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "End of the Freeze Period in cron format (e.g. `0 2 * * *`).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCronExpressionFunc,
 			},
 			"cron_timezone": {
 				Description: "The timezone.",
@@ -62,7 +64,7 @@ func resourceGitlabProjectFreezePeriodCreate(ctx context.Context, d *schema.Reso
 
 	log.Printf("[DEBUG] Project %s create gitlab project-level freeze period %+v", projectID, options)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	FreezePeriod, _, err := client.FreezePeriods.CreateFreezePeriodOptions(projectID, &options, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
@@ -75,7 +77,7 @@ func resourceGitlabProjectFreezePeriodCreate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabProjectFreezePeriodRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -102,7 +104,7 @@ func resourceGitlabProjectFreezePeriodRead(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectFreezePeriodUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(d.Id())
 	options := &gitlab.UpdateFreezePeriodOptions{}
 
@@ -133,7 +135,7 @@ func resourceGitlabProjectFreezePeriodUpdate(ctx context.Context, d *schema.Reso
 }
 
 func resourceGitlabProjectFreezePeriodDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(d.Id())
 	log.Printf("[DEBUG] Delete gitlab FreezePeriod %s", d.Id())
 
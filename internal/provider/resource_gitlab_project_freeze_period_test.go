@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -164,3 +165,69 @@ resource "gitlab_project_freeze_period" "schedule" {
 }
 	`, rInt)
 }
+
+func TestAccGitlabProjectFreezePeriod_weekend(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectFreezePeriodDestroy,
+		Steps: []resource.TestStep{
+			// Reject invalid cron expressions during plan
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_freeze_period" "weekend" {
+						project_id   = %d
+						freeze_start = "0 23 * * FRIDAY"
+						freeze_end   = "0 7 * *"
+					}
+				`, testProject.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not a valid cron expression`),
+			},
+			// Freeze deployments from Friday evening to Monday morning
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_freeze_period" "weekend" {
+						project_id    = %d
+						freeze_start  = "0 18 * * FRI"
+						freeze_end    = "0 8 * * MON"
+						cron_timezone = "Europe/Berlin"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_freeze_period.weekend", "freeze_start", "0 18 * * FRI"),
+					resource.TestCheckResourceAttr("gitlab_project_freeze_period.weekend", "freeze_end", "0 8 * * MON"),
+					resource.TestCheckResourceAttr("gitlab_project_freeze_period.weekend", "cron_timezone", "Europe/Berlin"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_freeze_period.weekend",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectFreezePeriodDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_project_freeze_period" {
+			continue
+		}
+
+		projectID, freezePeriodID, err := projectIDAndFreezePeriodIDFromID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.FreezePeriods.GetFreezePeriod(projectID, freezePeriodID)
+		if err == nil {
+			return fmt.Errorf("Freeze period %s still exists", rs.Primary.ID)
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -25,12 +25,12 @@ var _ = registerResource("gitlab_project_hook", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGitlabProjectHookStateImporter,
 		},
-		Schema: gitlabProjectHookSchema(),
+		Schema: gitlabProjectHookResourceSchema(),
 	}
 })
 
 func resourceGitlabProjectHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	options := &gitlab.AddProjectHookOptions{
 		URL:                      gitlab.String(d.Get("url").(string)),
@@ -54,6 +54,10 @@ func resourceGitlabProjectHookCreate(ctx context.Context, d *schema.ResourceData
 		options.Token = gitlab.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("custom_headers"); ok {
+		options.CustomHeaders = expandHookCustomHeaders(v)
+	}
+
 	log.Printf("[DEBUG] create gitlab project hook %q", *options.URL)
 
 	hook, _, err := client.Projects.AddProjectHook(project, options, gitlab.WithContext(ctx))
@@ -64,11 +68,18 @@ func resourceGitlabProjectHookCreate(ctx context.Context, d *schema.ResourceData
 	d.SetId(fmt.Sprintf("%d", hook.ID))
 	d.Set("token", options.Token)
 
-	return resourceGitlabProjectHookRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if d.Get("test_on_create").(bool) {
+		status, testDiags := testGitlabProjectHook(ctx, client, project, hook.ID)
+		d.Set("last_test_status", status)
+		diags = append(diags, testDiags...)
+	}
+
+	return append(diags, resourceGitlabProjectHookRead(ctx, d, meta)...)
 }
 
 func resourceGitlabProjectHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -94,7 +105,7 @@ func resourceGitlabProjectHookRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -129,11 +140,26 @@ func resourceGitlabProjectHookUpdate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("custom_headers") {
+		oldHeaders, newHeaders := d.GetChange("custom_headers")
+		changed, removed := changedHookCustomHeaders(oldHeaders, newHeaders)
+		for key, value := range changed {
+			if _, err := client.Projects.SetProjectCustomHeader(project, hookId, key, &gitlab.SetHookCustomHeaderOptions{Value: gitlab.String(value)}, gitlab.WithContext(ctx)); err != nil {
+				return diag.Errorf("failed to set custom header %q of project hook %s: %v", key, d.Id(), err)
+			}
+		}
+		for _, key := range removed {
+			if _, err := client.Projects.DeleteProjectCustomHeader(project, hookId, key, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+				return diag.Errorf("failed to delete custom header %q of project hook %s: %v", key, d.Id(), err)
+			}
+		}
+	}
+
 	return resourceGitlabProjectHookRead(ctx, d, meta)
 }
 
 func resourceGitlabProjectHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	hookId, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -76,7 +76,7 @@ func TestAccGitlabProjectHook_basic(t *testing.T) {
 				ImportStateIdFunc:       getProjectHookImportID("gitlab_project_hook.foo"),
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"token"},
+				ImportStateVerifyIgnore: []string{"token", "test_on_create"},
 			},
 		},
 	})
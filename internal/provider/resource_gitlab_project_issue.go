@@ -51,7 +51,7 @@ var _ = registerResource("gitlab_project_issue", func() *schema.Resource {
 })
 
 func resourceGitlabProjectIssueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	options := &gitlab.CreateIssueOptions{
@@ -90,7 +90,7 @@ func resourceGitlabProjectIssueCreate(ctx context.Context, d *schema.ResourceDat
 		options.IssueType = gitlab.String(issueType.(string))
 	}
 	if labels, ok := d.GetOk("labels"); ok {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(labels.(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(labels.(*schema.Set)))
 		options.Labels = &gitlabLabels
 	}
 	if mergeRequestToResolveDiscussionsOf, ok := d.GetOk("merge_request_to_resolve_discussions_of"); ok {
@@ -127,7 +127,7 @@ func resourceGitlabProjectIssueCreate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectIssueRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, issueIID, err := resourceGitLabProjectIssueParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -151,7 +151,7 @@ func resourceGitlabProjectIssueRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectIssueUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, issueIID, err := resourceGitLabProjectIssueParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -187,7 +187,7 @@ func resourceGitlabProjectIssueUpdate(ctx context.Context, d *schema.ResourceDat
 		options.IssueType = gitlab.String(d.Get("issue_type").(string))
 	}
 	if d.HasChange("labels") {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(d.Get("labels").(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(d.Get("labels").(*schema.Set)))
 		options.Labels = &gitlabLabels
 	}
 	if d.HasChange("milestone_id") {
@@ -212,7 +212,7 @@ func resourceGitlabProjectIssueUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceGitlabProjectIssueDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, issueIID, err := resourceGitLabProjectIssueParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -222,15 +222,15 @@ func resourceGitlabProjectIssueDelete(ctx context.Context, d *schema.ResourceDat
 
 	if deleteOnDestroy {
 		log.Printf("[DEBUG] Deleting issue %d in project %s for destroy", issueIID, project)
-		resp, err := client.Issues.DeleteIssue(project, issueIID, gitlab.WithContext(ctx))
-		if err != nil {
-			return diag.Errorf("%s failed to delete issue %d in project %s: (%s) %v", d.Id(), issueIID, project, resp.Status, err)
+		_, err := client.Issues.DeleteIssue(project, issueIID, gitlab.WithContext(ctx))
+		if err != nil && !is404(err) {
+			return diag.Errorf("%s failed to delete issue %d in project %s: %v", d.Id(), issueIID, project, err)
 		}
 	} else {
 		log.Printf("[DEBUG] Closing issue %d in project %s for destroy", issueIID, project)
-		_, resp, err := client.Issues.UpdateIssue(project, issueIID, &gitlab.UpdateIssueOptions{StateEvent: gitlab.String("close")}, gitlab.WithContext(ctx))
-		if err != nil {
-			return diag.Errorf("%s failed to delete issue %d in project %s: (%s) %v", d.Id(), issueIID, project, resp.Status, err)
+		_, _, err := client.Issues.UpdateIssue(project, issueIID, &gitlab.UpdateIssueOptions{StateEvent: gitlab.String("close")}, gitlab.WithContext(ctx))
+		if err != nil && !is404(err) {
+			return diag.Errorf("%s failed to close issue %d in project %s: %v", d.Id(), issueIID, project, err)
 		}
 	}
 
@@ -32,7 +32,7 @@ var _ = registerResource("gitlab_project_issue_board", func() *schema.Resource {
 })
 
 func resourceGitlabProjectIssueBoardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	options := gitlab.CreateIssueBoardOptions{
@@ -55,7 +55,7 @@ func resourceGitlabProjectIssueBoardCreate(ctx context.Context, d *schema.Resour
 		updateOptions.AssigneeID = gitlab.Int(v.(int))
 	}
 	if v, ok := d.GetOk("labels"); ok {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(v.(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(v.(*schema.Set)))
 		updateOptions.Labels = &gitlabLabels
 	}
 	if v, ok := d.GetOk("weight"); ok {
@@ -80,7 +80,7 @@ func resourceGitlabProjectIssueBoardCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectIssueBoardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, issueBoardID, err := resourceGitlabProjectIssueBoardParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -105,7 +105,7 @@ func resourceGitlabProjectIssueBoardRead(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabProjectIssueBoardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, issueBoardID, err := resourceGitlabProjectIssueBoardParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -122,7 +122,7 @@ func resourceGitlabProjectIssueBoardUpdate(ctx context.Context, d *schema.Resour
 		options.AssigneeID = gitlab.Int(d.Get("assignee_id").(int))
 	}
 	if d.HasChange("labels") {
-		gitlabLabels := gitlab.Labels(*stringSetToStringSlice(d.Get("labels").(*schema.Set)))
+		gitlabLabels := gitlab.LabelOptions(*stringSetToStringSlice(d.Get("labels").(*schema.Set)))
 		options.Labels = &gitlabLabels
 	}
 	if d.HasChange("weight") {
@@ -156,14 +156,14 @@ func resourceGitlabProjectIssueBoardUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectIssueBoardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, issueBoardID, err := resourceGitlabProjectIssueBoardParseID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] delete Project Issue Board in project %q with id %q", project, issueBoardID)
-	if _, err := client.Boards.DeleteIssueBoard(project, issueBoardID, gitlab.WithContext(ctx)); err != nil {
+	if _, err := client.Boards.DeleteIssueBoard(project, issueBoardID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -169,6 +169,11 @@ func TestAccGitlabProjectIssueBoard_Lists(t *testing.T) {
 						}
 					}
 				`, testProject.ID, testLabels[0].ID, testLabels[1].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.0.label_id", fmt.Sprintf("%d", testLabels[0].ID)),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.1.label_id", fmt.Sprintf("%d", testLabels[1].ID)),
+				),
 			},
 			// Verify import
 			{
@@ -199,6 +204,34 @@ func TestAccGitlabProjectIssueBoard_Lists(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Reorder Board lists
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_issue_board" "this" {
+						project      = "%d"
+						name         = "Test Board"
+
+						lists {
+							label_id = %d
+						}
+
+						lists {
+							label_id = %d
+						}
+					}
+				`, testProject.ID, testLabels[3].ID, testLabels[2].ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.0.label_id", fmt.Sprintf("%d", testLabels[3].ID)),
+					resource.TestCheckResourceAttr("gitlab_project_issue_board.this", "lists.1.label_id", fmt.Sprintf("%d", testLabels[2].ID)),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_project_issue_board.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Force a destroy for the board so that it can be recreated as the same resource
 			{
 				SkipFunc: isRunningInCE,
@@ -6,6 +6,8 @@ package provider
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -189,6 +191,71 @@ func TestAccGitlabProjectIssue_deleteOnDestroy(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectIssue_labels(t *testing.T) {
+	var issue gitlab.Issue
+	testProject := testAccCreateProject(t)
+
+	labelsConfig := func(labels string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_project_issue" "this" {
+				project = "%d"
+				title   = "Terraform test issue"
+				labels  = %s
+			}
+		`, testProject.ID, labels)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectIssueDestroy,
+		Steps: []resource.TestStep{
+			// Create an issue with labels
+			{
+				Config: labelsConfig(`["foo", "bar"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectIssueExists("gitlab_project_issue.this", &issue),
+					testAccCheckGitlabProjectIssueLabels(&issue, []string{"bar", "foo"}),
+				),
+			},
+			// Replace a label
+			{
+				Config: labelsConfig(`["foo", "baz"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectIssueExists("gitlab_project_issue.this", &issue),
+					testAccCheckGitlabProjectIssueLabels(&issue, []string{"baz", "foo"}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_project_issue.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_on_destroy"},
+			},
+			// Remove all labels
+			{
+				Config: labelsConfig(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_issue.this", "labels.#", "0"),
+					testAccCheckGitlabProjectIssueExists("gitlab_project_issue.this", &issue),
+					testAccCheckGitlabProjectIssueLabels(&issue, []string{}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectIssueLabels(issue *gitlab.Issue, want []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		got := append([]string{}, issue.Labels...)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("got labels %v; want %v", got, want)
+		}
+		return nil
+	}
+}
+
 func testAccCheckGitlabProjectIssueExists(n string, issue *gitlab.Issue) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -226,7 +293,7 @@ func testAccCheckGitlabProjectIssueDestroy(s *terraform.State) error {
 
 		issue, _, err := testGitlabClient.Issues.GetIssue(project, issueIID)
 		if err == nil && issue != nil && issue.IID == issueIID {
-			if val, ok := rs.Primary.Attributes["delete_on_destory"]; ok && val == "true" {
+			if val, ok := rs.Primary.Attributes["delete_on_destroy"]; ok && val == "true" {
 				return fmt.Errorf("Issue still exists")
 			} else {
 				if issue.State != "closed" {
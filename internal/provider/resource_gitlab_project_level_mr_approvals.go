@@ -2,20 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
 var _ = registerResource("gitlab_project_level_mr_approvals", func() *schema.Resource {
 	return &schema.Resource{
-		Description: `The ` + "`" + `gitlab_project_level_mr_approval_rule` + "`" + ` resource allows to manage the lifecycle of a Merge Request-level approval rule.
+		Description: `The ` + "`" + `gitlab_project_level_mr_approvals` + "`" + ` resource allows to manage the merge request approval settings of a project.
+
+-> A project has exactly one approval configuration. Creating this resource changes the existing configuration and destroying it resets the configuration to the GitLab defaults.
 
 -> This resource requires a GitLab Enterprise instance.
 
+~> **NOTE:** GitLab 12.3 replaced ` + "`approvals_before_merge`" + ` with approval rules, e.g. managed with ` + "`gitlab_project_approval_rule`" + `. To not fight with them, ` + "`approvals_before_merge`" + ` is neither applied nor read while the project has approval rules.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/merge_request_approvals.html#merge-request-level-mr-approvals)`,
 
 		CreateContext: resourceGitlabProjectLevelMRApprovalsCreate,
@@ -25,44 +31,55 @@ var _ = registerResource("gitlab_project_level_mr_approvals", func() *schema.Res
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: map[string]*schema.Schema{
-			"project_id": {
-				Description: "The ID of the project to change MR approval configuration.",
-				Type:        schema.TypeInt,
-				ForceNew:    true,
-				Required:    true,
-			},
-			"reset_approvals_on_push": {
-				Description: "Set to `true` if you want to remove all approvals in a merge request when new commits are pushed to its source branch. Default is `true`.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"disable_overriding_approvers_per_merge_request": {
-				Description: "By default, users are able to edit the approval rules in merge requests. If set to true,",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"merge_requests_author_approval": {
-				Description: "Set to `true` if you want to allow merge request authors to self-approve merge requests. Authors",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"merge_requests_disable_committers_approval": {
-				Description: "Set to `true` if you want to prevent approval of merge requests by merge request committers.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-			"require_password_to_approve": {
-				Description: "Set to `true` if you want to require authentication when approving a merge request.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-			},
-		},
+		Schema: gitlabProjectLevelMRApprovalsSchema(),
 	}
 })
 
+func gitlabProjectLevelMRApprovalsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"project_id": {
+			Description: "The ID of the project to change MR approval configuration.",
+			Type:        schema.TypeInt,
+			ForceNew:    true,
+			Required:    true,
+		},
+		"reset_approvals_on_push": {
+			Description: "Set to `true` if you want to remove all approvals in a merge request when new commits are pushed to its source branch. Default is `true`.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"disable_overriding_approvers_per_merge_request": {
+			Description: "By default, users are able to edit the approval rules in merge requests. If set to true,",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"merge_requests_author_approval": {
+			Description: "Set to `true` if you want to allow merge request authors to self-approve merge requests. Authors",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"merge_requests_disable_committers_approval": {
+			Description: "Set to `true` if you want to prevent approval of merge requests by merge request committers.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"require_password_to_approve": {
+			Description: "Set to `true` if you want to require authentication when approving a merge request.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"approvals_before_merge": {
+			Description:  "How many approvals are required before a merge request can be merged. This is the legacy setting of GitLab versions without approval rules. On GitLab 12.3 and later it is only applied while the project has no approval rules, otherwise the approval rules take precedence and this value is left untouched. It is only read from GitLab while it is configured.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
 func resourceGitlabProjectLevelMRApprovalsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	projectId := d.Get("project_id").(int)
 
@@ -74,6 +91,21 @@ func resourceGitlabProjectLevelMRApprovalsCreate(ctx context.Context, d *schema.
 		RequirePasswordToApprove:                  gitlab.Bool(d.Get("require_password_to_approve").(bool)),
 	}
 
+	var diags diag.Diagnostics
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("approvals_before_merge"); ok {
+		usesRules, err := gitlabProjectUsesApprovalRules(ctx, meta.(*ProviderMeta), projectId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if usesRules {
+			diags = append(diags, approvalsBeforeMergeIgnoredWarning(projectId))
+		} else {
+			options.ApprovalsBeforeMerge = gitlab.Int(v.(int))
+		}
+	}
+
 	log.Printf("[DEBUG] Creating new MR approval configuration for project %d:", projectId)
 
 	if _, _, err := client.Projects.ChangeApprovalConfiguration(projectId, options, gitlab.WithContext(ctx)); err != nil {
@@ -81,18 +113,18 @@ func resourceGitlabProjectLevelMRApprovalsCreate(ctx context.Context, d *schema.
 	}
 
 	d.SetId(strconv.Itoa(projectId))
-	return resourceGitlabProjectLevelMRApprovalsRead(ctx, d, meta)
+	return append(diags, resourceGitlabProjectLevelMRApprovalsRead(ctx, d, meta)...)
 }
 
 func resourceGitlabProjectLevelMRApprovalsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	projectId, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.Errorf("project ID must be an integer (was %q): %v", d.Id(), err)
 	}
 
-	log.Printf("[DEBUG] Reading gitlab approval configuration for project %q", projectId)
+	log.Printf("[DEBUG] Reading gitlab approval configuration for project %d", projectId)
 
 	approvalConfig, _, err := client.Projects.GetApprovalConfiguration(projectId, gitlab.WithContext(ctx))
 	if err != nil {
@@ -111,15 +143,33 @@ func resourceGitlabProjectLevelMRApprovalsRead(ctx context.Context, d *schema.Re
 	d.Set("merge_requests_disable_committers_approval", approvalConfig.MergeRequestsDisableCommittersApproval)
 	d.Set("require_password_to_approve", approvalConfig.RequirePasswordToApprove)
 
+	// NOTE: the legacy value is only read while it's managed, to not list the approval rules on every refresh.
+	//       With approval rules in place, it doesn't have any effect and may be changed by GitLab,
+	//       therefore it's kept as configured.
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if _, ok := d.GetOkExists("approvals_before_merge"); ok {
+		usesRules, err := gitlabProjectUsesApprovalRules(ctx, meta.(*ProviderMeta), projectId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !usesRules {
+			d.Set("approvals_before_merge", approvalConfig.ApprovalsBeforeMerge)
+		}
+	}
+
 	return nil
 }
 
 func resourceGitlabProjectLevelMRApprovalsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	options := &gitlab.ChangeApprovalConfigurationOptions{}
 
-	projectId := d.Id()
-	log.Printf("[DEBUG] Updating approval configuration for project %s:", projectId)
+	projectId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("project ID must be an integer (was %q): %v", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Updating approval configuration for project %d:", projectId)
 
 	if d.HasChange("reset_approvals_on_push") {
 		options.ResetApprovalsOnPush = gitlab.Bool(d.Get("reset_approvals_on_push").(bool))
@@ -137,15 +187,28 @@ func resourceGitlabProjectLevelMRApprovalsUpdate(ctx context.Context, d *schema.
 		options.RequirePasswordToApprove = gitlab.Bool(d.Get("require_password_to_approve").(bool))
 	}
 
-	if _, _, err := client.Projects.ChangeApprovalConfiguration(d.Id(), options, gitlab.WithContext(ctx)); err != nil {
+	var diags diag.Diagnostics
+	if d.HasChange("approvals_before_merge") {
+		usesRules, err := gitlabProjectUsesApprovalRules(ctx, meta.(*ProviderMeta), projectId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if usesRules {
+			diags = append(diags, approvalsBeforeMergeIgnoredWarning(projectId))
+		} else {
+			options.ApprovalsBeforeMerge = gitlab.Int(d.Get("approvals_before_merge").(int))
+		}
+	}
+
+	if _, _, err := client.Projects.ChangeApprovalConfiguration(projectId, options, gitlab.WithContext(ctx)); err != nil {
 		return diag.Errorf("couldn't update approval configuration: %v", err)
 	}
 
-	return resourceGitlabProjectLevelMRApprovalsRead(ctx, d, meta)
+	return append(diags, resourceGitlabProjectLevelMRApprovalsRead(ctx, d, meta)...)
 }
 
 func resourceGitlabProjectLevelMRApprovalsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectId := d.Id()
 
 	options := &gitlab.ChangeApprovalConfigurationOptions{
@@ -164,3 +227,30 @@ func resourceGitlabProjectLevelMRApprovalsDelete(ctx context.Context, d *schema.
 
 	return nil
 }
+
+// gitlabProjectUsesApprovalRules reports whether the required approvals of the project
+// are governed by approval rules instead of the legacy `approvals_before_merge` setting.
+// GitLab versions before 12.3 don't support approval rules at all.
+func gitlabProjectUsesApprovalRules(ctx context.Context, meta *ProviderMeta, projectId int) (bool, error) {
+	supportsRules, err := meta.supportsFeature(ctx, "12.3")
+	if err != nil {
+		return false, err
+	}
+	if !supportsRules {
+		return false, nil
+	}
+
+	rules, _, err := meta.Client.Projects.GetProjectApprovalRules(projectId, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("couldn't read approval rules of project %d: %w", projectId, err)
+	}
+	return len(rules) > 0, nil
+}
+
+func approvalsBeforeMergeIgnoredWarning(projectId int) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "`approvals_before_merge` is not applied",
+		Detail:   fmt.Sprintf("Project %d has approval rules, which take precedence over `approvals_before_merge`. Configure the required approvals with the approval rules instead.", projectId),
+	}
+}
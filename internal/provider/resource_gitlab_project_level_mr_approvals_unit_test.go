@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGitlab_resourceGitlabProjectLevelMRApprovalsCreate_approvalsBeforeMerge(t *testing.T) {
+	cases := []struct {
+		Name                           string
+		Version                        string
+		ApprovalRules                  string
+		ExpectRulesRequest             bool
+		ExpectApprovalsBeforeMergeSent bool
+		ExpectWarning                  bool
+	}{
+		{
+			Name:                           "legacy GitLab without approval rules",
+			Version:                        "12.2.0-ee",
+			ExpectRulesRequest:             false,
+			ExpectApprovalsBeforeMergeSent: true,
+			ExpectWarning:                  false,
+		},
+		{
+			Name:                           "project without approval rules",
+			Version:                        "15.4.0-ee",
+			ApprovalRules:                  `[]`,
+			ExpectRulesRequest:             true,
+			ExpectApprovalsBeforeMergeSent: true,
+			ExpectWarning:                  false,
+		},
+		{
+			Name:                           "project with approval rules",
+			Version:                        "15.4.0-ee",
+			ApprovalRules:                  `[{"id": 1, "name": "Any approver", "rule_type": "any_approver", "approvals_required": 1}]`,
+			ExpectRulesRequest:             true,
+			ExpectApprovalsBeforeMergeSent: false,
+			ExpectWarning:                  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// The approvals configured in GitLab, which are replaced by the ones sent by the provider.
+			approvalsBeforeMerge := 1
+			approvalsBeforeMergeSent := false
+			rulesRequests := 0
+			var decodeErr error
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/version", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"version": %q, "revision": "abcdef"}`, tc.Version)
+			})
+			mux.HandleFunc("/api/v4/projects/42/approvals", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					var body map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						decodeErr = err
+					}
+					if v, ok := body["approvals_before_merge"]; ok {
+						approvalsBeforeMergeSent = true
+						approvalsBeforeMerge = int(v.(float64))
+					}
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"approvals_before_merge": %d, "reset_approvals_on_push": true}`, approvalsBeforeMerge)
+			})
+			mux.HandleFunc("/api/v4/projects/42/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+				rulesRequests++
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tc.ApprovalRules))
+			})
+			client := newTestGitlabClient(t, mux)
+
+			d := schema.TestResourceDataRaw(t, gitlabProjectLevelMRApprovalsSchema(), map[string]interface{}{
+				"project_id":              42,
+				"reset_approvals_on_push": true,
+				"approvals_before_merge":  2,
+			})
+
+			diags := resourceGitlabProjectLevelMRApprovalsCreate(context.Background(), d, &ProviderMeta{Client: client})
+			if decodeErr != nil {
+				t.Fatalf("failed to decode request body: %v", decodeErr)
+			}
+			if diags.HasError() {
+				t.Fatalf("expected no errors, got %v", diags)
+			}
+
+			hasWarning := false
+			for _, diagnostic := range diags {
+				if diagnostic.Severity == diag.Warning {
+					hasWarning = true
+				}
+			}
+			if hasWarning != tc.ExpectWarning {
+				t.Fatalf("got warning %t, expected %t: %v", hasWarning, tc.ExpectWarning, diags)
+			}
+
+			if (rulesRequests > 0) != tc.ExpectRulesRequest {
+				t.Fatalf("got %d approval rules requests, expected any: %t", rulesRequests, tc.ExpectRulesRequest)
+			}
+
+			if approvalsBeforeMergeSent != tc.ExpectApprovalsBeforeMergeSent {
+				t.Fatalf("got approvals_before_merge sent %t, expected %t", approvalsBeforeMergeSent, tc.ExpectApprovalsBeforeMergeSent)
+			}
+
+			// The configured value is kept in state on both code paths, either because GitLab
+			// applied it or because it's left untouched while approval rules are in place.
+			if got := d.Get("approvals_before_merge").(int); got != 2 {
+				t.Fatalf("got approvals_before_merge %d in state, expected 2", got)
+			}
+		})
+	}
+}
+
+func TestGitlab_resourceGitlabProjectLevelMRApprovalsRead_approvalsBeforeMergeNotManaged(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/42/approvals", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"approvals_before_merge": 1, "reset_approvals_on_push": true}`))
+	})
+	// Any other request, e.g. for the version or the approval rules, is unexpected.
+	unexpectedRequests := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		unexpectedRequests++
+		w.WriteHeader(http.StatusNotFound)
+	})
+	client := newTestGitlabClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, gitlabProjectLevelMRApprovalsSchema(), map[string]interface{}{})
+	d.SetId("42")
+
+	if diags := resourceGitlabProjectLevelMRApprovalsRead(context.Background(), d, &ProviderMeta{Client: client}); diags.HasError() {
+		t.Fatalf("expected no errors, got %v", diags)
+	}
+	if unexpectedRequests != 0 {
+		t.Fatalf("got %d unexpected requests, expected none", unexpectedRequests)
+	}
+	if !d.Get("reset_approvals_on_push").(bool) {
+		t.Fatal("expected reset_approvals_on_push to be read")
+	}
+}
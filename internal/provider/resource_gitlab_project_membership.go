@@ -49,21 +49,23 @@ var _ = registerResource("gitlab_project_membership", func() *schema.Resource {
 				Required:         true,
 			},
 			"expires_at": {
-				Description:  "Expiration date for the project membership. Format: `YYYY-MM-DD`",
-				Type:         schema.TypeString,
-				ValidateFunc: validateDateFunc,
-				Optional:     true,
+				Description:      "Expiration date for the project membership. Format: `YYYY-MM-DD`. RFC3339 timestamps are accepted and normalized to their date.",
+				Type:             schema.TypeString,
+				ValidateFunc:     validateMembershipExpiresAtFunc,
+				DiffSuppressFunc: suppressEquivalentMembershipExpiresAt,
+				Optional:         true,
 			},
 		},
 	}
 })
 
 func resourceGitlabProjectMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
-	expiresAt := d.Get("expires_at").(string)
+	// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+	expiresAt, _ := normalizeMembershipExpiresAt(d.Get("expires_at").(string))
 	accessLevelId := accessLevelNameToValue[d.Get("access_level").(string)]
 
 	options := &gitlab.AddProjectMemberOptions{
@@ -83,7 +85,7 @@ func resourceGitlabProjectMembershipCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab project projectMember %s", id)
 
@@ -119,11 +121,12 @@ func projectIdAndUserIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userId := d.Get("user_id").(int)
 	projectId := d.Get("project_id").(string)
-	expiresAt := d.Get("expires_at").(string)
+	// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+	expiresAt, _ := normalizeMembershipExpiresAt(d.Get("expires_at").(string))
 	accessLevelId := accessLevelNameToValue[strings.ToLower(d.Get("access_level").(string))]
 
 	options := gitlab.EditProjectMemberOptions{
@@ -140,7 +143,7 @@ func resourceGitlabProjectMembershipUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id := d.Id()
 	projectId, userId, err := projectIdAndUserIdFromId(id)
@@ -34,7 +34,7 @@ var _ = registerResource("gitlab_project_milestone", func() *schema.Resource {
 })
 
 func resourceGitlabProjectMilestoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	title := d.Get("title").(string)
 
@@ -82,7 +82,7 @@ func resourceGitlabProjectMilestoneCreate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabProjectMilestoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, milestoneID, err := resourceGitLabProjectMilestoneParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -108,7 +108,7 @@ func resourceGitlabProjectMilestoneRead(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabProjectMilestoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, milestoneID, err := resourceGitLabProjectMilestoneParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -152,7 +152,7 @@ func resourceGitlabProjectMilestoneUpdate(ctx context.Context, d *schema.Resourc
 }
 
 func resourceGitlabProjectMilestoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, milestoneID, err := resourceGitLabProjectMilestoneParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
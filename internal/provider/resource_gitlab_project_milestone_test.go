@@ -56,6 +56,7 @@ func TestAccGitlabProjectMilestone_basic(t *testing.T) {
 					state       = "closed"
 				}`, project.ID, rInt2),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_milestone.this", "state", "closed"),
 					resource.TestCheckResourceAttrSet("gitlab_project_milestone.this", "iid"),
 					resource.TestCheckResourceAttrSet("gitlab_project_milestone.this", "milestone_id"),
 					resource.TestCheckResourceAttrSet("gitlab_project_milestone.this", "updated_at"),
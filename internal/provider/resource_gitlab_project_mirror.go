@@ -5,7 +5,6 @@ import (
 	"log"
 	"net/url"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,23 +50,8 @@ import_url, mirror, and mirror_trigger_builds properties on the gitlab_project r
 				ForceNew:    true,
 				Required:    true,
 				Sensitive:   true, // Username and password must be provided in the URL for https.
-				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					oldURL, err := url.Parse(old)
-					if err != nil {
-						return old == new
-					}
-					newURL, err := url.Parse(new)
-					if err != nil {
-						return old == new
-					}
-					if oldURL.User != nil {
-						oldURL.User = url.UserPassword("redacted", "redacted")
-					}
-					if newURL.User != nil {
-						newURL.User = url.UserPassword("redacted", "redacted")
-					}
-					return oldURL.String() == newURL.String()
-				},
+				// GitLab masks the credentials in the URL when reading the mirror.
+				DiffSuppressFunc: suppressEquivalentMirrorURL,
 			},
 			"enabled": {
 				Description: "Determines if the mirror is enabled.",
@@ -87,12 +71,17 @@ import_url, mirror, and mirror_trigger_builds properties on the gitlab_project r
 				Optional:    true,
 				Default:     true,
 			},
+			"mirror_branch_regex": {
+				Description: "A regular expression matching the names of the branches to mirror. Requires `only_protected_branches` to be `false`. Requires a GitLab Premium license.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 		},
 	}
 })
 
 func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	projectID := d.Get("project").(string)
 	URL := d.Get("url").(string)
@@ -106,6 +95,9 @@ func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceDa
 		OnlyProtectedBranches: &onlyProtectedBranches,
 		KeepDivergentRefs:     &keepDivergentRefs,
 	}
+	if v, ok := d.GetOk("mirror_branch_regex"); ok {
+		options.MirrorBranchRegex = gitlab.String(v.(string))
+	}
 
 	log.Printf("[DEBUG] create gitlab project mirror for project %v", projectID)
 
@@ -121,7 +113,7 @@ func resourceGitlabProjectMirrorCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectMirrorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	mirrorID := d.Get("mirror_id").(int)
 	projectID := d.Get("project").(string)
@@ -134,6 +126,9 @@ func resourceGitlabProjectMirrorUpdate(ctx context.Context, d *schema.ResourceDa
 		OnlyProtectedBranches: &onlyProtectedBranches,
 		KeepDivergentRefs:     &keepDivergentRefs,
 	}
+	if d.HasChange("mirror_branch_regex") {
+		options.MirrorBranchRegex = gitlab.String(d.Get("mirror_branch_regex").(string))
+	}
 	log.Printf("[DEBUG] update gitlab project mirror %v for %s", mirrorID, projectID)
 
 	_, _, err := client.ProjectMirrors.EditProjectMirror(projectID, mirrorID, &options, gitlab.WithContext(ctx))
@@ -144,12 +139,12 @@ func resourceGitlabProjectMirrorUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectMirrorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	mirrorID := d.Get("mirror_id").(int)
 	projectID := d.Get("project").(string)
 
-	isDeleteSupported, err := isGitLabVersionAtLeast(ctx, client, "14.10")()
+	isDeleteSupported, err := meta.(*ProviderMeta).supportsFeature(ctx, "14.10")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -176,17 +171,16 @@ func resourceGitlabProjectMirrorDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectMirrorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-
-	ids := strings.Split(d.Id(), ":")
-	projectID := ids[0]
-	rawMirrorID := ids[1]
+	projectID, rawMirrorID, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	mirrorID, err := strconv.Atoi(rawMirrorID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] read gitlab project mirror %s id %v", projectID, mirrorID)
-	mirror, err := resourceGitLabProjectMirrorGetMirror(ctx, client, projectID, mirrorID)
+	mirror, err := resourceGitLabProjectMirrorGetMirror(ctx, meta.(*ProviderMeta), projectID, mirrorID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -201,17 +195,38 @@ func resourceGitlabProjectMirrorRead(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// suppressEquivalentMirrorURL suppresses diffs between mirror URLs which only differ in their credentials.
+func suppressEquivalentMirrorURL(k, old, new string, d *schema.ResourceData) bool {
+	oldURL, err := url.Parse(old)
+	if err != nil {
+		return old == new
+	}
+	newURL, err := url.Parse(new)
+	if err != nil {
+		return old == new
+	}
+	if oldURL.User != nil {
+		oldURL.User = url.UserPassword("redacted", "redacted")
+	}
+	if newURL.User != nil {
+		newURL.User = url.UserPassword("redacted", "redacted")
+	}
+	return oldURL.String() == newURL.String()
+}
+
 func resourceGitlabProjectMirrorSetToState(d *schema.ResourceData, projectMirror *gitlab.ProjectMirror, projectID *string) {
 	d.Set("enabled", projectMirror.Enabled)
 	d.Set("mirror_id", projectMirror.ID)
 	d.Set("keep_divergent_refs", projectMirror.KeepDivergentRefs)
 	d.Set("only_protected_branches", projectMirror.OnlyProtectedBranches)
+	d.Set("mirror_branch_regex", projectMirror.MirrorBranchRegex)
 	d.Set("project", projectID)
 	d.Set("url", projectMirror.URL)
 }
 
-func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Client, projectID string, mirrorID int) (*gitlab.ProjectMirror, error) {
-	isGetProjectMirrorSupported, err := isGitLabVersionAtLeast(ctx, client, "14.10")()
+func resourceGitLabProjectMirrorGetMirror(ctx context.Context, meta *ProviderMeta, projectID string, mirrorID int) (*gitlab.ProjectMirror, error) {
+	client := meta.Client
+	isGetProjectMirrorSupported, err := meta.supportsFeature(ctx, "14.10")
 	if err != nil {
 		return nil, err
 	}
@@ -230,8 +245,7 @@ func resourceGitLabProjectMirrorGetMirror(ctx context.Context, client *gitlab.Cl
 		// NOTE: remove this branch and move logic back to Read() function when GitLab older than 14.10 are not longer supported by this provider
 		found := false
 		options := &gitlab.ListProjectMirrorOptions{
-			Page:    1,
-			PerPage: 20,
+			Page: 1,
 		}
 
 		for options.Page != 0 && !found {
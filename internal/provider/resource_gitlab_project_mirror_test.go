@@ -87,6 +87,57 @@ func TestAccGitlabProjectMirror_withPassword(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectMirror_mirrorBranchRegex(t *testing.T) {
+	testAccCheckEE(t)
+
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectMirrorDestroy,
+		Steps: []resource.TestStep{
+			// Mirror only release branches and keep divergent refs
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_mirror" "this" {
+						project                 = "%d"
+						url                     = "https://example.com/mirror"
+						only_protected_branches = false
+						keep_divergent_refs     = true
+						mirror_branch_regex     = "^release/.*"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_mirror.this", "keep_divergent_refs", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_mirror.this", "mirror_branch_regex", "^release/.*"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_mirror.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the branch regex
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project_mirror" "this" {
+						project                 = "%d"
+						url                     = "https://example.com/mirror"
+						only_protected_branches = false
+						keep_divergent_refs     = true
+					}
+				`, testProject.ID),
+				Check: resource.TestCheckResourceAttr("gitlab_project_mirror.this", "mirror_branch_regex", ""),
+			},
+			{
+				ResourceName:      "gitlab_project_mirror.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectMirrorExists(n string, mirror *gitlab.ProjectMirror) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -38,17 +38,69 @@ var _ = registerResource("gitlab_project_protected_environment", func() *schema.
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"environment": {
-				Description:  "The name of the environment.",
+				Description:  "The name of the environment. The environment must already exist in the project.",
 				Type:         schema.TypeString,
 				ForceNew:     true,
 				Required:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			"required_approval_count": {
-				Description: "The number of approvals required to deploy to this environment.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-				ForceNew:    true,
+				Description:   "The number of approvals required to deploy to this environment. Use `approval_rules` to require approvals from specific users, groups or access levels instead.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"approval_rules"},
+			},
+			"approval_rules": {
+				Description:   "Array of approval rules to deploy, with each described by a hash. Each rule requires a number of approvals from the given user, group or access level.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"required_approval_count"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The unique ID of the approval rule object.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"access_level": {
+							Description:  fmt.Sprintf("Levels of access allowed to approve a deployment to this protected environment. Valid values are %s.", renderValueListForDocs(validProtectedEnvironmentDeploymentLevelNames)),
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Optional:     true,
+							Computed:     true, // When user_id or group_id is specified, the GitLab API still returns an access_level in the response.
+							ValidateFunc: validation.StringInSlice(validProtectedEnvironmentDeploymentLevelNames, false),
+						},
+						"access_level_description": {
+							Description: "Readable description of level of access.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"required_approvals": {
+							Description:  "The number of approvals required from the user, group or access level of this rule. Defaults to `1`.",
+							Type:         schema.TypeInt,
+							ForceNew:     true,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"user_id": {
+							Description:  "The ID of the user allowed to approve a deployment to this protected environment. The user must be a member of the project.",
+							Type:         schema.TypeInt,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"group_id": {
+							Description:  "The ID of the group allowed to approve a deployment to this protected environment. The project must be shared with the group.",
+							Type:         schema.TypeInt,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
 			},
 			"deploy_access_levels": {
 				Description: "Array of access levels allowed to deploy, with each described by a hash.",
@@ -106,11 +158,28 @@ func resourceGitlabProjectProtectedEnvironmentCreate(ctx context.Context, d *sch
 		options.RequiredApprovalCount = gitlab.Int(v.(int))
 	}
 
+	if v, ok := d.GetOk("approval_rules"); ok {
+		approvalRules, err := expandEnvironmentApprovalRules(v.([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		options.ApprovalRules = &approvalRules
+	}
+
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] Project %s create gitlab protected environment %q", project, *options.Name)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
+
+	// Check for the environment up front, because protecting an environment which does not exist yet leads to confusing errors.
+	exists, err := projectEnvironmentExists(ctx, client, project, *options.Name)
+	if err != nil {
+		return diag.Errorf("error checking if environment %q exists in project %q: %v", *options.Name, project, err)
+	}
+	if !exists {
+		return diag.Errorf("environment %q does not exist in project %q. Create the environment first, e.g. with the `gitlab_project_environment` resource", *options.Name, project)
+	}
 
 	protectedEnvironment, _, err := client.ProtectedEnvironments.ProtectRepositoryEnvironments(project, options, gitlab.WithContext(ctx))
 	if err != nil {
@@ -136,7 +205,7 @@ func resourceGitlabProjectProtectedEnvironmentRead(ctx context.Context, d *schem
 
 	log.Printf("[DEBUG] Project %s read gitlab protected environment %q", project, environment)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	protectedEnvironment, _, err := client.ProtectedEnvironments.GetProtectedEnvironment(project, environment, gitlab.WithContext(ctx))
 	if err != nil {
@@ -153,6 +222,10 @@ func resourceGitlabProjectProtectedEnvironmentRead(ctx context.Context, d *schem
 		return diag.Errorf("error setting deploy_access_levels: %v", err)
 	}
 
+	if err := d.Set("approval_rules", flattenEnvironmentApprovalRules(protectedEnvironment.ApprovalRules)); err != nil {
+		return diag.Errorf("error setting approval_rules: %v", err)
+	}
+
 	return nil
 }
 
@@ -164,7 +237,7 @@ func resourceGitlabProjectProtectedEnvironmentDelete(ctx context.Context, d *sch
 
 	log.Printf("[DEBUG] Project %s delete gitlab project-level protected environment %s", project, environmentName)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	_, err = client.ProtectedEnvironments.UnprotectEnvironment(project, environmentName, gitlab.WithContext(ctx))
 	if err != nil {
@@ -174,6 +247,21 @@ func resourceGitlabProjectProtectedEnvironmentDelete(ctx context.Context, d *sch
 	return nil
 }
 
+// projectEnvironmentExists returns true if the project has an environment with exactly the given name.
+func projectEnvironmentExists(ctx context.Context, client *gitlab.Client, project string, name string) (bool, error) {
+	environments, _, err := client.Environments.ListEnvironments(project, &gitlab.ListEnvironmentsOptions{Name: gitlab.String(name)}, gitlab.WithContext(ctx))
+	if err != nil {
+		return false, err
+	}
+
+	for _, environment := range environments {
+		if environment.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func expandDeployAccessLevels(vs []interface{}) ([]*gitlab.EnvironmentAccessOptions, error) {
 	result := make([]*gitlab.EnvironmentAccessOptions, len(vs))
 
@@ -230,3 +318,64 @@ func flattenDeployAccessLevels(accessDescriptions []*gitlab.EnvironmentAccessDes
 
 	return result
 }
+
+func expandEnvironmentApprovalRules(vs []interface{}) ([]*gitlab.EnvironmentApprovalRuleOptions, error) {
+	result := make([]*gitlab.EnvironmentApprovalRuleOptions, len(vs))
+
+	for i, v := range vs {
+		opts := v.(map[string]interface{})
+		option := &gitlab.EnvironmentApprovalRuleOptions{}
+		count := 0
+
+		if accessLevel, ok := opts["access_level"]; ok && accessLevel != "" {
+			option.AccessLevel = gitlab.AccessLevel(accessLevelNameToValue[accessLevel.(string)])
+			count++
+		}
+
+		if userID, ok := opts["user_id"]; ok && userID != 0 {
+			option.UserID = gitlab.Int(userID.(int))
+			count++
+		}
+
+		if groupID, ok := opts["group_id"]; ok && groupID != 0 {
+			option.GroupID = gitlab.Int(groupID.(int))
+			count++
+		}
+
+		// Same manual "ExactlyOneOf" schema check as for the deploy access levels.
+		if count != 1 {
+			return nil, fmt.Errorf(`illegal approval_rules.%d: exactly one of "access_level", "user_id", or "group_id" must be specified (got %d)`, i, count)
+		}
+
+		if requiredApprovals, ok := opts["required_approvals"]; ok && requiredApprovals != 0 {
+			option.RequiredApprovalCount = gitlab.Int(requiredApprovals.(int))
+		}
+
+		result[i] = option
+	}
+
+	return result, nil
+}
+
+func flattenEnvironmentApprovalRules(approvalRules []*gitlab.EnvironmentApprovalRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(approvalRules))
+
+	for i, approvalRule := range approvalRules {
+		v := make(map[string]interface{})
+		v["id"] = approvalRule.ID
+		v["access_level_description"] = approvalRule.AccessLevelDescription
+		v["required_approvals"] = approvalRule.RequiredApprovalCount
+		if approvalRule.AccessLevel != 0 {
+			v["access_level"] = accessLevelValueToName[approvalRule.AccessLevel]
+		}
+		if approvalRule.UserID != 0 {
+			v["user_id"] = approvalRule.UserID
+		}
+		if approvalRule.GroupID != 0 {
+			v["group_id"] = approvalRule.GroupID
+		}
+		result[i] = v
+	}
+
+	return result
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -98,6 +99,83 @@ func TestAccGitlabProjectProtectedEnvironment_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectProtectedEnvironment_approvalRules(t *testing.T) {
+	testAccCheckEE(t)
+	testAccRequiresAtLeast(t, "14.10")
+
+	// Set up project environment.
+	project := testAccCreateProject(t)
+	environment := testAccCreateProjectEnvironment(t, project.ID, &gitlab.CreateEnvironmentOptions{
+		Name: gitlab.String(acctest.RandomWithPrefix("test-protected-environment")),
+	})
+
+	// Set up group access.
+	group := testAccCreateGroups(t, 1)[0]
+	if _, err := testGitlabClient.Projects.ShareProjectWithGroup(project.ID, &gitlab.ShareWithGroupOptions{
+		GroupID:     &group.ID,
+		GroupAccess: gitlab.AccessLevel(gitlab.MaintainerPermissions),
+	}); err != nil {
+		t.Fatalf("unable to share project %d with group %d", project.ID, group.ID)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectProtectedEnvironmentDestroy(project.ID, environment.Name),
+		Steps: []resource.TestStep{
+			// Create a protected environment with two approval rules.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project     = %d
+					environment = %q
+					deploy_access_levels {
+						access_level = "developer"
+					}
+					approval_rules {
+						group_id           = %d
+						required_approvals = 2
+					}
+					approval_rules {
+						access_level = "maintainer"
+					}
+				}`, project.ID, environment.Name, group.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.#", "2"),
+					resource.TestCheckResourceAttrSet("gitlab_project_protected_environment.this", "approval_rules.0.id"),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.0.group_id", fmt.Sprintf("%d", group.ID)),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.0.required_approvals", "2"),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.1.access_level", "maintainer"),
+					resource.TestCheckResourceAttr("gitlab_project_protected_environment.this", "approval_rules.1.required_approvals", "1"),
+					resource.TestCheckResourceAttrSet("gitlab_project_protected_environment.this", "approval_rules.1.access_level_description"),
+				),
+			},
+			// Verify upstream attributes with an import.
+			{
+				ResourceName:      "gitlab_project_protected_environment.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Verify that approval rules and required_approval_count can't be combined.
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project                 = %d
+					environment             = %q
+					required_approval_count = 1
+					deploy_access_levels {
+						access_level = "developer"
+					}
+					approval_rules {
+						access_level = "maintainer"
+					}
+				}`, project.ID, environment.Name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"required_approval_count": conflicts with approval_rules`),
+			},
+		},
+	})
+}
+
 func TestAccGitlabProjectProtectedEnvironment_regressionIssue1132(t *testing.T) {
 	testAccCheckEE(t)
 
@@ -151,6 +229,30 @@ func TestAccGitlabProjectProtectedEnvironment_regressionIssue1132(t *testing.T)
 	})
 }
 
+func TestAccGitlabProjectProtectedEnvironment_environmentDoesNotExist(t *testing.T) {
+	testAccCheckEE(t)
+
+	project := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectProtectedEnvironmentDestroy(project.ID, "does-not-exist"),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_project_protected_environment" "this" {
+					project     = %d
+					environment = "does-not-exist"
+					deploy_access_levels {
+						access_level = "developer"
+					}
+				}`, project.ID),
+				ExpectError: regexp.MustCompile(`environment "does-not-exist" does not exist in project`),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectProtectedEnvironmentDestroy(projectID int, environmentName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, _, err := testGitlabClient.ProtectedEnvironments.GetProtectedEnvironment(projectID, environmentName)
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_project_push_rules", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_project_push_rules`" + ` resource allows to manage the lifecycle of the push rules of a project.
+
+-> This resource requires a GitLab Enterprise instance.
+
+~> A project has exactly one set of push rules. Creating this resource edits already existing push rules of the project instead of failing. Do not use this resource together with the ` + "`push_rules`" + ` block of the ` + "`gitlab_project`" + ` resource for the same project.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/projects.html#push-rules)`,
+
+		CreateContext: resourceGitlabProjectPushRulesCreate,
+		ReadContext:   resourceGitlabProjectPushRulesRead,
+		UpdateContext: resourceGitlabProjectPushRulesUpdate,
+		DeleteContext: resourceGitlabProjectPushRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: gitlabProjectPushRulesGetSchema(),
+	}
+})
+
+func gitlabProjectPushRulesGetSchema() map[string]*schema.Schema {
+	pushRulesSchema := gitlabPushRulesResourceGetSchema()
+	pushRulesSchema["project"] = &schema.Schema{
+		Description: "The ID or full path of the project.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	}
+	return pushRulesSchema
+}
+
+func resourceGitlabProjectPushRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+
+	// NOTE: push rules id `0` indicates that there haven't been any push rules set.
+	pushRules, _, err := client.Projects.GetProjectPushRules(project, gitlab.WithContext(ctx))
+	if err != nil && !is404(err) {
+		return diag.Errorf("failed to get push rules for project %q: %v", project, err)
+	}
+
+	if err != nil || pushRules.ID == 0 {
+		log.Printf("[DEBUG] create gitlab project push rules for project %q", project)
+		options := &gitlab.AddProjectPushRuleOptions{
+			AuthorEmailRegex:           gitlab.String(d.Get("author_email_regex").(string)),
+			BranchNameRegex:            gitlab.String(d.Get("branch_name_regex").(string)),
+			CommitCommitterCheck:       gitlab.Bool(d.Get("commit_committer_check").(bool)),
+			CommitCommitterNameCheck:   gitlab.Bool(d.Get("commit_committer_name_check").(bool)),
+			CommitMessageNegativeRegex: gitlab.String(d.Get("commit_message_negative_regex").(string)),
+			CommitMessageRegex:         gitlab.String(d.Get("commit_message_regex").(string)),
+			DenyDeleteTag:              gitlab.Bool(d.Get("deny_delete_tag").(bool)),
+			FileNameRegex:              gitlab.String(d.Get("file_name_regex").(string)),
+			MaxFileSize:                gitlab.Int(d.Get("max_file_size").(int)),
+			MemberCheck:                gitlab.Bool(d.Get("member_check").(bool)),
+			PreventSecrets:             gitlab.Bool(d.Get("prevent_secrets").(bool)),
+			RejectUnsignedCommits:      gitlab.Bool(d.Get("reject_unsigned_commits").(bool)),
+			RejectNonDCOCommits:        gitlab.Bool(d.Get("reject_non_dco_commits").(bool)),
+		}
+		if _, _, err := client.Projects.AddProjectPushRule(project, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to create push rules for project %q: %v", project, err)
+		}
+	} else {
+		// Push rules are a singleton per project, so existing push rules are taken over.
+		log.Printf("[DEBUG] gitlab project push rules already exist for project %q, editing them", project)
+		if err := resourceGitlabProjectPushRulesEdit(ctx, client, project, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(project)
+	return resourceGitlabProjectPushRulesRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPushRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab project push rules for project %q", project)
+
+	pushRules, _, err := client.Projects.GetProjectPushRules(project, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab project push rules for project %q not found, removing from state", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to get push rules for project %q: %v", project, err)
+	}
+
+	if pushRules.ID == 0 {
+		log.Printf("[DEBUG] gitlab project %q has no push rules, removing from state", project)
+		d.SetId("")
+		return nil
+	}
+
+	stateMap := gitlabProjectPushRulesToStateMap(project, pushRules)
+	if err := setStateMapInResourceData(stateMap, d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectPushRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	log.Printf("[DEBUG] update gitlab project push rules for project %q", d.Id())
+
+	if err := resourceGitlabProjectPushRulesEdit(ctx, client, d.Id(), d); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceGitlabProjectPushRulesRead(ctx, d, meta)
+}
+
+func resourceGitlabProjectPushRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	log.Printf("[DEBUG] delete gitlab project push rules for project %q", d.Id())
+
+	if _, err := client.Projects.DeleteProjectPushRule(d.Id(), gitlab.WithContext(ctx)); err != nil {
+		if is404(err) {
+			return nil
+		}
+		return diag.Errorf("failed to delete push rules for project %q: %v", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceGitlabProjectPushRulesEdit(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
+	options := &gitlab.EditProjectPushRuleOptions{
+		AuthorEmailRegex:           gitlab.String(d.Get("author_email_regex").(string)),
+		BranchNameRegex:            gitlab.String(d.Get("branch_name_regex").(string)),
+		CommitCommitterCheck:       gitlab.Bool(d.Get("commit_committer_check").(bool)),
+		CommitCommitterNameCheck:   gitlab.Bool(d.Get("commit_committer_name_check").(bool)),
+		CommitMessageNegativeRegex: gitlab.String(d.Get("commit_message_negative_regex").(string)),
+		CommitMessageRegex:         gitlab.String(d.Get("commit_message_regex").(string)),
+		DenyDeleteTag:              gitlab.Bool(d.Get("deny_delete_tag").(bool)),
+		FileNameRegex:              gitlab.String(d.Get("file_name_regex").(string)),
+		MaxFileSize:                gitlab.Int(d.Get("max_file_size").(int)),
+		MemberCheck:                gitlab.Bool(d.Get("member_check").(bool)),
+		PreventSecrets:             gitlab.Bool(d.Get("prevent_secrets").(bool)),
+		RejectUnsignedCommits:      gitlab.Bool(d.Get("reject_unsigned_commits").(bool)),
+		RejectNonDCOCommits:        gitlab.Bool(d.Get("reject_non_dco_commits").(bool)),
+	}
+
+	_, _, err := client.Projects.EditProjectPushRule(project, options, gitlab.WithContext(ctx))
+	return err
+}
+
+func gitlabProjectPushRulesToStateMap(project string, pushRules *gitlab.ProjectPushRules) map[string]interface{} {
+	stateMap := make(map[string]interface{})
+	stateMap["project"] = project
+	stateMap["author_email_regex"] = pushRules.AuthorEmailRegex
+	stateMap["branch_name_regex"] = pushRules.BranchNameRegex
+	stateMap["commit_committer_check"] = pushRules.CommitCommitterCheck
+	stateMap["commit_committer_name_check"] = pushRules.CommitCommitterNameCheck
+	stateMap["commit_message_negative_regex"] = pushRules.CommitMessageNegativeRegex
+	stateMap["commit_message_regex"] = pushRules.CommitMessageRegex
+	stateMap["deny_delete_tag"] = pushRules.DenyDeleteTag
+	stateMap["file_name_regex"] = pushRules.FileNameRegex
+	stateMap["max_file_size"] = pushRules.MaxFileSize
+	stateMap["member_check"] = pushRules.MemberCheck
+	stateMap["prevent_secrets"] = pushRules.PreventSecrets
+	stateMap["reject_unsigned_commits"] = pushRules.RejectUnsignedCommits
+	stateMap["reject_non_dco_commits"] = pushRules.RejectNonDCOCommits
+	return stateMap
+}
@@ -0,0 +1,112 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestAccGitlabProjectPushRules_basic(t *testing.T) {
+	testAccCheckEE(t)
+
+	project := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectPushRulesDestroy(project.ID),
+		Steps: []resource.TestStep{
+			// Create push rules
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_push_rules" "foo" {
+  project              = %d
+  commit_message_regex = "^(feat|fix): "
+  prevent_secrets      = true
+  max_file_size        = 10
+}
+`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "commit_message_regex", "^(feat|fix): "),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "prevent_secrets", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "max_file_size", "10"),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "member_check", "false"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the commit message regex
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_push_rules" "foo" {
+  project              = %d
+  commit_message_regex = "^(feat|fix|docs): "
+  prevent_secrets      = true
+  max_file_size        = 10
+}
+`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "commit_message_regex", "^(feat|fix|docs): "),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_push_rules.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectPushRules_existingPushRules(t *testing.T) {
+	testAccCheckEE(t)
+
+	project := testAccCreateProject(t)
+	if _, _, err := testGitlabClient.Projects.AddProjectPushRule(project.ID, &gitlab.AddProjectPushRuleOptions{MemberCheck: gitlab.Bool(true)}); err != nil {
+		t.Fatalf("failed to create push rules: %v", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectPushRulesDestroy(project.ID),
+		Steps: []resource.TestStep{
+			// Take over the existing push rules
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_push_rules" "foo" {
+  project         = %d
+  prevent_secrets = true
+}
+`, project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "prevent_secrets", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_push_rules.foo", "member_check", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabProjectPushRulesDestroy(projectID int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		pushRules, _, err := testGitlabClient.Projects.GetProjectPushRules(projectID)
+		if err != nil {
+			if is404(err) {
+				return nil
+			}
+			return err
+		}
+		if pushRules.ID != 0 {
+			return fmt.Errorf("push rules of project %d still exist", projectID)
+		}
+		return nil
+	}
+}
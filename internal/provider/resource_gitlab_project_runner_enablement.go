@@ -40,7 +40,7 @@ var _ = registerResource("gitlab_project_runner_enablement", func() *schema.Reso
 })
 
 func resourceGitlabProjectRunnerEnablementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	projectID := d.Get("project").(string)
 	runnerID := d.Get("runner_id").(int)
 	options := &gitlab.EnableProjectRunnerOptions{
@@ -61,7 +61,7 @@ func resourceGitlabProjectRunnerEnablementCreate(ctx context.Context, d *schema.
 }
 
 func resourceGitlabProjectRunnerEnablementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, runnerID, err := projectAndRunnerFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -77,6 +77,11 @@ func resourceGitlabProjectRunnerEnablementRead(ctx context.Context, d *schema.Re
 
 	runnerdetails, _, err := client.Runners.GetRunnerDetails(runnerID, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			log.Printf("[WARN] removing project runner: %v from state because the runner no longer exists in gitlab", runnerID)
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -119,7 +124,7 @@ func projectAndRunnerFromID(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectRunnerEnablementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	projectID, runnerID, err := projectAndRunnerFromID(d.Id())
 	if err != nil {
@@ -128,8 +133,8 @@ func resourceGitlabProjectRunnerEnablementDelete(ctx context.Context, d *schema.
 
 	log.Printf("[DEBUG] Delete gitlab project runner %s/%v", projectID, runnerID)
 
-	_, err = client.Runners.DisableProjectRunner(projectID, runnerID)
-	if err != nil {
+	_, err = client.Runners.DisableProjectRunner(projectID, runnerID, gitlab.WithContext(ctx))
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
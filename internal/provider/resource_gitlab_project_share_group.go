@@ -55,6 +55,14 @@ var _ = registerResource("gitlab_project_share_group", func() *schema.Resource {
 				Deprecated:       "Use `group_access` instead of the `access_level` attribute.",
 				ExactlyOneOf:     []string{"access_level", "group_access"},
 			},
+			"expires_at": {
+				Description:      "Share expiration date. Format: `YYYY-MM-DD`. RFC3339 timestamps are accepted and normalized to their date. The expiration date is not read back from GitLab.",
+				Type:             schema.TypeString,
+				ValidateFunc:     validateMembershipExpiresAtFunc,
+				DiffSuppressFunc: suppressEquivalentMembershipExpiresAt,
+				ForceNew:         true,
+				Optional:         true,
+			},
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -68,7 +76,7 @@ var _ = registerResource("gitlab_project_share_group", func() *schema.Resource {
 })
 
 func resourceGitlabProjectShareGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	groupId := d.Get("group_id").(int)
 	projectId := d.Get("project_id").(string)
@@ -86,6 +94,11 @@ func resourceGitlabProjectShareGroupCreate(ctx context.Context, d *schema.Resour
 		GroupID:     &groupId,
 		GroupAccess: &groupAccess,
 	}
+	if v, ok := d.GetOk("expires_at"); ok {
+		// NOTE: the value has already been validated by `validateMembershipExpiresAtFunc`.
+		expiresAt, _ := normalizeMembershipExpiresAt(v.(string))
+		options.ExpiresAt = &expiresAt
+	}
 	log.Printf("[DEBUG] create gitlab project membership for %d in %s", options.GroupID, projectId)
 
 	_, err := client.Projects.ShareProjectWithGroup(projectId, options, gitlab.WithContext(ctx))
@@ -98,7 +111,7 @@ func resourceGitlabProjectShareGroupCreate(ctx context.Context, d *schema.Resour
 }
 
 func resourceGitlabProjectShareGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	id := d.Id()
 	log.Printf("[DEBUG] read gitlab project projectMember %s", id)
 
@@ -120,9 +133,12 @@ func resourceGitlabProjectShareGroupRead(ctx context.Context, d *schema.Resource
 	for _, v := range projectInformation.SharedWithGroups {
 		if groupId == v.GroupID {
 			resourceGitlabProjectShareGroupSetToState(d, v, &projectId)
+			return nil
 		}
 	}
 
+	log.Printf("[DEBUG] gitlab project %s is not shared with group %d anymore, removing from state", projectId, groupId)
+	d.SetId("")
 	return nil
 }
 
@@ -141,7 +157,7 @@ func projectIdAndGroupIdFromId(id string) (string, int, error) {
 }
 
 func resourceGitlabProjectShareGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id := d.Id()
 	projectId, groupId, err := projectIdAndGroupIdFromId(id)
@@ -153,6 +169,9 @@ func resourceGitlabProjectShareGroupDelete(ctx context.Context, d *schema.Resour
 
 	_, err = client.Projects.DeleteSharedProjectFromGroup(projectId, groupId, gitlab.WithContext(ctx))
 	if err != nil {
+		if is404(err) {
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -162,6 +181,7 @@ func resourceGitlabProjectShareGroupDelete(ctx context.Context, d *schema.Resour
 func resourceGitlabProjectShareGroupSetToState(d *schema.ResourceData, group struct {
 	GroupID          int    "json:\"group_id\""
 	GroupName        string "json:\"group_name\""
+	GroupFullPath    string "json:\"group_full_path\""
 	GroupAccessLevel int    "json:\"group_access_level\""
 }, projectId *string) {
 
@@ -71,6 +71,52 @@ func TestAccGitlabProjectShareGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectShareGroup_expiresAtAndRemovedOutsideTerraform(t *testing.T) {
+	project := testAccCreateProject(t)
+	group := testAccCreateGroups(t, 1)[0]
+
+	config := func(groupAccess string) string {
+		return fmt.Sprintf(`
+resource "gitlab_project_share_group" "test" {
+  project_id   = "%d"
+  group_id     = %d
+  group_access = "%s"
+  expires_at   = "2099-12-31"
+}
+`, project.ID, group.ID, groupAccess)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectShareGroupDestroy,
+		Steps: []resource.TestStep{
+			// Share the project at reporter level
+			{
+				Config: config("reporter"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectSharedWithGroup(project.PathWithNamespace, group.FullPath, gitlab.ReporterPermissions),
+					resource.TestCheckResourceAttr("gitlab_project_share_group.test", "expires_at", "2099-12-31"),
+				),
+			},
+			// Update the access level to developer
+			{
+				Config: config("developer"),
+				Check:  testAccCheckGitlabProjectSharedWithGroup(project.PathWithNamespace, group.FullPath, gitlab.DeveloperPermissions),
+			},
+			// Share the project again after it has been unshared outside of Terraform
+			{
+				PreConfig: func() {
+					if _, err := testGitlabClient.Projects.DeleteSharedProjectFromGroup(project.ID, group.ID); err != nil {
+						t.Fatalf("failed to unshare project: %v", err)
+					}
+				},
+				Config: config("developer"),
+				Check:  testAccCheckGitlabProjectSharedWithGroup(project.PathWithNamespace, group.FullPath, gitlab.DeveloperPermissions),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectSharedWithGroup(projectName, groupName string, accessLevel gitlab.AccessLevelValue) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		project, _, err := testGitlabClient.Projects.GetProject(projectName, nil)
@@ -13,6 +13,8 @@ var _ = registerResource("gitlab_project_tag", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_project_tag`" + ` resource allows to manage the lifecycle of a tag in a project.
 
+-> Tags cannot be changed once they are created, thus changing any of the arguments will recreate the tag.
+
 **Upstream API**: [GitLab API docs](https://docs.gitlab.com/ee/api/tags.html)`,
 
 		CreateContext: resourceGitlabProjectTagCreate,
@@ -42,7 +44,7 @@ var _ = registerResource("gitlab_project_tag", func() *schema.Resource {
 })
 
 func resourceGitlabProjectTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 	ref := d.Get("ref").(string)
@@ -63,7 +65,7 @@ func resourceGitlabProjectTagCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabProjectTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, name, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -75,7 +77,7 @@ func resourceGitlabProjectTagRead(ctx context.Context, d *schema.ResourceData, m
 		if is404(err) {
 			log.Printf("[DEBUG] recieved 404 for gitlab tag %s/%s, removing from state", project, name)
 			d.SetId("")
-			return diag.FromErr(err)
+			return nil
 		}
 		log.Printf("[DEBUG] failed to read gitlab tag %s/%s response %v", project, name, resp)
 		return diag.FromErr(err)
@@ -97,14 +99,14 @@ func resourceGitlabProjectTagRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabProjectTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, name, err := parseTwoPartID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("[DEBUG] delete gitlab tag %s/%s", project, name)
 	resp, err := client.Tags.DeleteTag(project, name, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		log.Printf("[DEBUG] failed to delete gitlab tag %s/%s response %v", project, name, resp)
 		return diag.FromErr(err)
 	}
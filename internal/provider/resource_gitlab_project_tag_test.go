@@ -65,6 +65,41 @@ func TestAccGitlabProjectTag_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProjectTag_deletedOutsideTerraform(t *testing.T) {
+	var tag gitlab.Tag
+	rInt, rInt2 := acctest.RandInt(), acctest.RandInt()
+	project := testAccCreateProject(t)
+	branches := testAccCreateBranches(t, project, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGitlabProjectTagConfig(rInt, rInt2, project.PathWithNamespace, branches[0].Name),
+				Check:  testAccCheckGitlabProjectTagExists("foo2", &tag, rInt2),
+			},
+			// Recreate the tag after it has been deleted outside of Terraform
+			{
+				PreConfig: func() {
+					if _, err := testGitlabClient.Tags.DeleteTag(project.ID, fmt.Sprintf("tag-%d", rInt2)); err != nil {
+						t.Fatalf("failed to delete tag: %v", err)
+					}
+				},
+				Config: testAccGitlabProjectTagConfig(rInt, rInt2, project.PathWithNamespace, branches[0].Name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectTagExists("foo2", &tag, rInt2),
+					testAccCheckGitlabProjectTagAttributes("foo2", &tag, &testAccGitlabProjectTagExpectedAttributes{
+						Name:    fmt.Sprintf("tag-%d", rInt2),
+						Message: fmt.Sprintf("tag-%d", rInt2),
+						Ref:     branches[0].Name,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabProjectTagDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "gitlab_project_tag" {
@@ -7,9 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -728,6 +731,8 @@ func TestAccGitlabProject_transfer(t *testing.T) {
 	pathBeforeTransfer := fmt.Sprintf("foogroup-%d/foo-%d", rInt, rInt)
 	pathAfterTransfer := fmt.Sprintf("foo2group-%d/foo-%d", rInt, rInt)
 
+	var projectIDBeforeTransfer string
+
 	resource.ParallelTest(t, resource.TestCase{
 		ProviderFactories: providerFactories,
 		CheckDestroy:      testAccCheckGitlabProjectDestroy,
@@ -738,6 +743,10 @@ func TestAccGitlabProject_transfer(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckGitlabProjectExists("gitlab_project.foo", &received),
 					resource.TestCheckResourceAttrPtr("gitlab_project_variable.foo", "value", &pathBeforeTransfer),
+					func(s *terraform.State) error {
+						projectIDBeforeTransfer = s.RootModule().Resources["gitlab_project.foo"].Primary.ID
+						return nil
+					},
 				),
 			},
 			// Create a second group and set the transfer the project to this group
@@ -747,8 +756,128 @@ func TestAccGitlabProject_transfer(t *testing.T) {
 					testAccCheckGitlabProjectExists("gitlab_project.foo", &received),
 					testAccCheckAggregateGitlabProject(&transferred, &received),
 					resource.TestCheckResourceAttrPtr("gitlab_project_variable.foo", "value", &pathAfterTransfer),
+					// The project is transferred in place
+					resource.TestCheckResourceAttrPtr("gitlab_project.foo", "id", &projectIDBeforeTransfer),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_transferNameConflict(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	config := func(namespace string) string {
+		return fmt.Sprintf(`
+resource "gitlab_group" "foo" {
+  name             = "foogroup-%[1]d"
+  path             = "foogroup-%[1]d"
+  visibility_level = "public"
+}
+
+resource "gitlab_group" "foo2" {
+  name             = "foo2group-%[1]d"
+  path             = "foo2group-%[1]d"
+  visibility_level = "public"
+}
+
+resource "gitlab_project" "existing" {
+  name             = "foo-%[1]d"
+  namespace_id     = gitlab_group.foo2.id
+  visibility_level = "public"
+}
+
+resource "gitlab_project" "foo" {
+  name             = "foo-%[1]d"
+  namespace_id     = gitlab_group.%[2]s.id
+  visibility_level = "public"
+}
+`, rInt, namespace)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("foo"),
+			},
+			// The target group already contains a project with the same name
+			{
+				Config:      config("foo2"),
+				ExpectError: regexp.MustCompile(`failed to transfer project \d+ to namespace \d+`),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_changeDefaultBranch(t *testing.T) {
+	var project gitlab.Project
+	rInt := acctest.RandInt()
+
+	config := func(defaultBranch string) string {
+		return fmt.Sprintf(`
+resource "gitlab_project" "foo" {
+  name                   = "foo-%d"
+  visibility_level       = "public"
+  initialize_with_readme = true
+  default_branch         = "%s"
+
+  migrate_default_branch_protection = true
+}
+`, rInt, defaultBranch)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config("master"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.foo", &project),
+					resource.TestCheckResourceAttr("gitlab_project.foo", "default_branch", "master"),
+				),
+			},
+			// The new default branch doesn't exist yet
+			{
+				Config:      config("main"),
+				ExpectError: regexp.MustCompile(`unable to set default branch of project \d+ to "main", because the branch does not exist`),
+			},
+			// Change the default branch and migrate its protection
+			{
+				PreConfig: func() {
+					if _, _, err := testGitlabClient.Branches.CreateBranch(project.ID, &gitlab.CreateBranchOptions{
+						Branch: gitlab.String("main"),
+						Ref:    gitlab.String("master"),
+					}); err != nil {
+						t.Fatalf("failed to create branch: %v", err)
+					}
+				},
+				Config: config("main"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.foo", "default_branch", "main"),
+					func(*terraform.State) error {
+						if _, _, err := testGitlabClient.ProtectedBranches.GetProtectedBranch(project.ID, "main"); err != nil {
+							return fmt.Errorf("expected new default branch to be protected: %w", err)
+						}
+						_, _, err := testGitlabClient.ProtectedBranches.GetProtectedBranch(project.ID, "master")
+						if err == nil {
+							return errors.New("expected old default branch to be unprotected")
+						}
+						if !is404(err) {
+							return err
+						}
+						return nil
+					},
 				),
 			},
+			{
+				ResourceName:            "gitlab_project.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initialize_with_readme", "migrate_default_branch_protection"},
+			},
 		},
 	})
 }
@@ -766,7 +895,7 @@ func TestAccGitlabProject_importURL(t *testing.T) {
 		t.Fatalf("failed to create base project: %v", err)
 	}
 
-	defer testGitlabClient.Projects.DeleteProject(baseProject.ID) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
+	defer testGitlabClient.Projects.DeleteProject(baseProject.ID, nil) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
 
 	// Add a file to the base project, for later verifying the import.
 	_, _, err = testGitlabClient.RepositoryFiles.CreateFile(baseProject.ID, "foo.txt", &gitlab.CreateFileOptions{
@@ -1084,7 +1213,7 @@ func TestAccGitlabProject_ImportURLMirrored(t *testing.T) {
 		t.Fatalf("failed to create base project: %v", err)
 	}
 
-	defer testGitlabClient.Projects.DeleteProject(baseProject.ID) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
+	defer testGitlabClient.Projects.DeleteProject(baseProject.ID, nil) // nolint // TODO: Resolve this golangci-lint issue: Error return value of `testGitlabClient.Projects.DeleteProject` is not checked (errcheck)
 
 	// Add a file to the base project, for later verifying the import.
 	_, _, err = testGitlabClient.RepositoryFiles.CreateFile(baseProject.ID, "foo.txt", &gitlab.CreateFileOptions{
@@ -1184,6 +1313,85 @@ func TestAccGitlabProject_ImportURLMirrored(t *testing.T) {
 	})
 }
 
+// lintignore: AT002 // not a Terraform import test
+func TestAccGitlabProject_ImportURLMirrorSyncTrigger(t *testing.T) {
+	testAccCheckEE(t)
+
+	baseProject := testAccCreateProject(t)
+
+	mirrorConfig := func(trigger string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_project" "mirror" {
+				name                  = "mirror-%d"
+				import_url            = "%s"
+				mirror                = true
+				mirror_sync_on_create = true
+				mirror_sync_trigger   = "%s"
+
+				# So that acceptance tests can be run in a gitlab organization
+				# with no billing
+				visibility_level = "public"
+			}
+		`, baseProject.ID, baseProject.HTTPURLToRepo, trigger)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: mirrorConfig("initial"),
+				Check:  testAccCheckGitlabProjectMirroredFile("gitlab_project.mirror", "README.md"),
+			},
+			// Changing the trigger pulls a new commit of the base project right away
+			{
+				PreConfig: func() {
+					testAccCreateProjectFile(t, baseProject.ID, "content", "synced.txt", "main")
+				},
+				Config: mirrorConfig("updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectMirroredFile("gitlab_project.mirror", "synced.txt"),
+					resource.TestCheckResourceAttr("gitlab_project.mirror", "mirror_sync_trigger", "updated"),
+				),
+			},
+			{
+				ResourceName:            "gitlab_project.mirror",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mirror_sync_on_create", "mirror_sync_trigger", "mirror_last_update_at"},
+			},
+		},
+	})
+}
+
+// testAccCheckGitlabProjectMirroredFile waits until the given file has been pulled into the mirrored project.
+func testAccCheckGitlabProjectMirroredFile(n string, filePath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		projectID := s.RootModule().Resources[n].Primary.ID
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"missing"},
+			Target:  []string{"present"},
+			Refresh: func() (interface{}, string, error) {
+				_, _, err := testGitlabClient.RepositoryFiles.GetFile(projectID, filePath, &gitlab.GetFileOptions{Ref: gitlab.String("main")})
+				if is404(err) {
+					return filePath, "missing", nil
+				}
+				if err != nil {
+					return nil, "", err
+				}
+				return filePath, "present", nil
+			},
+			Timeout:    2 * time.Minute,
+			MinTimeout: 2 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("file %s was not mirrored into project %s: %w", filePath, projectID, err)
+		}
+		return nil
+	}
+}
+
 func TestAccGitlabProject_templateMutualExclusiveNameAndID(t *testing.T) {
 	rInt := acctest.RandInt()
 
@@ -1243,6 +1451,215 @@ func TestAccGitlabProject_UpdateAnalyticsAccessLevel(t *testing.T) {
 	})
 }
 
+func TestAccGitlabProject_topics(t *testing.T) {
+	var received gitlab.Project
+	rInt := acctest.RandInt()
+
+	config := func(topics string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_project" "this" {
+				name             = "foo-%d"
+				visibility_level = "public"
+				topics           = %s
+			}`, rInt, topics)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Create the project with topics
+			{
+				Config: config(`["foo", "bar"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
+					resource.TestCheckResourceAttr("gitlab_project.this", "topics.#", "2"),
+				),
+			},
+			// Reordering the topics doesn't cause a diff
+			{
+				Config:   config(`["bar", "foo"]`),
+				PlanOnly: true,
+			},
+			// Add a topic in place
+			{
+				Config: config(`["foo", "bar", "baz"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
+					func(_ *terraform.State) error {
+						topics := append([]string{}, received.Topics...)
+						sort.Strings(topics)
+						if !reflect.DeepEqual(topics, []string{"bar", "baz", "foo"}) {
+							return fmt.Errorf("got topics %v, expected [bar baz foo]", received.Topics)
+						}
+						return nil
+					},
+				),
+			},
+			// Remove topics
+			{
+				Config: config(`["baz"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
+					resource.TestCheckResourceAttr("gitlab_project.this", "topics.#", "1"),
+					resource.TestCheckTypeSetElemAttr("gitlab_project.this", "topics.*", "baz"),
+				),
+			},
+			// Remove all topics
+			{
+				Config: config(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
+					resource.TestCheckResourceAttr("gitlab_project.this", "topics.#", "0"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_project.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_avatar(t *testing.T) {
+	var received gitlab.Project
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Create the project with an avatar
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "foo-%d"
+						visibility_level = "public"
+						avatar           = "${path.module}/testdata/avatar.png"
+						avatar_hash      = filesha256("${path.module}/testdata/avatar.png")
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
+					resource.TestCheckResourceAttrSet("gitlab_project.this", "avatar_url"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "avatar_hash", "8d29d9c393facb9d86314eb347a03fde503f2c0422bf55af7df086deb126107e"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"avatar", "avatar_hash"},
+			},
+			// Drop the configured hash, the computed hash doesn't upload the avatar again
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "foo-%d"
+						visibility_level = "public"
+						avatar           = "${path.module}/testdata/avatar.png"
+					}`, rInt),
+				PlanOnly: true,
+			},
+			// Clear the avatar
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "foo-%d"
+						visibility_level = "public"
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectExists("gitlab_project.this", &received),
+					resource.TestCheckResourceAttr("gitlab_project.this", "avatar_url", ""),
+					resource.TestCheckResourceAttr("gitlab_project.this", "avatar_hash", ""),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:            "gitlab_project.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"avatar", "avatar_hash"},
+			},
+		},
+	})
+}
+
+func TestAccGitlabProject_ciCdSettings(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabProjectDestroy,
+		Steps: []resource.TestStep{
+			// Create a project with custom CI/CD settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "foo-%d"
+						visibility_level = "public"
+
+						ci_config_path                = "ci/pipeline.yml"
+						build_timeout                 = 30 * 60
+						ci_default_git_depth          = 10
+						ci_forward_deployment_enabled = false
+						ci_separated_caches           = false
+						keep_latest_artifact          = false
+						auto_cancel_pending_pipelines = "disabled"
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_config_path", "ci/pipeline.yml"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "build_timeout", "1800"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_default_git_depth", "10"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_forward_deployment_enabled", "false"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_separated_caches", "false"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "keep_latest_artifact", "false"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "auto_cancel_pending_pipelines", "disabled"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_project.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the CI/CD settings
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_project" "this" {
+						name             = "foo-%d"
+						visibility_level = "public"
+
+						ci_config_path                = ".gitlab-ci.yml@mynamespace/myproject"
+						build_timeout                 = 2 * 60 * 60
+						ci_default_git_depth          = 20
+						ci_forward_deployment_enabled = true
+						ci_separated_caches           = true
+						keep_latest_artifact          = true
+						auto_cancel_pending_pipelines = "enabled"
+					}`, rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_config_path", ".gitlab-ci.yml@mynamespace/myproject"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "build_timeout", "7200"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_default_git_depth", "20"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_forward_deployment_enabled", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "ci_separated_caches", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "keep_latest_artifact", "true"),
+					resource.TestCheckResourceAttr("gitlab_project.this", "auto_cancel_pending_pipelines", "enabled"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_project.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccGitlabProject_containerExpirationPolicy(t *testing.T) {
 	var received gitlab.Project
 	rInt := acctest.RandInt()
@@ -1455,6 +1872,7 @@ func testAccCheckAggregateGitlabProject(expected, received *gitlab.Project) reso
 	testResource := allResources["gitlab_project"]()
 	expectedData := testResource.TestResourceData()
 	receivedData := testResource.TestResourceData()
+	meta := &ProviderMeta{Client: testGitlabClient}
 	for a, v := range testResource.Schema {
 		attribute := a
 		attrValue := v
@@ -1469,11 +1887,11 @@ func testAccCheckAggregateGitlabProject(expected, received *gitlab.Project) reso
 				}
 			}
 
-			if err := resourceGitlabProjectSetToState(context.Background(), testGitlabClient, expectedData, expected); err != nil {
+			if err := resourceGitlabProjectSetToState(context.Background(), meta, expectedData, expected); err != nil {
 				return err
 			}
 
-			if err := resourceGitlabProjectSetToState(context.Background(), testGitlabClient, receivedData, received); err != nil {
+			if err := resourceGitlabProjectSetToState(context.Background(), meta, receivedData, received); err != nil {
 				return err
 			}
 
@@ -25,13 +25,14 @@ var _ = registerResource("gitlab_project_variable", func() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: setDefaultVariableMasked,
 
 		Schema: gitlabProjectVariableGetSchema(),
 	}
 })
 
 func resourceGitlabProjectVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
@@ -40,10 +41,12 @@ func resourceGitlabProjectVariableCreate(ctx context.Context, d *schema.Resource
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
 	environmentScope := d.Get("environment_scope").(string)
+	description := d.Get("description").(string)
 
 	options := gitlab.CreateProjectVariableOptions{
 		Key:              &key,
 		Value:            &value,
+		Description:      &description,
 		VariableType:     variableType,
 		Protected:        &protected,
 		Masked:           &masked,
@@ -65,7 +68,7 @@ func resourceGitlabProjectVariableCreate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabProjectVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	var (
 		project          string
@@ -109,7 +112,7 @@ func resourceGitlabProjectVariableRead(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabProjectVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
@@ -118,9 +121,11 @@ func resourceGitlabProjectVariableUpdate(ctx context.Context, d *schema.Resource
 	protected := d.Get("protected").(bool)
 	masked := d.Get("masked").(bool)
 	environmentScope := d.Get("environment_scope").(string)
+	description := d.Get("description").(string)
 
 	options := &gitlab.UpdateProjectVariableOptions{
 		Value:            &value,
+		Description:      &description,
 		VariableType:     variableType,
 		Protected:        &protected,
 		Masked:           &masked,
@@ -137,7 +142,7 @@ func resourceGitlabProjectVariableUpdate(ctx context.Context, d *schema.Resource
 }
 
 func resourceGitlabProjectVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	key := d.Get("key").(string)
 	environmentScope := d.Get("environment_scope").(string)
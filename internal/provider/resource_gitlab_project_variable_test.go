@@ -23,6 +23,7 @@ func testAccCheckGitlabProjectVariableExists(name string) resource.TestCheckFunc
 		protected        string
 		masked           string
 		environmentScope string
+		description      string
 	)
 
 	return resource.ComposeTestCheckFunc(
@@ -41,6 +42,7 @@ func testAccCheckGitlabProjectVariableExists(name string) resource.TestCheckFunc
 			protected = strconv.FormatBool(got.Protected)
 			masked = strconv.FormatBool(got.Masked)
 			environmentScope = got.EnvironmentScope
+			description = got.Description
 
 			return nil
 		},
@@ -53,6 +55,7 @@ func testAccCheckGitlabProjectVariableExists(name string) resource.TestCheckFunc
 			resource.TestCheckResourceAttrPtr(name, "masked", &masked),
 			resource.TestCheckResourceAttrPtr(name, "protected", &protected),
 			resource.TestCheckResourceAttrPtr(name, "environment_scope", &environmentScope),
+			resource.TestCheckResourceAttrPtr(name, "description", &description),
 		),
 	)
 }
@@ -72,6 +75,109 @@ func testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx testAccGitlabPro
 	}
 }
 
+func TestAccGitlabProjectVariable_description(t *testing.T) {
+	testAccRequiresAtLeast(t, "16.2")
+	ctx := testAccGitlabProjectStart(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Create a project variable with a description.
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "foo" {
+  project     = %d
+  key         = "my_key"
+  value       = "my_value"
+  description = "my description"
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectVariableExists("gitlab_project_variable.foo"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.foo", "description", "my description"),
+				),
+			},
+			{
+				ResourceName:      "gitlab_project_variable.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Remove the description.
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "foo" {
+  project = %d
+  key     = "my_key"
+  value   = "my_value"
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabProjectVariableExists("gitlab_project_variable.foo"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.foo", "description", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGitlabProjectVariable_providerDefaultMasked(t *testing.T) {
+	ctx := testAccGitlabProjectStart(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccGitlabProjectVariableCheckAllVariablesDestroyed(ctx),
+		Steps: []resource.TestStep{
+			// Create variables with the provider default, one of them explicitly overrides it.
+			{
+				Config: fmt.Sprintf(`
+provider "gitlab" {
+  default_variable_masked = true
+}
+
+resource "gitlab_project_variable" "default" {
+  project = %[1]d
+  key     = "my_default_key"
+  value   = "my-masked-value"
+}
+
+resource "gitlab_project_variable" "override" {
+  project = %[1]d
+  key     = "my_override_key"
+  value   = "my value"
+  masked  = false
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_variable.default", "masked", "true"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.override", "masked", "false"),
+				),
+			},
+			// Remove the provider default, which unmasks the variable which does not set it explicitly.
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_project_variable" "default" {
+  project = %[1]d
+  key     = "my_default_key"
+  value   = "my-masked-value"
+}
+
+resource "gitlab_project_variable" "override" {
+  project = %[1]d
+  key     = "my_override_key"
+  value   = "my value"
+  masked  = false
+}
+`, ctx.project.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_project_variable.default", "masked", "false"),
+					resource.TestCheckResourceAttr("gitlab_project_variable.override", "masked", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitlabProjectVariable_basic(t *testing.T) {
 	ctx := testAccGitlabProjectStart(t)
 
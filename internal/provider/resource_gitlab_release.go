@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/xanzy/go-gitlab"
+)
+
+var validReleaseLinkTypes = []string{"other", "runbook", "image", "package"}
+
+var _ = registerResource("gitlab_release", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_release`" + ` resource allows to manage the lifecycle of a release.
+
+-> If the tag given in ` + "`tag_name`" + ` does not exist, GitLab creates it from ` + "`ref`" + `. The tag is not deleted when the release is destroyed.
+
+~> Do not configure ` + "`assets`" + ` for a release whose links are managed with the ` + "`gitlab_release_link`" + ` resource. If ` + "`assets`" + ` are omitted, the links of the release are left as they are.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/)`,
+
+		CreateContext: resourceGitlabReleaseCreate,
+		ReadContext:   resourceGitlabReleaseRead,
+		UpdateContext: resourceGitlabReleaseUpdate,
+		DeleteContext: resourceGitlabReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description: "The ID or [URL-encoded path of the project](https://docs.gitlab.com/ee/api/index.html#namespaced-path-encoding).",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"tag_name": {
+				Description: "The tag where the release is created from.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Required:    true,
+			},
+			"name": {
+				Description: "The release name. Defaults to the `tag_name`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Description: "The description of the release. You can use Markdown.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ref": {
+				Description: "If the `tag_name` does not exist, the release is created from this ref. It can be a commit SHA, another tag name, or a branch name. This attribute is not available for imported resources.",
+				Type:        schema.TypeString,
+				ForceNew:    true,
+				Optional:    true,
+			},
+			"milestones": {
+				Description: "The title of each milestone the release is associated with. This attribute is not read back from GitLab.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"assets": {
+				Description: "An asset link of the release.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the link. Link names must be unique within the release.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"url": {
+							Description: "The URL of the link. Link URLs must be unique within the release.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"filepath": {
+							Description: "Relative path for a [Direct Asset link](https://docs.gitlab.com/ee/user/project/releases/index.html#permanent-links-to-release-assets).",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"link_type": {
+							Description:      fmt.Sprintf("The type of the link. Valid values are %s. Defaults to %s.", renderValueListForDocs(validReleaseLinkTypes), validReleaseLinkTypes[0]),
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          validReleaseLinkTypes[0],
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validReleaseLinkTypes, false)),
+						},
+					},
+				},
+			},
+			"created_at": {
+				Description: "The date and time the release was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"released_at": {
+				Description: "The date and time the release is ready.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Get("project").(string)
+	tagName := d.Get("tag_name").(string)
+
+	options := &gitlab.CreateReleaseOptions{
+		TagName: gitlab.String(tagName),
+	}
+	if name, ok := d.GetOk("name"); ok {
+		options.Name = gitlab.String(name.(string))
+	}
+	if description, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(description.(string))
+	}
+	if ref, ok := d.GetOk("ref"); ok {
+		options.Ref = gitlab.String(ref.(string))
+	}
+	if milestones, ok := d.GetOk("milestones"); ok {
+		options.Milestones = stringSetToStringSlice(milestones.(*schema.Set))
+	}
+	if assets, ok := d.GetOk("assets"); ok {
+		options.Assets = &gitlab.ReleaseAssetsOptions{
+			Links: expandGitlabReleaseAssetLinks(assets.(*schema.Set).List()),
+		}
+	}
+
+	log.Printf("[DEBUG] create gitlab release %s in project %s", tagName, project)
+	release, _, err := client.Releases.CreateRelease(project, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create release %s in project %s: %v", tagName, project, err)
+	}
+
+	d.SetId(buildTwoPartID(&project, &release.TagName))
+	return resourceGitlabReleaseRead(ctx, d, meta)
+}
+
+func resourceGitlabReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project, tagName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab release %s in project %s", tagName, project)
+	release, _, err := client.Releases.GetRelease(project, tagName, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab release %s in project %s not found, removing from state", tagName, project)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read release %s in project %s: %v", tagName, project, err)
+	}
+
+	d.Set("project", project)
+	d.Set("tag_name", release.TagName)
+	d.Set("name", release.Name)
+	d.Set("description", release.Description)
+	if release.CreatedAt != nil {
+		d.Set("created_at", release.CreatedAt.Format(time.RFC3339))
+	}
+	if release.ReleasedAt != nil {
+		d.Set("released_at", release.ReleasedAt.Format(time.RFC3339))
+	}
+	if err := d.Set("assets", flattenGitlabReleaseAssetLinks(release.Assets.Links)); err != nil {
+		return diag.Errorf("error setting assets: %v", err)
+	}
+	return nil
+}
+
+func resourceGitlabReleaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project, tagName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "milestones") {
+		options := &gitlab.UpdateReleaseOptions{
+			Name:        gitlab.String(d.Get("name").(string)),
+			Description: gitlab.String(d.Get("description").(string)),
+		}
+		if d.HasChange("milestones") {
+			options.Milestones = stringSetToStringSlice(d.Get("milestones").(*schema.Set))
+		}
+
+		log.Printf("[DEBUG] update gitlab release %s in project %s", tagName, project)
+		if _, _, err := client.Releases.UpdateRelease(project, tagName, options, gitlab.WithContext(ctx)); err != nil {
+			return diag.Errorf("failed to update release %s in project %s: %v", tagName, project, err)
+		}
+	}
+
+	if d.HasChange("assets") {
+		if err := resourceGitlabReleaseUpdateAssetLinks(ctx, client, project, tagName, d.Get("assets").(*schema.Set).List()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGitlabReleaseRead(ctx, d, meta)
+}
+
+func resourceGitlabReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project, tagName, err := parseTwoPartID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] delete gitlab release %s in project %s", tagName, project)
+	if _, _, err := client.Releases.DeleteRelease(project, tagName, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to delete release %s in project %s: %v", tagName, project, err)
+	}
+	return nil
+}
+
+// resourceGitlabReleaseUpdateAssetLinks deletes and creates the asset links of a release,
+// matched by their name, until they match the configured `assets`.
+// Changed links are recreated, because the filepath of a link cannot be removed with an update.
+func resourceGitlabReleaseUpdateAssetLinks(ctx context.Context, client *gitlab.Client, project string, tagName string, assets []interface{}) error {
+	release, _, err := client.Releases.GetRelease(project, tagName, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to read release %s in project %s: %w", tagName, project, err)
+	}
+
+	desiredLinks := make(map[string]*gitlab.ReleaseAssetLinkOptions)
+	for _, link := range expandGitlabReleaseAssetLinks(assets) {
+		desiredLinks[*link.Name] = link
+	}
+
+	for _, currentLink := range release.Assets.Links {
+		if link, ok := desiredLinks[currentLink.Name]; ok && gitlabReleaseAssetLinkEqual(currentLink, link) {
+			delete(desiredLinks, currentLink.Name)
+			continue
+		}
+
+		log.Printf("[DEBUG] delete link %q of gitlab release %s in project %s", currentLink.Name, tagName, project)
+		if _, _, err := client.ReleaseLinks.DeleteReleaseLink(project, tagName, currentLink.ID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+			return fmt.Errorf("failed to delete link %q of release %s in project %s: %w", currentLink.Name, tagName, project, err)
+		}
+	}
+
+	for name, link := range desiredLinks {
+		log.Printf("[DEBUG] create link %q of gitlab release %s in project %s", name, tagName, project)
+		options := &gitlab.CreateReleaseLinkOptions{
+			Name:     link.Name,
+			URL:      link.URL,
+			FilePath: link.FilePath,
+			LinkType: link.LinkType,
+		}
+		if _, _, err := client.ReleaseLinks.CreateReleaseLink(project, tagName, options, gitlab.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to create link %q of release %s in project %s: %w", name, tagName, project, err)
+		}
+	}
+
+	return nil
+}
+
+func gitlabReleaseAssetLinkEqual(currentLink *gitlab.ReleaseLink, link *gitlab.ReleaseAssetLinkOptions) bool {
+	filePath := ""
+	if link.FilePath != nil {
+		filePath = *link.FilePath
+	}
+	return currentLink.URL == *link.URL &&
+		currentLink.LinkType == *link.LinkType &&
+		gitlabReleaseLinkFilePath(currentLink.DirectAssetURL) == filePath
+}
+
+func expandGitlabReleaseAssetLinks(assets []interface{}) []*gitlab.ReleaseAssetLinkOptions {
+	links := make([]*gitlab.ReleaseAssetLinkOptions, 0, len(assets))
+	for _, v := range assets {
+		asset := v.(map[string]interface{})
+		linkType := gitlab.LinkTypeValue(asset["link_type"].(string))
+		link := &gitlab.ReleaseAssetLinkOptions{
+			Name:     gitlab.String(asset["name"].(string)),
+			URL:      gitlab.String(asset["url"].(string)),
+			LinkType: &linkType,
+		}
+		if filePath := asset["filepath"].(string); filePath != "" {
+			link.FilePath = gitlab.String(filePath)
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+func flattenGitlabReleaseAssetLinks(links []*gitlab.ReleaseLink) []map[string]interface{} {
+	assets := make([]map[string]interface{}, 0, len(links))
+	for _, link := range links {
+		assets = append(assets, map[string]interface{}{
+			"name":      link.Name,
+			"url":       link.URL,
+			"filepath":  gitlabReleaseLinkFilePath(link.DirectAssetURL),
+			"link_type": string(link.LinkType),
+		})
+	}
+	return assets
+}
+
+// gitlabReleaseLinkFilePath returns the relative path of a direct asset link from its URL.
+func gitlabReleaseLinkFilePath(directAssetURL string) string {
+	directAssetLinkArray := strings.SplitN(directAssetURL, "downloads", 2)
+	if len(directAssetLinkArray) > 1 {
+		return directAssetLinkArray[1]
+	}
+	return ""
+}
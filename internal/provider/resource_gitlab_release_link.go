@@ -16,6 +16,8 @@ var _ = registerResource("gitlab_release_link", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`gitlab_release_link`" + ` resource allows to manage the lifecycle of a release link.
 
+-> This allows to attach links to releases which are not managed by Terraform. For releases managed with the ` + "`gitlab_release`" + ` resource use its ` + "`assets`" + ` instead.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/releases/links.html)`,
 
 		CreateContext: resourceGitlabReleaseLinkCreate,
@@ -30,7 +32,7 @@ var _ = registerResource("gitlab_release_link", func() *schema.Resource {
 })
 
 func resourceGitlabReleaseLinkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	tagName := d.Get("tag_name").(string)
 	name := d.Get("name").(string)
@@ -60,7 +62,7 @@ func resourceGitlabReleaseLinkCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabReleaseLinkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, tagName, linkID, err := resourceGitLabReleaseLinkParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -86,7 +88,7 @@ func resourceGitlabReleaseLinkRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabReleaseLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, tagName, linkID, err := resourceGitLabReleaseLinkParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -118,7 +120,7 @@ func resourceGitlabReleaseLinkUpdate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabReleaseLinkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, tagName, linkID, err := resourceGitLabReleaseLinkParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -126,7 +128,7 @@ func resourceGitlabReleaseLinkDelete(ctx context.Context, d *schema.ResourceData
 
 	log.Printf("[DEBUG] delete release link project/tagName/linkID: %s/%s/%d", project, tagName, linkID)
 	_, resp, err := client.ReleaseLinks.DeleteReleaseLink(project, tagName, linkID, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		log.Printf("[DEBUG] failed to delete release link project/tagName/linkID: %s/%s/%d. Response %v", project, tagName, linkID, resp)
 		return diag.FromErr(err)
 	}
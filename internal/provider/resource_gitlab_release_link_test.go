@@ -6,6 +6,7 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -17,7 +18,7 @@ func TestAccGitlabReleaseLink_basic(t *testing.T) {
 
 	rInt1, rInt2 := acctest.RandInt(), acctest.RandInt()
 	project := testAccCreateProject(t)
-	releases := testAccCreateReleases(t, project, 1)
+	releases := testAccCreateReleases(t, project, 2)
 
 	resource.ParallelTest(t, resource.TestCase{
 		ProviderFactories: providerFactories,
@@ -67,6 +68,20 @@ func TestAccGitlabReleaseLink_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// move the Release link to another release
+				Config: fmt.Sprintf(`
+				resource "gitlab_release_link" "this" {
+					project  = "%d"
+					tag_name = "%s"
+					name     = "test-%d"
+					url      = "https://test/%d"
+				}`, project.ID, releases[1].TagName, rInt2, rInt2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release_link.this", "tag_name", releases[1].TagName),
+					resource.TestMatchResourceAttr("gitlab_release_link.this", "id", regexp.MustCompile(fmt.Sprintf(`^%d:%s:\d+$`, project.ID, regexp.QuoteMeta(releases[1].TagName)))),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,139 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabRelease_basic(t *testing.T) {
+	tagName := acctest.RandomWithPrefix("acctest")
+	project := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabReleaseDestroy,
+		Steps: []resource.TestStep{
+			// Create a release and its tag with two asset links
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_release" "this" {
+					project     = "%d"
+					tag_name    = "%s"
+					ref         = "%s"
+					name        = "Release %[2]s"
+					description = "The first release"
+
+					assets {
+						name = "binary"
+						url  = "https://example.com/%[2]s/binary"
+					}
+
+					assets {
+						name      = "runbook"
+						url       = "https://example.com/%[2]s/runbook"
+						filepath  = "/runbook"
+						link_type = "runbook"
+					}
+				}`, project.ID, tagName, project.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "name", fmt.Sprintf("Release %s", tagName)),
+					resource.TestCheckResourceAttr("gitlab_release.this", "description", "The first release"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "assets.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_release.this", "assets.*", map[string]string{
+						"name":      "binary",
+						"url":       fmt.Sprintf("https://example.com/%s/binary", tagName),
+						"filepath":  "",
+						"link_type": "other",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_release.this", "assets.*", map[string]string{
+						"name":      "runbook",
+						"url":       fmt.Sprintf("https://example.com/%s/runbook", tagName),
+						"filepath":  "/runbook",
+						"link_type": "runbook",
+					}),
+					resource.TestCheckResourceAttrSet("gitlab_release.this", "created_at"),
+					resource.TestCheckResourceAttrSet("gitlab_release.this", "released_at"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_release.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref"},
+			},
+			// Update the description and the asset links
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_release" "this" {
+					project     = "%d"
+					tag_name    = "%s"
+					ref         = "%s"
+					name        = "Release %[2]s"
+					description = "The updated release"
+
+					assets {
+						name      = "binary"
+						url       = "https://example.com/%[2]s/binary-v2"
+						link_type = "package"
+					}
+
+					assets {
+						name = "image"
+						url  = "https://example.com/%[2]s/image"
+					}
+				}`, project.ID, tagName, project.DefaultBranch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_release.this", "description", "The updated release"),
+					resource.TestCheckResourceAttr("gitlab_release.this", "assets.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_release.this", "assets.*", map[string]string{
+						"name":      "binary",
+						"url":       fmt.Sprintf("https://example.com/%s/binary-v2", tagName),
+						"link_type": "package",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("gitlab_release.this", "assets.*", map[string]string{
+						"name":      "image",
+						"url":       fmt.Sprintf("https://example.com/%s/image", tagName),
+						"link_type": "other",
+					}),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_release.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ref"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabReleaseDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_release" {
+			continue
+		}
+		project, tagName, err := parseTwoPartID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.Releases.GetRelease(project, tagName)
+		if err == nil {
+			return errors.New("Release still exists")
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
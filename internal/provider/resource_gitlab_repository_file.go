@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"errors"
@@ -13,11 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
 const encoding = "base64"
 
+// validRepositoryFileEncodings are the encodings of the `content` of the gitlab_repository_file resource.
+// NOTE: the content is always sent base64 encoded to the API, see the schema for details.
+var validRepositoryFileEncodings = []string{"text", "base64"}
+
 // NOTE: this lock is a bit of a hack to prevent parallel calls to the GitLab Repository Files API.
 //
 //	If it is called concurrently, the API will return a 400 error along the lines of:
@@ -57,11 +63,30 @@ var _ = registerResource("gitlab_repository_file", func() *schema.Resource {
 			Update: schema.DefaultTimeout(1 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceGitlabRepositoryFileResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceGitlabRepositoryFileStateUpgradeV0,
+				Version: 0,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, rd *schema.ResourceDiff, i interface{}) error {
+			if rd.Get("encoding").(string) != "base64" || !rd.NewValueKnown("content") {
+				return nil
+			}
+			if _, err := base64.StdEncoding.DecodeString(rd.Get("content").(string)); err != nil {
+				return fmt.Errorf("content must be a valid base64 encoded string when encoding is base64: %w", err)
+			}
+			return nil
+		},
 
 		// the schema matches https://docs.gitlab.com/ee/api/repository_files.html#create-new-file-in-repository
-		// However, we don't support the `encoding` parameter as it seems to be broken.
+		// However, the `encoding` parameter of the API seems to be broken.
 		// Only a value of `base64` is supported, all others, including the documented default `text`, lead to
 		// a `400 {error: encoding does not have a valid value}` error.
+		// Therefore, the `encoding` attribute only defines the encoding of the `content` in the configuration
+		// and the content is always sent base64 encoded.
 		Schema: constructSchema(
 			map[string]*schema.Schema{
 				"branch": {
@@ -90,8 +115,28 @@ var _ = registerResource("gitlab_repository_file", func() *schema.Resource {
 					Type:        schema.TypeString,
 					Optional:    true,
 				},
+				"overwrite_on_create": {
+					Description: "Enable overwriting existing files when creating the resource, e.g. the `README.md` created together with a project. Otherwise, creating a file which already exists fails.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
 			},
 			gitlabRepositoryFileGetSchema(),
+			map[string]*schema.Schema{
+				"content": {
+					Description:      "File content. With the `text` encoding the content is used as is and with the `base64` encoding it must be base64 encoded, e.g. for binary files. If no `encoding` is given, the content is base64 encoded automatically unless it already is.",
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressEquivalentRepositoryFileBase64Content,
+				},
+				"encoding": {
+					Description:      fmt.Sprintf("The encoding of the `content`. Valid values are: %s. The content is always transferred base64 encoded to GitLab.", renderValueListForDocs(validRepositoryFileEncodings)),
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validRepositoryFileEncodings, false)),
+				},
+			},
 		),
 	}
 })
@@ -107,14 +152,8 @@ func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceD
 	defer resourceGitlabRepositoryFileApiLock.unlock()
 	log.Printf("[DEBUG] gitlab_repository_file: got lock to create %s/%s", project, filePath)
 
-	client := meta.(*gitlab.Client)
-	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
-	//       otherwise we encode the `content` to base64.
-	content := d.Get("content").(string)
-	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
-		log.Printf("[DEBUG] gitlab_repository_file: given content '%s' is not a valid base64 encoded string, encoding it ...", content)
-		content = base64.StdEncoding.EncodeToString([]byte(content))
-	}
+	client := meta.(*ProviderMeta).Client
+	content := encodeGitlabRepositoryFileContent(d.Get("encoding").(string), d.Get("content").(string))
 
 	options := &gitlab.CreateFileOptions{
 		Branch:        gitlab.String(d.Get("branch").(string)),
@@ -132,6 +171,36 @@ func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		if d.Get("overwrite_on_create").(bool) {
+			existingRepositoryFile, _, err := client.RepositoryFiles.GetFile(project, filePath, &gitlab.GetFileOptions{Ref: options.Branch}, gitlab.WithContext(ctx))
+			if err == nil {
+				log.Printf("[DEBUG] gitlab_repository_file: %s/%s already exists, overwriting it", project, filePath)
+				updateOptions := &gitlab.UpdateFileOptions{
+					Branch:          options.Branch,
+					Encoding:        options.Encoding,
+					AuthorEmail:     options.AuthorEmail,
+					AuthorName:      options.AuthorName,
+					Content:         options.Content,
+					CommitMessage:   options.CommitMessage,
+					LastCommitID:    gitlab.String(existingRepositoryFile.LastCommitID),
+					ExecuteFilemode: options.ExecuteFilemode,
+				}
+				repositoryFile, _, err := client.RepositoryFiles.UpdateFile(project, filePath, updateOptions, gitlab.WithContext(ctx))
+				if err != nil {
+					if isRefreshError(err) {
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+
+				d.SetId(resourceGitLabRepositoryFileBuildId(project, repositoryFile.Branch, repositoryFile.FilePath))
+				return nil
+			}
+			if !is404(err) {
+				return resource.NonRetryableError(err)
+			}
+		}
+
 		repositoryFile, _, err := client.RepositoryFiles.CreateFile(project, filePath, options, gitlab.WithContext(ctx))
 		if err != nil {
 			if isRefreshError(err) {
@@ -151,7 +220,7 @@ func resourceGitlabRepositoryFileCreate(ctx context.Context, d *schema.ResourceD
 }
 
 func resourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, branch, filePath, err := resourceGitLabRepositoryFileParseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -171,21 +240,14 @@ func resourceGitlabRepositoryFileRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	configContent := d.Get("content").(string)
-	log.Printf("[DEBUG] gitlab_repository_file: comparing content of %s with %s", repositoryFile.Content, configContent)
-	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
-	//       otherwise we encode the `content` to base64.
-	if _, err := base64.StdEncoding.DecodeString(configContent); err != nil {
-		// if `content` is config is not a base64 encoded string, we decode the one from the API, too
-		// in case it's base64 encoded, else we don't decode it.
-		if decodedContent, err := base64.StdEncoding.DecodeString(repositoryFile.Content); err == nil {
-			repositoryFile.Content = string(decodedContent)
-		}
-	}
+	repositoryFile.Content = decodeGitlabRepositoryFileContent(d.Get("encoding").(string), d.Get("content").(string), repositoryFile.Content)
 
 	d.SetId(resourceGitLabRepositoryFileBuildId(project, branch, repositoryFile.FilePath))
 	d.Set("branch", repositoryFile.Ref)
 	stateMap := gitlabRepositoryFileToStateMap(project, repositoryFile)
+	// NOTE: the `encoding` of the resource is the encoding of the configured `content`,
+	//       not the one returned by the API, which is always base64.
+	delete(stateMap, "encoding")
 	if err = setStateMapInResourceData(stateMap, d); err != nil {
 		return diag.FromErr(err)
 	}
@@ -206,19 +268,13 @@ func resourceGitlabRepositoryFileUpdate(ctx context.Context, d *schema.ResourceD
 	defer resourceGitlabRepositoryFileApiLock.unlock()
 	log.Printf("[DEBUG] gitlab_repository_file: got lock to update %s/%s", project, filePath)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	readOptions := &gitlab.GetFileOptions{
 		Ref: gitlab.String(branch),
 	}
 
-	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
-	//       otherwise we encode the `content` to base64.
-	content := d.Get("content").(string)
-	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
-		log.Printf("[DEBUG] gitlab_repository_file: given content '%s' is not a valid base64 encoded string, encoding it ...", content)
-		content = base64.StdEncoding.EncodeToString([]byte(content))
-	}
+	content := encodeGitlabRepositoryFileContent(d.Get("encoding").(string), d.Get("content").(string))
 
 	updateOptions := &gitlab.UpdateFileOptions{
 		Branch:        gitlab.String(branch),
@@ -273,7 +329,7 @@ func resourceGitlabRepositoryFileDelete(ctx context.Context, d *schema.ResourceD
 	defer resourceGitlabRepositoryFileApiLock.unlock()
 	log.Printf("[DEBUG] gitlab_repository_file: got lock to delete %s/%s", project, filePath)
 
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	readOptions := &gitlab.GetFileOptions{
 		Ref: gitlab.String(branch),
@@ -323,6 +379,154 @@ func resourceGitLabRepositoryFileBuildId(project string, branch string, filePath
 	return fmt.Sprintf("%s:%s:%s", project, branch, filePath)
 }
 
+// encodeGitlabRepositoryFileContent returns the base64 encoded content to send to the API.
+func encodeGitlabRepositoryFileContent(contentEncoding string, content string) string {
+	switch contentEncoding {
+	case "base64":
+		return content
+	case "text":
+		return base64.StdEncoding.EncodeToString([]byte(content))
+	}
+
+	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
+	//       otherwise we encode the `content` to base64.
+	if _, err := base64.StdEncoding.DecodeString(content); err != nil {
+		log.Printf("[DEBUG] gitlab_repository_file: given content '%s' is not a valid base64 encoded string, encoding it ...", content)
+		return base64.StdEncoding.EncodeToString([]byte(content))
+	}
+	return content
+}
+
+// decodeGitlabRepositoryFileContent returns the base64 encoded content from the API
+// in the encoding of the configured content.
+func decodeGitlabRepositoryFileContent(contentEncoding string, configContent string, apiContent string) string {
+	switch contentEncoding {
+	case "base64":
+		return apiContent
+	case "text":
+		if decodedContent, err := base64.StdEncoding.DecodeString(apiContent); err == nil {
+			return string(decodedContent)
+		}
+		return apiContent
+	}
+
+	log.Printf("[DEBUG] gitlab_repository_file: comparing content of %s with %s", apiContent, configContent)
+	// NOTE: for backwards-compatibility reasons, we also support an already given base64 encoding,
+	//       otherwise we encode the `content` to base64.
+	if _, err := base64.StdEncoding.DecodeString(configContent); err != nil {
+		// if `content` is config is not a base64 encoded string, we decode the one from the API, too
+		// in case it's base64 encoded, else we don't decode it.
+		if decodedContent, err := base64.StdEncoding.DecodeString(apiContent); err == nil {
+			return string(decodedContent)
+		}
+	}
+	return apiContent
+}
+
+// suppressEquivalentRepositoryFileBase64Content suppresses the diff of base64 encoded contents
+// which decode to the same bytes, e.g. with and without line breaks.
+func suppressEquivalentRepositoryFileBase64Content(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("encoding").(string) != "base64" {
+		return false
+	}
+	oldContent, err := base64.StdEncoding.DecodeString(old)
+	if err != nil {
+		return false
+	}
+	newContent, err := base64.StdEncoding.DecodeString(new)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(oldContent, newContent)
+}
+
+// resourceGitlabRepositoryFileResourceV0 is a frozen copy of the schema before the `encoding` became configurable.
+// It must not be derived from the current schema, otherwise later changes would alter how the v0 state is decoded.
+func resourceGitlabRepositoryFileResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_branch": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"author_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"file_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ref": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"file_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"encoding": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execute_filemode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"blob_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceGitlabRepositoryFileStateUpgradeV0 removes the `encoding` which was read from the API before it
+// became the encoding of the configured `content`. The API always returned `base64`.
+func resourceGitlabRepositoryFileStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	delete(rawState, "encoding")
+	return rawState, nil
+}
+
 func isRefreshError(err error) bool {
 	var httpErr *gitlab.ErrorResponse
 	return errors.As(err, &httpErr) &&
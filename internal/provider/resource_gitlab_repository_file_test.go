@@ -4,7 +4,10 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -239,6 +242,168 @@ func TestAccGitlabRepositoryFile_base64EncodingWithTextContent(t *testing.T) {
 	})
 }
 
+func TestResourceGitlabRepositoryFileStateUpgradeV0(t *testing.T) {
+	expected := map[string]interface{}{
+		"project":   "1",
+		"file_path": "meow.txt",
+		"content":   "bWVvdyBtZW93IG1lb3c=",
+	}
+	actual, err := resourceGitlabRepositoryFileStateUpgradeV0(context.Background(), map[string]interface{}{
+		"project":   "1",
+		"file_path": "meow.txt",
+		"content":   "bWVvdyBtZW93IG1lb3c=",
+		"encoding":  "base64",
+	}, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+}
+
+func TestResourceGitlabRepositoryFileContentEncoding(t *testing.T) {
+	cases := []struct {
+		Encoding       string
+		Content        string
+		EncodedContent string
+	}{
+		{Encoding: "text", Content: "meow", EncodedContent: "bWVvdw=="},
+		// valid base64, but must not be treated as such with the text encoding
+		{Encoding: "text", Content: "abcd", EncodedContent: "YWJjZA=="},
+		{Encoding: "base64", Content: "AAEC/w==", EncodedContent: "AAEC/w=="},
+		{Encoding: "", Content: "meow meow meow", EncodedContent: "bWVvdyBtZW93IG1lb3c="},
+		{Encoding: "", Content: "bWVvdyBtZW93IG1lb3c=", EncodedContent: "bWVvdyBtZW93IG1lb3c="},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s/%s", tc.Encoding, tc.Content), func(t *testing.T) {
+			if encoded := encodeGitlabRepositoryFileContent(tc.Encoding, tc.Content); encoded != tc.EncodedContent {
+				t.Fatalf("got encoded content %q, expected %q", encoded, tc.EncodedContent)
+			}
+			if decoded := decodeGitlabRepositoryFileContent(tc.Encoding, tc.Content, tc.EncodedContent); decoded != tc.Content {
+				t.Fatalf("got decoded content %q, expected %q", decoded, tc.Content)
+			}
+		})
+	}
+}
+
+func TestAccGitlabRepositoryFile_contentEncoding(t *testing.T) {
+	var textFile, binaryFile gitlab.File
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabRepositoryFileDestroy,
+		Steps: []resource.TestStep{
+			// Create a text file which content is valid base64 and a binary file
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_repository_file" "text" {
+						project        = %[1]d
+						file_path      = "meow.txt"
+						branch         = "main"
+						encoding       = "text"
+						content        = "abcd"
+						commit_message = "feature: add text file"
+					}
+
+					resource "gitlab_repository_file" "binary" {
+						project        = %[1]d
+						file_path      = "meow.bin"
+						branch         = "main"
+						encoding       = "base64"
+						content        = "AAEC/w=="
+						commit_message = "feature: add binary file"
+
+						depends_on = [gitlab_repository_file.text]
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRepositoryFileExists("gitlab_repository_file.text", &textFile),
+					testAccCheckGitlabRepositoryFileAttributes(&textFile, &testAccGitlabRepositoryFileAttributes{
+						FilePath: "meow.txt",
+						Content:  "YWJjZA==",
+					}),
+					resource.TestCheckResourceAttr("gitlab_repository_file.text", "content", "abcd"),
+					testAccCheckGitlabRepositoryFileExists("gitlab_repository_file.binary", &binaryFile),
+					testAccCheckGitlabRepositoryFileAttributes(&binaryFile, &testAccGitlabRepositoryFileAttributes{
+						FilePath: "meow.bin",
+						Content:  "AAEC/w==",
+					}),
+					resource.TestCheckResourceAttr("gitlab_repository_file.binary", "content", "AAEC/w=="),
+					resource.TestCheckResourceAttr("gitlab_repository_file.binary", "size", "4"),
+				),
+			},
+			// Invalid base64 content is rejected during plan
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_repository_file" "binary" {
+						project        = %d
+						file_path      = "meow.bin"
+						branch         = "main"
+						encoding       = "base64"
+						content        = "not base64"
+						commit_message = "feature: add binary file"
+					}
+				`, testProject.ID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`content must be a valid base64 encoded string`),
+			},
+		},
+	})
+}
+
+func TestAccGitlabRepositoryFile_overwriteOnCreate(t *testing.T) {
+	var file gitlab.File
+	// NOTE: the project is initialized with a README.md
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabRepositoryFileDestroy,
+		Steps: []resource.TestStep{
+			// Creating an existing file fails without overwrite_on_create
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_repository_file" "this" {
+						project        = %d
+						file_path      = "README.md"
+						branch         = "main"
+						encoding       = "text"
+						content        = "# Meow"
+						commit_message = "feature: replace readme"
+					}
+				`, testProject.ID),
+				ExpectError: regexp.MustCompile(`A file with this name already exists`),
+			},
+			// Overwrite the existing file
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_repository_file" "this" {
+						project             = %d
+						file_path           = "README.md"
+						branch              = "main"
+						encoding            = "text"
+						content             = "# Meow"
+						commit_message      = "feature: replace readme"
+						overwrite_on_create = true
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabRepositoryFileExists("gitlab_repository_file.this", &file),
+					testAccCheckGitlabRepositoryFileAttributes(&file, &testAccGitlabRepositoryFileAttributes{
+						FilePath: "README.md",
+						Content:  "IyBNZW93",
+					}),
+					resource.TestCheckResourceAttr("gitlab_repository_file.this", "content", "# Meow"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGitlabRepositoryFile_createWithExecuteFilemode(t *testing.T) {
 	testProject := testAccCreateProject(t)
 
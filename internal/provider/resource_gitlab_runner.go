@@ -100,7 +100,7 @@ The runner will be registered at a group level if the token used is from a group
 })
 
 func resourceGitLabRunnerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.RegisterNewRunnerOptions{
 		Token: gitlab.String(d.Get("registration_token").(string)),
@@ -157,7 +157,7 @@ func resourceGitLabRunnerCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitLabRunnerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	runnerID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -185,7 +185,7 @@ func resourceGitLabRunnerRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitLabRunnerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	runnerID := d.Id()
 
 	options := &gitlab.UpdateRunnerDetailsOptions{}
@@ -235,7 +235,7 @@ func resourceGitLabRunnerUpdate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceGitLabRunnerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	runnerID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -17,10 +17,10 @@ var _ = registerResource("gitlab_service_external_wiki", func() *schema.Resource
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#external-wiki)`,
 
-		CreateContext: resourceGitlabServiceExternalWikiCreate,
+		CreateContext: resourceGitlabServiceSet("external wiki", resourceGitlabServiceExternalWikiSet, resourceGitlabServiceExternalWikiRead),
 		ReadContext:   resourceGitlabServiceExternalWikiRead,
-		UpdateContext: resourceGitlabServiceExternalWikiCreate,
-		DeleteContext: resourceGitlabServiceExternalWikiDelete,
+		UpdateContext: resourceGitlabServiceSet("external wiki", resourceGitlabServiceExternalWikiSet, resourceGitlabServiceExternalWikiRead),
+		DeleteContext: resourceGitlabServiceDelete("external wiki", (*gitlab.ServicesService).DeleteExternalWikiService),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -68,27 +68,17 @@ var _ = registerResource("gitlab_service_external_wiki", func() *schema.Resource
 	}
 })
 
-func resourceGitlabServiceExternalWikiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Get("project").(string)
-	d.SetId(project)
-
+func resourceGitlabServiceExternalWikiSet(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
 	options := &gitlab.SetExternalWikiServiceOptions{
 		ExternalWikiURL: gitlab.String(d.Get("external_wiki_url").(string)),
 	}
 
-	log.Printf("[DEBUG] create gitlab external wiki service for project %s", project)
-
-	_, err := client.Services.SetExternalWikiService(project, options, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceGitlabServiceExternalWikiRead(ctx, d, meta)
+	_, _, err := client.Services.SetExternalWikiService(project, options, gitlab.WithContext(ctx))
+	return err
 }
 
 func resourceGitlabServiceExternalWikiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] read gitlab external wiki service for project %s", project)
@@ -115,17 +105,3 @@ func resourceGitlabServiceExternalWikiRead(ctx context.Context, d *schema.Resour
 
 	return nil
 }
-
-func resourceGitlabServiceExternalWikiDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Id()
-
-	log.Printf("[DEBUG] delete gitlab external wiki service for project %s", project)
-
-	_, err := client.Services.DeleteExternalWikiService(project, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
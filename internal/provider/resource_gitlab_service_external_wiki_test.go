@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -95,6 +96,24 @@ func TestAccGitlabServiceExternalWiki_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabServiceExternalWiki_invalidURL(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_service_external_wiki" "this" {
+						project           = "foo"
+						external_wiki_url = "mywiki.com"
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected "external_wiki_url" to have a host`),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabServiceExternalWikiExists(resourceIdentifier string, service *gitlab.ExternalWikiService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceIdentifier]
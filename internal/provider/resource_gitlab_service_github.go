@@ -21,7 +21,7 @@ var _ = registerResource("gitlab_service_github", func() *schema.Resource {
 		CreateContext: resourceGitlabServiceGithubCreate,
 		ReadContext:   resourceGitlabServiceGithubRead,
 		UpdateContext: resourceGitlabServiceGithubUpdate,
-		DeleteContext: resourceGitlabServiceGithubDelete,
+		DeleteContext: resourceGitlabServiceDelete("github", (*gitlab.ServicesService).DeleteGithubService),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceGitlabServiceGithubImportState,
 		},
@@ -88,7 +88,7 @@ func resourceGitlabServiceGithubSetToState(d *schema.ResourceData, service *gitl
 }
 
 func resourceGitlabServiceGithubCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] create gitlab github service for project %s", project)
@@ -99,7 +99,7 @@ func resourceGitlabServiceGithubCreate(ctx context.Context, d *schema.ResourceDa
 		StaticContext: gitlab.Bool(d.Get("static_context").(bool)),
 	}
 
-	_, err := client.Services.SetGithubService(project, opts, gitlab.WithContext(ctx))
+	_, _, err := client.Services.SetGithubService(project, opts, gitlab.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,7 +108,7 @@ func resourceGitlabServiceGithubCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabServiceGithubRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 
 	log.Printf("[DEBUG] read gitlab github service for project %s", project)
@@ -135,20 +135,6 @@ func resourceGitlabServiceGithubUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceGitlabServiceGithubCreate(ctx, d, meta)
 }
 
-func resourceGitlabServiceGithubDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Get("project").(string)
-
-	log.Printf("[DEBUG] delete gitlab github service for project %s", project)
-
-	_, err := client.Services.DeleteGithubService(project, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
 func resourceGitlabServiceGithubImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("project", d.Id())
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gitlab "github.com/xanzy/go-gitlab"
 )
 
@@ -15,10 +16,10 @@ var _ = registerResource("gitlab_service_jira", func() *schema.Resource {
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/services.html#jira)`,
 
-		CreateContext: resourceGitlabServiceJiraCreate,
+		CreateContext: resourceGitlabServiceSet("jira", resourceGitlabServiceJiraSet, resourceGitlabServiceJiraRead),
 		ReadContext:   resourceGitlabServiceJiraRead,
-		UpdateContext: resourceGitlabServiceJiraUpdate,
-		DeleteContext: resourceGitlabServiceJiraDelete,
+		UpdateContext: resourceGitlabServiceSet("jira", resourceGitlabServiceJiraSet, resourceGitlabServiceJiraRead),
+		DeleteContext: resourceGitlabServiceDelete("jira", (*gitlab.ServicesService).DeleteJiraService),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -69,13 +70,22 @@ var _ = registerResource("gitlab_service_jira", func() *schema.Resource {
 				Optional:    true,
 				Default:     "",
 			},
+			"jira_auth_type": {
+				Description: "The authentication method to use with Jira. `0` means Basic Authentication, `1` means Jira personal access token. " +
+					"Jira Cloud only supports Basic Authentication with the email address of the user as `username` and an API token as `password`. " +
+					"Jira personal access tokens are only available for Jira Data Center and Jira Server. Defaults to `0`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
 			"username": {
-				Description: "The username of the user created to be used with GitLab/JIRA.",
+				Description: "The username of the user created to be used with GitLab/JIRA. For Jira Cloud, this is the email address of the user. Not required when using a Jira personal access token.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 			},
 			"password": {
-				Description: "The password of the user created to be used with GitLab/JIRA.",
+				Description: "The password of the user created to be used with GitLab/JIRA. For Jira Cloud, this is an API token. When using a Jira personal access token, this is the token. GitLab never returns the password, so changes made outside of Terraform are not detected.",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
@@ -143,29 +153,18 @@ var _ = registerResource("gitlab_service_jira", func() *schema.Resource {
 	}
 })
 
-func resourceGitlabServiceJiraCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-
-	project := d.Get("project").(string)
-
+func resourceGitlabServiceJiraSet(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
 	jiraOptions, err := expandJiraOptions(d)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	log.Printf("[DEBUG] Create Gitlab Jira service")
-
-	if _, err := client.Services.SetJiraService(project, jiraOptions, gitlab.WithContext(ctx)); err != nil {
-		return diag.Errorf("couldn't create Gitlab Jira service: %v", err)
+		return err
 	}
 
-	d.SetId(project)
-
-	return resourceGitlabServiceJiraRead(ctx, d, meta)
+	_, _, err = client.Services.SetJiraService(project, jiraOptions, gitlab.WithContext(ctx))
+	return err
 }
 
 func resourceGitlabServiceJiraRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] Read Gitlab Jira service %s", project)
@@ -183,10 +182,12 @@ func resourceGitlabServiceJiraRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("project", project)
 	d.Set("url", jiraService.Properties.URL)
 	d.Set("api_url", jiraService.Properties.APIURL)
+	d.Set("jira_auth_type", jiraService.Properties.JiraAuthType)
 	d.Set("username", jiraService.Properties.Username)
+	// The password is write-only and is kept as configured, GitLab only returns it masked.
 	d.Set("project_key", jiraService.Properties.ProjectKey)
 
-	hasJiraIssueTransitionIDFixed, err := isGitLabVersionAtLeast(ctx, client, "15.2")()
+	hasJiraIssueTransitionIDFixed, err := meta.(*ProviderMeta).supportsFeature(ctx, "15.2")
 	if err != nil {
 		return diag.Errorf("failed to check if `jira_issue_transition_id` is properly supported in GitLab version: %v", err)
 	}
@@ -195,7 +196,9 @@ func resourceGitlabServiceJiraRead(ctx context.Context, d *schema.ResourceData,
 	}
 	d.Set("title", jiraService.Title)
 	d.Set("created_at", jiraService.CreatedAt.String())
-	d.Set("updated_at", jiraService.UpdatedAt.String())
+	if jiraService.UpdatedAt != nil {
+		d.Set("updated_at", jiraService.UpdatedAt.String())
+	}
 	d.Set("active", jiraService.Active)
 	d.Set("push_events", jiraService.PushEvents)
 	d.Set("issues_events", jiraService.IssuesEvents)
@@ -210,25 +213,6 @@ func resourceGitlabServiceJiraRead(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
-func resourceGitlabServiceJiraUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceGitlabServiceJiraCreate(ctx, d, meta)
-}
-
-func resourceGitlabServiceJiraDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-
-	project := d.Get("project").(string)
-
-	log.Printf("[DEBUG] Delete Gitlab Jira service %s", d.Id())
-
-	_, err := client.Services.DeleteJiraService(project, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
 func expandJiraOptions(d *schema.ResourceData) (*gitlab.SetJiraServiceOptions, error) {
 	setJiraServiceOptions := gitlab.SetJiraServiceOptions{}
 
@@ -237,6 +221,7 @@ func expandJiraOptions(d *schema.ResourceData) (*gitlab.SetJiraServiceOptions, e
 	setJiraServiceOptions.ProjectKey = gitlab.String(d.Get("project_key").(string))
 	setJiraServiceOptions.Username = gitlab.String(d.Get("username").(string))
 	setJiraServiceOptions.Password = gitlab.String(d.Get("password").(string))
+	setJiraServiceOptions.JiraAuthType = gitlab.Int(d.Get("jira_auth_type").(int))
 	setJiraServiceOptions.CommitEvents = gitlab.Bool(d.Get("commit_events").(bool))
 	setJiraServiceOptions.MergeRequestsEvents = gitlab.Bool(d.Get("merge_requests_events").(bool))
 	setJiraServiceOptions.CommentOnEventEnabled = gitlab.Bool(d.Get("comment_on_event_enabled").(bool))
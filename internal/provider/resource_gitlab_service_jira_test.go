@@ -95,6 +95,64 @@ func TestAccGitlabServiceJira_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabServiceJira_cloud(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	config := func(transitionID string) string {
+		return fmt.Sprintf(`
+			resource "gitlab_service_jira" "this" {
+				project                  = "%d"
+				url                      = "https://example.atlassian.net"
+				jira_auth_type           = 0
+				username                 = "user@example.com"
+				password                 = "api-token"
+				project_key              = "PROJ"
+				jira_issue_transition_id = "%s"
+				commit_events            = true
+				merge_requests_events    = true
+				comment_on_event_enabled = true
+			}
+		`, testProject.ID, transitionID)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceJiraDestroy,
+		Steps: []resource.TestStep{
+			// Configure a Jira Cloud integration
+			{
+				Config: config("11,21"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_jira.this", "jira_auth_type", "0"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.this", "username", "user@example.com"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.this", "project_key", "PROJ"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.this", "jira_issue_transition_id", "11,21"),
+					resource.TestCheckResourceAttr("gitlab_service_jira.this", "active", "true"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_service_jira.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// TODO: as soon as we remove support for GitLab < 15.2 we can remove ignoring `jira_issue_transition_id`.
+				//        See https://gitlab.com/gitlab-org/gitlab/-/issues/362437
+				ImportStateVerifyIgnore: []string{"password", "jira_issue_transition_id"},
+			},
+			// Update the transition IDs
+			{
+				Config: config("31"),
+				Check:  resource.TestCheckResourceAttr("gitlab_service_jira.this", "jira_issue_transition_id", "31"),
+			},
+			// The write-only password does not cause a diff
+			{
+				Config:   config("31"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabServiceJiraExists(n string, service *gitlab.JiraService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
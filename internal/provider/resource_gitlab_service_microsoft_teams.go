@@ -15,10 +15,10 @@ var _ = registerResource("gitlab_service_microsoft_teams", func() *schema.Resour
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#microsoft-teams)`,
 
-		CreateContext: resourceGitlabServiceMicrosoftTeamsCreate,
+		CreateContext: resourceGitlabServiceSet("microsoft teams", resourceGitlabServiceMicrosoftTeamsSet, resourceGitlabServiceMicrosoftTeamsRead),
 		ReadContext:   resourceGitlabServiceMicrosoftTeamsRead,
-		UpdateContext: resourceGitlabServiceMicrosoftTeamsUpdate,
-		DeleteContext: resourceGitlabServiceMicrosoftTeamsDelete,
+		UpdateContext: resourceGitlabServiceSet("microsoft teams", resourceGitlabServiceMicrosoftTeamsSet, resourceGitlabServiceMicrosoftTeamsRead),
+		DeleteContext: resourceGitlabServiceDelete("microsoft teams", (*gitlab.ServicesService).DeleteMicrosoftTeamsService),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -46,9 +46,10 @@ var _ = registerResource("gitlab_service_microsoft_teams", func() *schema.Resour
 				Computed:    true,
 			},
 			"webhook": {
-				Description:  "The Microsoft Teams webhook. For example, https://outlook.office.com/webhook/...",
+				Description:  "The Microsoft Teams webhook. For example, https://outlook.office.com/webhook/... GitLab masks the webhook when reading the integration, so changes made outside of Terraform are not detected and the webhook of an imported integration is set again with the next apply.",
 				Type:         schema.TypeString,
 				Required:     true,
+				Sensitive:    true,
 				ValidateFunc: validateURLFunc,
 			},
 			"notify_only_broken_pipelines": {
@@ -110,11 +111,7 @@ var _ = registerResource("gitlab_service_microsoft_teams", func() *schema.Resour
 	}
 })
 
-func resourceGitlabServiceMicrosoftTeamsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Get("project").(string)
-	d.SetId(project)
-
+func resourceGitlabServiceMicrosoftTeamsSet(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
 	options := &gitlab.SetMicrosoftTeamsServiceOptions{
 		WebHook:                   gitlab.String(d.Get("webhook").(string)),
 		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
@@ -130,17 +127,12 @@ func resourceGitlabServiceMicrosoftTeamsCreate(ctx context.Context, d *schema.Re
 		WikiPageEvents:            gitlab.Bool(d.Get("wiki_page_events").(bool)),
 	}
 
-	log.Printf("[DEBUG] Create Gitlab Microsoft Teams service")
-
-	if _, err := client.Services.SetMicrosoftTeamsService(project, options, gitlab.WithContext(ctx)); err != nil {
-		return diag.Errorf("couldn't create Gitlab Microsoft Teams service: %v", err)
-	}
-
-	return resourceGitlabServiceMicrosoftTeamsRead(ctx, d, meta)
+	_, _, err := client.Services.SetMicrosoftTeamsService(project, options, gitlab.WithContext(ctx))
+	return err
 }
 
 func resourceGitlabServiceMicrosoftTeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] Read Gitlab Microsoft Teams service for project %s", d.Id())
@@ -157,9 +149,15 @@ func resourceGitlabServiceMicrosoftTeamsRead(ctx context.Context, d *schema.Reso
 
 	d.Set("project", project)
 	d.Set("created_at", teamsService.CreatedAt.String())
-	d.Set("updated_at", teamsService.UpdatedAt.String())
+	if teamsService.UpdatedAt != nil {
+		d.Set("updated_at", teamsService.UpdatedAt.String())
+	}
 	d.Set("active", teamsService.Active)
-	d.Set("webhook", teamsService.Properties.WebHook)
+	// GitLab may return the webhook masked, keep the last value set by the provider in that case.
+	// A masked webhook is never stored, e.g. after an import, so that the configured webhook is set again.
+	if webhook := teamsService.Properties.WebHook; !isMaskedServiceValue(webhook) {
+		d.Set("webhook", webhook)
+	}
 	d.Set("notify_only_broken_pipelines", teamsService.Properties.NotifyOnlyBrokenPipelines)
 	d.Set("branches_to_be_notified", teamsService.Properties.BranchesToBeNotified)
 	d.Set("push_events", teamsService.PushEvents)
@@ -174,21 +172,3 @@ func resourceGitlabServiceMicrosoftTeamsRead(ctx context.Context, d *schema.Reso
 
 	return nil
 }
-
-func resourceGitlabServiceMicrosoftTeamsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceGitlabServiceMicrosoftTeamsCreate(ctx, d, meta)
-}
-
-func resourceGitlabServiceMicrosoftTeamsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Id()
-
-	log.Printf("[DEBUG] Delete Gitlab Microsoft Teams service for project %s", d.Id())
-
-	_, err := client.Services.DeleteMicrosoftTeamsService(project, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return diag.FromErr(err)
-}
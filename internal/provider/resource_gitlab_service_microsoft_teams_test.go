@@ -88,6 +88,53 @@ func TestAccGitlabServiceMicrosoftTeams_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabServiceMicrosoftTeams_pipelineEventsOnly(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceMicrosoftTeamsDestroy,
+		Steps: []resource.TestStep{
+			// Only notify about broken pipelines
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_service_microsoft_teams" "this" {
+						project                      = "%d"
+						webhook                      = "https://test.com/?token=6"
+						pipeline_events              = true
+						notify_only_broken_pipelines = true
+						branches_to_be_notified      = "default"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.this", "pipeline_events", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.this", "push_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_microsoft_teams.this", "merge_requests_events", "false"),
+					func(s *terraform.State) error {
+						service, _, err := testGitlabClient.Services.GetMicrosoftTeamsService(testProject.ID)
+						if err != nil {
+							return err
+						}
+						if !service.PipelineEvents || service.PushEvents || service.IssuesEvents || service.MergeRequestsEvents || service.TagPushEvents || service.NoteEvents || service.WikiPageEvents {
+							return fmt.Errorf("expected only pipeline events to be enabled for the microsoft teams service of project %d, got %+v", testProject.ID, service)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify import by project ID
+			{
+				ResourceName:      "gitlab_service_microsoft_teams.this",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%d", testProject.ID),
+				ImportStateVerify: true,
+				// GitLab may mask the webhook when reading the integration
+				ImportStateVerifyIgnore: []string{"webhook"},
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabServiceMicrosoftTeamsExists(n string, service *gitlab.MicrosoftTeamsService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
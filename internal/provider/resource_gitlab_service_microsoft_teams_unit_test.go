@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGitlab_resourceGitlabServiceMicrosoftTeamsRead_maskedWebhook(t *testing.T) {
+	cases := []struct {
+		Name            string
+		StateWebhook    string
+		ReadWebhook     string
+		ExpectedWebhook string
+	}{
+		{
+			Name:            "masked webhook of an imported integration",
+			StateWebhook:    "",
+			ReadWebhook:     "************",
+			ExpectedWebhook: "",
+		},
+		{
+			Name:            "masked webhook set by the provider",
+			StateWebhook:    "https://test.com/?token=4",
+			ReadWebhook:     "************",
+			ExpectedWebhook: "https://test.com/?token=4",
+		},
+		{
+			Name:            "unmasked webhook",
+			StateWebhook:    "https://test.com/?token=4",
+			ReadWebhook:     "https://test.com/?token=5",
+			ExpectedWebhook: "https://test.com/?token=5",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/projects/42/services/microsoft-teams", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = fmt.Fprintf(w, `{"id": 1, "created_at": "2023-03-10T12:00:00Z", "active": true, "properties": {"webhook": %q}}`, tc.ReadWebhook)
+			})
+			client := newTestGitlabClient(t, mux)
+
+			d := schema.TestResourceDataRaw(t, allResources["gitlab_service_microsoft_teams"]().Schema, map[string]interface{}{
+				"project": "42",
+				"webhook": tc.StateWebhook,
+			})
+			d.SetId("42")
+
+			if diags := resourceGitlabServiceMicrosoftTeamsRead(context.Background(), d, &ProviderMeta{Client: client}); diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+
+			if webhook := d.Get("webhook").(string); webhook != tc.ExpectedWebhook {
+				t.Fatalf("got webhook %q, expected %q", webhook, tc.ExpectedWebhook)
+			}
+		})
+	}
+}
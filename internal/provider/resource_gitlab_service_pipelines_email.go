@@ -17,10 +17,10 @@ var _ = registerResource("gitlab_service_pipelines_email", func() *schema.Resour
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#pipeline-emails)`,
 
-		CreateContext: resourceGitlabServicePipelinesEmailCreate,
+		CreateContext: resourceGitlabServiceSet("pipelines email", resourceGitlabServicePipelinesEmailSet, resourceGitlabServicePipelinesEmailRead),
 		ReadContext:   resourceGitlabServicePipelinesEmailRead,
-		UpdateContext: resourceGitlabServicePipelinesEmailCreate,
-		DeleteContext: resourceGitlabServicePipelinesEmailDelete,
+		UpdateContext: resourceGitlabServiceSet("pipelines email", resourceGitlabServicePipelinesEmailSet, resourceGitlabServicePipelinesEmailRead),
+		DeleteContext: resourceGitlabServiceDelete("pipelines email", (*gitlab.ServicesService).DeletePipelinesEmailService),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -33,10 +33,13 @@ var _ = registerResource("gitlab_service_pipelines_email", func() *schema.Resour
 				ForceNew:    true,
 			},
 			"recipients": {
-				Description: ") email addresses where notifications are sent.",
+				Description: "Email addresses where notifications are sent.",
 				Type:        schema.TypeSet,
 				Required:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateEmailFunc,
+				},
 			},
 			"notify_only_broken_pipelines": {
 				Description: "Notify only broken pipelines. Default is true.",
@@ -44,6 +47,13 @@ var _ = registerResource("gitlab_service_pipelines_email", func() *schema.Resour
 				Optional:    true,
 				Default:     true,
 			},
+			"notify_only_default_branch": {
+				Description: "Notify only for the default branch. This parameter has been replaced with `branches_to_be_notified`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Deprecated:  "use 'branches_to_be_notified' argument instead",
+			},
 			"branches_to_be_notified": {
 				Description:  "Branches to send notifications for. Valid options are `all`, `default`, `protected`, and `default_and_protected`. Default is `default`",
 				Type:         schema.TypeString,
@@ -58,31 +68,27 @@ var _ = registerResource("gitlab_service_pipelines_email", func() *schema.Resour
 func resourceGitlabServicePipelinesEmailSetToState(d *schema.ResourceData, service *gitlab.PipelinesEmailService) {
 	d.Set("recipients", strings.Split(service.Properties.Recipients, ",")) // lintignore: XR004 // TODO: Resolve this tfproviderlint issue
 	d.Set("notify_only_broken_pipelines", service.Properties.NotifyOnlyBrokenPipelines)
+	d.Set("notify_only_default_branch", service.Properties.NotifyOnlyDefaultBranch)
 	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
 }
 
-func resourceGitlabServicePipelinesEmailCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Get("project").(string)
-	d.SetId(project)
+func resourceGitlabServicePipelinesEmailSet(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
 	options := &gitlab.SetPipelinesEmailServiceOptions{
 		Recipients:                gitlab.String(strings.Join(*stringSetToStringSlice(d.Get("recipients").(*schema.Set)), ",")),
 		NotifyOnlyBrokenPipelines: gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool)),
 		BranchesToBeNotified:      gitlab.String(d.Get("branches_to_be_notified").(string)),
 	}
-
-	log.Printf("[DEBUG] create gitlab pipelines emails service for project %s", project)
-
-	_, err := client.Services.SetPipelinesEmailService(project, options, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
+	// The deprecated option is only sent when configured, so that it doesn't interfere with `branches_to_be_notified`.
+	if d.HasChange("notify_only_default_branch") {
+		options.NotifyOnlyDefaultBranch = gitlab.Bool(d.Get("notify_only_default_branch").(bool))
 	}
 
-	return resourceGitlabServicePipelinesEmailRead(ctx, d, meta)
+	_, _, err := client.Services.SetPipelinesEmailService(project, options, gitlab.WithContext(ctx))
+	return err
 }
 
 func resourceGitlabServicePipelinesEmailRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Id()
 
 	log.Printf("[DEBUG] read gitlab pipelines emails service for project %s", project)
@@ -101,17 +107,3 @@ func resourceGitlabServicePipelinesEmailRead(ctx context.Context, d *schema.Reso
 	resourceGitlabServicePipelinesEmailSetToState(d, service)
 	return nil
 }
-
-func resourceGitlabServicePipelinesEmailDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Id()
-
-	log.Printf("[DEBUG] delete gitlab pipelines email service for project %s", project)
-
-	_, err := client.Services.DeletePipelinesEmailService(project, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
@@ -76,6 +77,24 @@ func TestAccGitlabServicePipelinesEmail_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabServicePipelinesEmail_invalidRecipient(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "gitlab_service_pipelines_email" "email" {
+						project    = "foo"
+						recipients = ["test@example.com", "not-an-email"]
+					}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`not-an-email is not a valid email address`),
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabServicePipelinesEmailExists(n string, service *gitlab.PipelinesEmailService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
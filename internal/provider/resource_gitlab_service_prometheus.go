@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+const (
+	prometheusConfigurationTypeManual  = "manual"
+	prometheusConfigurationTypeManaged = "managed"
+)
+
+var _ = registerResource("gitlab_service_prometheus", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_service_prometheus`" + ` resource allows to manage the lifecycle of a project integration with Prometheus.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#prometheus)`,
+
+		CreateContext: resourceGitlabServiceSet("prometheus", resourceGitlabServicePrometheusSet, resourceGitlabServicePrometheusRead),
+		ReadContext:   resourceGitlabServicePrometheusRead,
+		UpdateContext: resourceGitlabServiceSet("prometheus", resourceGitlabServicePrometheusSet, resourceGitlabServicePrometheusRead),
+		DeleteContext: resourceGitlabServiceDelete("prometheus", (*gitlab.ServicesService).DeletePrometheusService),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Description:  "ID of the project you want to activate integration on.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"api_url": {
+				Description:  "The Prometheus API base URL, like `http://prometheus.example.com/`. Required if `manual_configuration` is `true`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURLFunc,
+			},
+			"manual_configuration": {
+				Description: "Whether the Prometheus integration is configured manually with the given `api_url`. Set to `false` to use the Prometheus instance managed by GitLab on a Kubernetes cluster. Defaults to `true`.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"configuration_type": {
+				Description: fmt.Sprintf("How the Prometheus integration is configured, one of %s.", renderValueListForDocs([]string{prometheusConfigurationTypeManual, prometheusConfigurationTypeManaged})),
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"google_iap_audience_client_id": {
+				Description: "The ID of the IAP secured resource, like `IAP_CLIENT_ID.apps.googleusercontent.com`, if Prometheus is protected by Google Cloud Identity-Aware Proxy.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"google_iap_service_account_json": {
+				Description: "The contents of the credentials JSON file of the Google Cloud service account, if Prometheus is protected by Google Cloud Identity-Aware Proxy. GitLab never returns the credentials, so changes made outside of Terraform are not detected.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"created_at": {
+				Description: "The ISO8601 date/time that this integration was activated at in UTC.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The ISO8601 date/time that this integration was last updated at in UTC.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"active": {
+				Description: "Whether the integration is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: resourceGitlabServicePrometheusCustomizeDiff,
+	}
+})
+
+// resourceGitlabServicePrometheusCustomizeDiff requires the `api_url` for a manually configured Prometheus integration.
+// Without the manual configuration, the Prometheus instance managed by GitLab is used, which doesn't need a URL.
+func resourceGitlabServicePrometheusCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("manual_configuration") || !d.NewValueKnown("api_url") {
+		return nil
+	}
+	if d.Get("manual_configuration").(bool) && d.Get("api_url").(string) == "" {
+		return errors.New("`api_url` is required if `manual_configuration` is `true`")
+	}
+	return nil
+}
+
+// gitlabPrometheusService extends the go-gitlab Prometheus service with the `manual_configuration` property,
+// which go-gitlab doesn't implement.
+type gitlabPrometheusService struct {
+	gitlab.Service
+	Properties struct {
+		gitlab.PrometheusServiceProperties
+		ManualConfiguration gitlab.BoolValue `json:"manual_configuration"`
+	} `json:"properties"`
+}
+
+// gitlabSetPrometheusServiceOptions extends the go-gitlab options to set the Prometheus service
+// with the `manual_configuration` property, which go-gitlab doesn't implement.
+type gitlabSetPrometheusServiceOptions struct {
+	gitlab.SetPrometheusServiceOptions
+	ManualConfiguration *bool `json:"manual_configuration,omitempty"`
+}
+
+func resourceGitlabServicePrometheusSet(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
+	options := &gitlabSetPrometheusServiceOptions{
+		SetPrometheusServiceOptions: gitlab.SetPrometheusServiceOptions{
+			APIURL:                      gitlab.String(d.Get("api_url").(string)),
+			GoogleIAPAudienceClientID:   gitlab.String(d.Get("google_iap_audience_client_id").(string)),
+			GoogleIAPServiceAccountJSON: gitlab.String(d.Get("google_iap_service_account_json").(string)),
+		},
+		ManualConfiguration: gitlab.Bool(d.Get("manual_configuration").(bool)),
+	}
+
+	req, err := client.NewRequest(http.MethodPut, fmt.Sprintf("projects/%s/services/prometheus", gitlab.PathEscape(project)), options, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return err
+	}
+	_, err = client.Do(req, nil)
+	return err
+}
+
+func resourceGitlabServicePrometheusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	project := d.Id()
+
+	log.Printf("[DEBUG] read gitlab prometheus service for project %s", project)
+
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%s/services/prometheus", gitlab.PathEscape(project)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	service := new(gitlabPrometheusService)
+	if _, err := client.Do(req, service); err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab prometheus service not found for project %s", project)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set("project", project)
+	d.Set("api_url", service.Properties.APIURL)
+	d.Set("manual_configuration", bool(service.Properties.ManualConfiguration))
+	if service.Properties.ManualConfiguration {
+		d.Set("configuration_type", prometheusConfigurationTypeManual)
+	} else {
+		d.Set("configuration_type", prometheusConfigurationTypeManaged)
+	}
+	d.Set("google_iap_audience_client_id", service.Properties.GoogleIAPAudienceClientID)
+	d.Set("active", service.Active)
+	if service.CreatedAt != nil {
+		d.Set("created_at", service.CreatedAt.Format(time.RFC3339))
+	}
+	if service.UpdatedAt != nil {
+		d.Set("updated_at", service.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabServicePrometheus_manual(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServicePrometheusDestroy,
+		Steps: []resource.TestStep{
+			// A manually configured Prometheus service requires the API URL
+			{
+				Config: fmt.Sprintf(`
+resource "gitlab_service_prometheus" "this" {
+  project              = %d
+  manual_configuration = true
+}
+`, testProject.ID),
+				ExpectError: regexp.MustCompile("`api_url` is required if `manual_configuration` is `true`"),
+			},
+			// Create a manually configured Prometheus service
+			{
+				Config: testAccGitlabServicePrometheusConfig(testProject.ID, "https://prometheus.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_prometheus.this", "api_url", "https://prometheus.example.com"),
+					resource.TestCheckResourceAttr("gitlab_service_prometheus.this", "manual_configuration", "true"),
+					resource.TestCheckResourceAttr("gitlab_service_prometheus.this", "configuration_type", "manual"),
+					resource.TestCheckResourceAttr("gitlab_service_prometheus.this", "active", "true"),
+					resource.TestCheckResourceAttrSet("gitlab_service_prometheus.this", "created_at"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_prometheus.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update the API URL
+			{
+				Config: testAccGitlabServicePrometheusConfig(testProject.ID, "https://prometheus2.example.com"),
+				Check:  resource.TestCheckResourceAttr("gitlab_service_prometheus.this", "api_url", "https://prometheus2.example.com"),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_prometheus.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabServicePrometheusDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_service_prometheus" {
+			continue
+		}
+
+		service, _, err := testGitlabClient.Services.GetPrometheusService(rs.Primary.ID)
+		if err == nil {
+			if service != nil && service.Active {
+				return fmt.Errorf("Prometheus service of project %s still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		if !is404(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func testAccGitlabServicePrometheusConfig(projectID int, apiURL string) string {
+	return fmt.Sprintf(`
+resource "gitlab_service_prometheus" "this" {
+  project              = %d
+  api_url              = "%s"
+  manual_configuration = true
+}
+`, projectID, apiURL)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestGitlab_resourceGitlabServicePrometheusCustomizeDiff(t *testing.T) {
+	cases := []struct {
+		Name                string
+		APIURL              cty.Value
+		ManualConfiguration cty.Value
+		ExpectedError       bool
+	}{
+		{
+			Name:                "manual configuration with API URL",
+			APIURL:              cty.StringVal("https://prometheus.example.com"),
+			ManualConfiguration: cty.True,
+		},
+		{
+			Name:                "manual configuration without API URL",
+			APIURL:              cty.NullVal(cty.String),
+			ManualConfiguration: cty.True,
+			ExpectedError:       true,
+		},
+		{
+			Name:                "managed configuration without API URL",
+			APIURL:              cty.NullVal(cty.String),
+			ManualConfiguration: cty.False,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := allResources["gitlab_service_prometheus"]()
+			coreSchema := r.CoreConfigSchema()
+			attributes := map[string]cty.Value{}
+			for name, attribute := range coreSchema.Attributes {
+				attributes[name] = cty.NullVal(attribute.Type)
+			}
+			attributes["project"] = cty.StringVal("42")
+			attributes["api_url"] = tc.APIURL
+			attributes["manual_configuration"] = tc.ManualConfiguration
+			rawConfig := cty.ObjectVal(attributes)
+
+			_, err := r.Diff(context.Background(), &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigShimmed(rawConfig, coreSchema), &ProviderMeta{})
+			if tc.ExpectedError && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tc.ExpectedError && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -15,10 +15,10 @@ var _ = registerResource("gitlab_service_slack", func() *schema.Resource {
 
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/integrations.html#slack-notifications)`,
 
-		CreateContext: resourceGitlabServiceSlackCreate,
+		CreateContext: resourceGitlabServiceSet("slack", resourceGitlabServiceSlackSet, resourceGitlabServiceSlackRead),
 		ReadContext:   resourceGitlabServiceSlackRead,
-		UpdateContext: resourceGitlabServiceSlackUpdate,
-		DeleteContext: resourceGitlabServiceSlackDelete,
+		UpdateContext: resourceGitlabServiceSet("slack", resourceGitlabServiceSlackSet, resourceGitlabServiceSlackRead),
+		DeleteContext: resourceGitlabServiceDelete("slack", (*gitlab.ServicesService).DeleteSlackService),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -40,6 +40,11 @@ var _ = registerResource("gitlab_service_slack", func() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"channel": {
+				Description: "The default channel to receive notifications in. Used for all events which have no dedicated channel configured.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"notify_only_broken_pipelines": {
 				Description: "Send notifications for broken pipelines.",
 				Type:        schema.TypeBool,
@@ -59,6 +64,17 @@ var _ = registerResource("gitlab_service_slack", func() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"alert_channel": {
+				Description: "The name of the channel to receive alert events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"alert_events": {
+				Description: "Enable notifications for alert events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 			// TODO: Currently, go-gitlab doesn't implement this option yet.
 			//       see https://github.com/xanzy/go-gitlab/issues/1354
 			// "commit_events": {
@@ -78,34 +94,28 @@ var _ = registerResource("gitlab_service_slack", func() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
-			// TODO: Currently, GitLab ignores this option (not implemented yet?), so
-			// there is no way to set it. Uncomment when this is fixed.
-			// See: https://gitlab.com/gitlab-org/gitlab-ce/issues/49730
-			// "confidential_note_channel": {
-			// 	Description: "The name of the channel to receive confidential note events notifications.",
-			// 	Type:        schema.TypeString,
-			// 	Optional:    true,
-			// },
+			"confidential_note_channel": {
+				Description: "The name of the channel to receive confidential note events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"confidential_note_events": {
 				Description: "Enable notifications for confidential note events.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Computed:    true,
 			},
-			// TODO: Currently, GitLab doesn't correctly implement the API, so this is
-			//       impossible to implement here at the moment.
-			//       see https://gitlab.com/gitlab-org/gitlab/-/issues/28903
-			// "deployment_channel": {
-			// 	Description: "The name of the channel to receive deployment events notifications.",
-			// 	Type:        schema.TypeString,
-			// 	Optional:    true,
-			// },
-			// "deployment_events": {
-			// 	Description: "Enable notifications for deployment events.",
-			// 	Type:        schema.TypeBool,
-			// 	Optional:    true,
-			// 	Computed:    true,
-			// },
+			"deployment_channel": {
+				Description: "The name of the channel to receive deployment events notifications.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"deployment_events": {
+				Description: "Enable notifications for deployment events.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 			"issue_channel": {
 				Description: "The name of the channel to receive issue events notifications.",
 				Type:        schema.TypeString,
@@ -194,36 +204,27 @@ var _ = registerResource("gitlab_service_slack", func() *schema.Resource {
 	}
 })
 
-func resourceGitlabServiceSlackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Get("project").(string)
-	d.SetId(project)
-
-	log.Printf("[DEBUG] create gitlab slack service for project %s", project)
-
+func resourceGitlabServiceSlackSet(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error {
 	opts := &gitlab.SetSlackServiceOptions{
 		WebHook: gitlab.String(d.Get("webhook").(string)),
 	}
 
 	opts.Username = gitlab.String(d.Get("username").(string))
+	opts.Channel = gitlab.String(d.Get("channel").(string))
 	opts.NotifyOnlyBrokenPipelines = gitlab.Bool(d.Get("notify_only_broken_pipelines").(bool))
 	opts.NotifyOnlyDefaultBranch = gitlab.Bool(d.Get("notify_only_default_branch").(bool))
 	opts.BranchesToBeNotified = gitlab.String(d.Get("branches_to_be_notified").(string))
+	opts.AlertChannel = gitlab.String(d.Get("alert_channel").(string))
+	opts.AlertEvents = gitlab.Bool(d.Get("alert_events").(bool))
 	// TODO: Currently, go-gitlab doesn't implement this option yet.
 	//       see https://github.com/xanzy/go-gitlab/issues/1354
 	// opts.CommitEvents = gitlab.Bool(d.Get("commit_events").(bool))
 	opts.ConfidentialIssueChannel = gitlab.String(d.Get("confidential_issue_channel").(string))
 	opts.ConfidentialIssuesEvents = gitlab.Bool(d.Get("confidential_issues_events").(bool))
-	// TODO: Currently, GitLab ignores this option (not implemented yet?), so
-	// there is no way to set it. Uncomment when this is fixed.
-	// See: https://gitlab.com/gitlab-org/gitlab-ce/issues/49730
-	// opts.ConfidentialNoteChannel = gitlab.String(d.Get("confidential_note_channel").(string))
+	opts.ConfidentialNoteChannel = gitlab.String(d.Get("confidential_note_channel").(string))
 	opts.ConfidentialNoteEvents = gitlab.Bool(d.Get("confidential_note_events").(bool))
-	// TODO: Currently, GitLab doesn't correctly implement the API, so this is
-	//       impossible to implement here at the moment.
-	//       see https://gitlab.com/gitlab-org/gitlab/-/issues/28903
-	// opts.DeploymentChannel = gitlab.String(d.Get("deployment_channel").(string))
-	// opts.DeploymentEvents = gitlab.Bool(d.Get("deployment_events").(bool))
+	opts.DeploymentChannel = gitlab.String(d.Get("deployment_channel").(string))
+	opts.DeploymentEvents = gitlab.Bool(d.Get("deployment_events").(bool))
 	opts.IssueChannel = gitlab.String(d.Get("issue_channel").(string))
 	opts.IssuesEvents = gitlab.Bool(d.Get("issues_events").(bool))
 	// TODO: Currently, go-gitlab doesn't implement this option yet.
@@ -242,16 +243,12 @@ func resourceGitlabServiceSlackCreate(ctx context.Context, d *schema.ResourceDat
 	opts.WikiPageChannel = gitlab.String(d.Get("wiki_page_channel").(string))
 	opts.WikiPageEvents = gitlab.Bool(d.Get("wiki_page_events").(bool))
 
-	_, err := client.Services.SetSlackService(project, opts, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceGitlabServiceSlackRead(ctx, d, meta)
+	_, _, err := client.Services.SetSlackService(project, opts, gitlab.WithContext(ctx))
+	return err
 }
 
 func resourceGitlabServiceSlackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	id := d.Id()
 	project := d.Get("project").(string)
 	if id != project && project != "" {
@@ -276,21 +273,18 @@ func resourceGitlabServiceSlackRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("project", project)
 	d.Set("webhook", service.Properties.WebHook)
 	d.Set("username", service.Properties.Username)
+	d.Set("channel", service.Properties.Channel)
 	d.Set("notify_only_broken_pipelines", bool(service.Properties.NotifyOnlyBrokenPipelines))
 	d.Set("notify_only_default_branch", bool(service.Properties.NotifyOnlyDefaultBranch))
 	d.Set("branches_to_be_notified", service.Properties.BranchesToBeNotified)
+	d.Set("alert_channel", service.Properties.AlertChannel)
+	d.Set("alert_events", service.AlertEvents)
 	d.Set("confidential_issue_channel", service.Properties.ConfidentialIssueChannel)
 	d.Set("confidential_issues_events", service.ConfidentialIssuesEvents)
-	// TODO: Currently, GitLab ignores this option (not implemented yet?), so
-	// there is no way to set it. Uncomment when this is fixed.
-	// See: https://gitlab.com/gitlab-org/gitlab-ce/issues/49730
-	// d.Set("confidential_note_channel", service.Properties.ConfidentialNoteChannel)
+	d.Set("confidential_note_channel", service.Properties.ConfidentialNoteChannel)
 	d.Set("confidential_note_events", service.ConfidentialNoteEvents)
-	// TODO: Currently, GitLab doesn't correctly implement the API, so this is
-	//       impossible to implement here at the moment.
-	//       see https://gitlab.com/gitlab-org/gitlab/-/issues/28903
-	// d.Set("deployment_channel", service.Properties.DeploymentChannel)
-	// d.Set("deployment_events", service.DeploymentEvents)
+	d.Set("deployment_channel", service.Properties.DeploymentChannel)
+	d.Set("deployment_events", service.DeploymentEvents)
 	d.Set("issue_channel", service.Properties.IssueChannel)
 	d.Set("issues_events", service.IssuesEvents)
 	// TODO: Currently, go-gitlab doesn't implement this option yet.
@@ -311,21 +305,3 @@ func resourceGitlabServiceSlackRead(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
-
-func resourceGitlabServiceSlackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return resourceGitlabServiceSlackCreate(ctx, d, meta)
-}
-
-func resourceGitlabServiceSlackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	project := d.Id()
-
-	log.Printf("[DEBUG] delete gitlab slack service for project %s", project)
-
-	_, err := client.Services.DeleteSlackService(project, gitlab.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
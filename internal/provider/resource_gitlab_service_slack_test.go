@@ -48,11 +48,8 @@ func TestAccGitlabServiceSlack_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(slackResourceName, "webhook", "https://test.com"),
 					resource.TestCheckResourceAttr(slackResourceName, "push_events", "true"),
 					resource.TestCheckResourceAttr(slackResourceName, "push_channel", "test"),
-					// TODO: Currently, GitLab doesn't correctly implement the API, so this is
-					//       impossible to implement here at the moment.
-					//       see https://gitlab.com/gitlab-org/gitlab/-/issues/28903
-					// resource.TestCheckResourceAttr(slackResourceName, "deployment_events", "true"),
-					// resource.TestCheckResourceAttr(slackResourceName, "deployment_channel", "test"),
+					resource.TestCheckResourceAttr(slackResourceName, "deployment_events", "true"),
+					resource.TestCheckResourceAttr(slackResourceName, "deployment_channel", "test"),
 					resource.TestCheckResourceAttr(slackResourceName, "notify_only_broken_pipelines", "true"),
 				),
 			},
@@ -132,6 +129,67 @@ func TestAccGitlabServiceSlack_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabServiceSlack_perEventChannels(t *testing.T) {
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabServiceSlackDestroy,
+		Steps: []resource.TestStep{
+			// Send each event to its own channel and disable push notifications
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_service_slack" "this" {
+						project                    = "%d"
+						webhook                    = "https://test.com"
+						channel                    = "general"
+						push_events                = false
+						alert_events               = true
+						alert_channel              = "alerts"
+						confidential_note_events   = true
+						confidential_note_channel  = "confidential-notes"
+						deployment_events          = true
+						deployment_channel         = "deployments"
+						merge_requests_events      = true
+						merge_request_channel      = "merge-requests"
+						pipeline_events            = true
+						pipeline_channel           = "pipelines"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "channel", "general"),
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "push_events", "false"),
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "alert_channel", "alerts"),
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "confidential_note_channel", "confidential-notes"),
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "deployment_channel", "deployments"),
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "merge_request_channel", "merge-requests"),
+					resource.TestCheckResourceAttr("gitlab_service_slack.this", "pipeline_channel", "pipelines"),
+					func(s *terraform.State) error {
+						service, _, err := testGitlabClient.Services.GetSlackService(testProject.ID)
+						if err != nil {
+							return err
+						}
+						if service.PushEvents {
+							return fmt.Errorf("expected push events to be disabled for the slack service of project %d", testProject.ID)
+						}
+						return nil
+					},
+				),
+			},
+			// Verify import
+			{
+				ResourceName:      "gitlab_service_slack.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"notify_only_broken_pipelines",
+					"notify_only_default_branch",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabServiceExists(n string, service *gitlab.SlackService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -229,11 +287,8 @@ resource "gitlab_service_slack" "slack" {
   confidential_issues_events   = true
   confidential_issue_channel   = "test"
   confidential_note_events     = true
-// TODO: Currently, GitLab doesn't correctly implement the API, so this is
-//       impossible to implement here at the moment.
-//       see https://gitlab.com/gitlab-org/gitlab/-/issues/28903
-//   deployment_channel           = "test"
-//   deployment_events            = true
+  deployment_channel           = "test"
+  deployment_events            = true
   merge_requests_events        = true
   merge_request_channel        = "test"
   tag_push_events              = true
@@ -272,11 +327,8 @@ resource "gitlab_service_slack" "slack" {
   confidential_issues_events   = false
   confidential_issue_channel   = "test confidential_issue_channel"
   confidential_note_events     = false
-// TODO: Currently, GitLab doesn't correctly implement the API, so this is
-//       impossible to implement here at the moment.
-//       see https://gitlab.com/gitlab-org/gitlab/-/issues/28903
-//   deployment_channel           = "test deployment_channel"
-//   deployment_events            = false
+  deployment_channel           = "test deployment_channel"
+  deployment_events            = false
   merge_requests_events        = false
   merge_request_channel        = "test merge_request_channel"
   tag_push_events              = false
@@ -81,7 +81,7 @@ var _ = registerResource("gitlab_system_hook", func() *schema.Resource {
 })
 
 func resourceGitlabSystemHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.AddHookOptions{
 		URL: gitlab.String(d.Get("url").(string)),
@@ -133,7 +133,7 @@ func resourceGitlabSystemHookCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabSystemHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	hookID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -161,7 +161,7 @@ func resourceGitlabSystemHookRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabSystemHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	hookID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
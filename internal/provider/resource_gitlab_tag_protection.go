@@ -15,6 +15,8 @@ var _ = registerResource("gitlab_tag_protection", func() *schema.Resource {
 	return &schema.Resource{
 		Description: `The ` + "`" + `gitlab_tag_protection` + "`" + ` resource allows to manage the lifecycle of a tag protection.
 
+~> The ` + "`allowed_to_create`" + ` attribute requires a GitLab Enterprise instance.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/protected_tags.html)`,
 
 		CreateContext: resourceGitlabTagProtectionCreate,
@@ -44,19 +46,29 @@ var _ = registerResource("gitlab_tag_protection", func() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 			},
+			"allowed_to_create": {
+				Description: "User or group which are allowed to create, in addition to the `create_access_level`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        allowedToElem,
+			},
 		},
 	}
 })
 
 func resourceGitlabTagProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	tag := gitlab.String(d.Get("tag").(string))
 	createAccessLevel := tagProtectionAccessLevelID[d.Get("create_access_level").(string)]
 
+	allowedToCreate := expandTagPermissionOptions(d.Get("allowed_to_create").(*schema.Set).List())
+
 	options := &gitlab.ProtectRepositoryTagsOptions{
 		Name:              tag,
 		CreateAccessLevel: &createAccessLevel,
+		AllowedToCreate:   &allowedToCreate,
 	}
 
 	log.Printf("[DEBUG] create gitlab tag protection on %v for project %s", options.Name, project)
@@ -81,7 +93,7 @@ func resourceGitlabTagProtectionCreate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceGitlabTagProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project, tag, err := projectAndTagFromID(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
@@ -99,14 +111,21 @@ func resourceGitlabTagProtectionRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	accessLevel, ok := tagProtectionAccessLevelNames[pt.CreateAccessLevels[0].AccessLevel]
+	createAccessLevel, err := firstValidTagAccessLevel(pt.CreateAccessLevels)
+	if err != nil {
+		return diag.Errorf("failed to find the create access level of tag protection %s/%s: %v", project, tag, err)
+	}
+	accessLevel, ok := tagProtectionAccessLevelNames[*createAccessLevel]
 	if !ok {
-		return diag.Errorf("tag protection access level %d is not supported. Supported are: %v", pt.CreateAccessLevels[0].AccessLevel, tagProtectionAccessLevelNames)
+		return diag.Errorf("tag protection access level %d is not supported. Supported are: %v", *createAccessLevel, tagProtectionAccessLevelNames)
 	}
 
 	d.Set("project", project)
 	d.Set("tag", pt.Name)
 	d.Set("create_access_level", accessLevel)
+	if err := d.Set("allowed_to_create", flattenNonZeroTagAccessDescriptions(pt.CreateAccessLevels)); err != nil {
+		return diag.Errorf("error setting allowed_to_create: %v", err)
+	}
 
 	d.SetId(buildTwoPartID(&project, &pt.Name))
 
@@ -114,14 +133,14 @@ func resourceGitlabTagProtectionRead(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGitlabTagProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	project := d.Get("project").(string)
 	tag := d.Get("tag").(string)
 
 	log.Printf("[DEBUG] Delete gitlab protected tag %s for project %s", tag, project)
 
 	_, err := client.ProtectedTags.UnprotectRepositoryTags(project, tag, gitlab.WithContext(ctx))
-	if err != nil {
+	if err != nil && !is404(err) {
 		return diag.FromErr(err)
 	}
 
@@ -132,7 +151,51 @@ func projectAndTagFromID(id string) (string, string, error) {
 	project, tag, err := parseTwoPartID(id)
 
 	if err != nil {
-		log.Printf("[WARN] cannot get group member id from input: %v", id)
+		log.Printf("[WARN] cannot get tag protection id from input: %v", id)
 	}
 	return project, tag, err
 }
+
+func expandTagPermissionOptions(allowedTo []interface{}) []*gitlab.TagsPermissionOptions {
+	result := make([]*gitlab.TagsPermissionOptions, 0)
+	for _, v := range allowedTo {
+		opt := &gitlab.TagsPermissionOptions{}
+		if userID, ok := v.(map[string]interface{})["user_id"]; ok && userID != 0 {
+			opt.UserID = gitlab.Int(userID.(int))
+		}
+		if groupID, ok := v.(map[string]interface{})["group_id"]; ok && groupID != 0 {
+			opt.GroupID = gitlab.Int(groupID.(int))
+		}
+		result = append(result, opt)
+	}
+	return result
+}
+
+func firstValidTagAccessLevel(descriptions []*gitlab.TagAccessDescription) (*gitlab.AccessLevelValue, error) {
+	for _, description := range descriptions {
+		if description.UserID != 0 || description.GroupID != 0 {
+			continue
+		}
+		return &description.AccessLevel, nil
+	}
+
+	return nil, fmt.Errorf("no valid access level found")
+}
+
+// flattenNonZeroTagAccessDescriptions flattens the list of tag access descriptions for the tf state.
+// only descriptions with non-zero user id and group id are included in the tf state.
+func flattenNonZeroTagAccessDescriptions(descriptions []*gitlab.TagAccessDescription) (values []map[string]interface{}) {
+	for _, description := range descriptions {
+		if description.UserID == 0 && description.GroupID == 0 {
+			continue
+		}
+		values = append(values, map[string]interface{}{
+			"access_level":             accessLevelValueToName[description.AccessLevel],
+			"access_level_description": description.AccessLevelDescription,
+			"user_id":                  description.UserID,
+			"group_id":                 description.GroupID,
+		})
+	}
+
+	return values
+}
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -135,6 +136,77 @@ func TestAccGitlabTagProtection_wildcard(t *testing.T) {
 	})
 }
 
+func TestAccGitlabTagProtection_allowedToCreate(t *testing.T) {
+	testProject := testAccCreateProject(t)
+	testUser := testAccCreateUsers(t, 1)[0]
+	testAccAddProjectMembers(t, testProject.ID, []*gitlab.User{testUser})
+	var pt gitlab.ProtectedTag
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabTagProtectionDestroy,
+		Steps: []resource.TestStep{
+			// Only allow maintainers to create version tags
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_tag_protection" "this" {
+						project             = "%d"
+						tag                 = "v*"
+						create_access_level = "maintainer"
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabTagProtectionExists("gitlab_tag_protection.this", &pt),
+					resource.TestCheckResourceAttr("gitlab_tag_protection.this", "tag", "v*"),
+					resource.TestCheckResourceAttr("gitlab_tag_protection.this", "allowed_to_create.#", "0"),
+					testAccCheckGitlabTagProtectionAttributes(&pt, &testAccGitlabTagProtectionExpectedAttributes{
+						Name:              "v*",
+						CreateAccessLevel: accessLevelValueToName[gitlab.MaintainerPermissions],
+					}),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_tag_protection.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Additionally allow a user to create version tags
+			{
+				SkipFunc: isRunningInCE,
+				Config: fmt.Sprintf(`
+					resource "gitlab_tag_protection" "this" {
+						project             = "%d"
+						tag                 = "v*"
+						create_access_level = "maintainer"
+
+						allowed_to_create {
+							user_id = %d
+						}
+					}
+				`, testProject.ID, testUser.ID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabTagProtectionExists("gitlab_tag_protection.this", &pt),
+					resource.TestCheckResourceAttr("gitlab_tag_protection.this", "allowed_to_create.#", "1"),
+					resource.TestCheckResourceAttr("gitlab_tag_protection.this", "allowed_to_create.0.user_id", fmt.Sprintf("%d", testUser.ID)),
+					testAccCheckGitlabTagProtectionAttributes(&pt, &testAccGitlabTagProtectionExpectedAttributes{
+						Name:                 "v*",
+						CreateAccessLevel:    accessLevelValueToName[gitlab.MaintainerPermissions],
+						UsersAllowedToCreate: []int{testUser.ID},
+					}),
+				),
+			},
+			// Verify Import
+			{
+				SkipFunc:          isRunningInCE,
+				ResourceName:      "gitlab_tag_protection.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGitlabTagProtectionExists(n string, pt *gitlab.ProtectedTag) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -161,8 +233,9 @@ func testAccCheckGitlabTagProtectionExists(n string, pt *gitlab.ProtectedTag) re
 }
 
 type testAccGitlabTagProtectionExpectedAttributes struct {
-	Name              string
-	CreateAccessLevel string
+	Name                 string
+	CreateAccessLevel    string
+	UsersAllowedToCreate []int
 }
 
 func testAccCheckGitlabTagProtectionAttributes(pt *gitlab.ProtectedTag, want *testAccGitlabTagProtectionExpectedAttributes) resource.TestCheckFunc {
@@ -171,8 +244,22 @@ func testAccCheckGitlabTagProtectionAttributes(pt *gitlab.ProtectedTag, want *te
 			return fmt.Errorf("got name %q; want %q", pt.Name, want.Name)
 		}
 
-		if pt.CreateAccessLevels[0].AccessLevel != accessLevelNameToValue[want.CreateAccessLevel] {
-			return fmt.Errorf("got Create access levels %q; want %q", pt.CreateAccessLevels[0].AccessLevel, accessLevelNameToValue[want.CreateAccessLevel])
+		createAccessLevel, err := firstValidTagAccessLevel(pt.CreateAccessLevels)
+		if err != nil {
+			return err
+		}
+		if *createAccessLevel != accessLevelNameToValue[want.CreateAccessLevel] {
+			return fmt.Errorf("got Create access levels %q; want %q", *createAccessLevel, accessLevelNameToValue[want.CreateAccessLevel])
+		}
+
+		var usersAllowedToCreate []int
+		for _, v := range pt.CreateAccessLevels {
+			if v.UserID != 0 {
+				usersAllowedToCreate = append(usersAllowedToCreate, v.UserID)
+			}
+		}
+		if !reflect.DeepEqual(usersAllowedToCreate, want.UsersAllowedToCreate) {
+			return fmt.Errorf("got users allowed to create %v; want %v", usersAllowedToCreate, want.UsersAllowedToCreate)
 		}
 
 		return nil
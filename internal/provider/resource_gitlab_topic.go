@@ -85,8 +85,8 @@ var _ = registerResource("gitlab_topic", func() *schema.Resource {
 })
 
 func resourceGitlabTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	if err := resourceGitlabTopicEnsureTitleSupport(ctx, client, d); err != nil {
+	client := meta.(*ProviderMeta).Client
+	if err := resourceGitlabTopicEnsureTitleSupport(ctx, meta.(*ProviderMeta), d); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -122,7 +122,7 @@ func resourceGitlabTopicCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	topicID, err := strconv.Atoi(d.Id())
 	if err != nil {
@@ -149,9 +149,9 @@ func resourceGitlabTopicRead(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceGitlabTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	options := &gitlab.UpdateTopicOptions{}
-	if err := resourceGitlabTopicEnsureTitleSupport(ctx, client, d); err != nil {
+	if err := resourceGitlabTopicEnsureTitleSupport(ctx, meta.(*ProviderMeta), d); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -199,18 +199,18 @@ func resourceGitlabTopicUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	topicID, err := strconv.Atoi(d.Id())
 	if err != nil {
 		return diag.Errorf("Failed to convert topic id %s to int: %s", d.Id(), err)
 	}
 	softDestroy := d.Get("soft_destroy").(bool)
 
-	deleteNotSupported, err := isGitLabVersionLessThan(ctx, client, "14.9")()
+	isDeleteSupported, err := meta.(*ProviderMeta).supportsFeature(ctx, "14.9")
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if !softDestroy && deleteNotSupported {
+	if !softDestroy && !isDeleteSupported {
 		return diag.Errorf("GitLab 14.9 introduced the proper deletion of topics. Set `soft_destroy = true` to empty out a topic instead of deleting it.")
 	}
 
@@ -252,8 +252,8 @@ func resourceGitlabTopicGetAvatar(avatarPath string) (*gitlab.TopicAvatar, error
 	}, nil
 }
 
-func resourceGitlabTopicEnsureTitleSupport(ctx context.Context, client *gitlab.Client, d *schema.ResourceData) error {
-	isTitleSupported, err := isGitLabVersionAtLeast(ctx, client, "15.0")()
+func resourceGitlabTopicEnsureTitleSupport(ctx context.Context, meta *ProviderMeta, d *schema.ResourceData) error {
+	isTitleSupported, err := meta.supportsFeature(ctx, "15.0")
 	if err != nil {
 		return err
 	}
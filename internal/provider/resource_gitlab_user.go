@@ -134,7 +134,7 @@ func resourceGitlabUserSetToState(d *schema.ResourceData, user *gitlab.User) {
 }
 
 func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	options := &gitlab.CreateUserOptions{
 		Email:            gitlab.String(d.Get("email").(string)),
 		Password:         gitlab.String(d.Get("password").(string)),
@@ -180,7 +180,7 @@ func resourceGitlabUserCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	log.Printf("[DEBUG] import -- read gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
@@ -200,7 +200,7 @@ func resourceGitlabUserRead(ctx context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.ModifyUserOptions{}
 
@@ -281,7 +281,7 @@ func resourceGitlabUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceGitlabUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 	log.Printf("[DEBUG] Delete gitlab user %s", d.Id())
 
 	id, _ := strconv.Atoi(d.Id())
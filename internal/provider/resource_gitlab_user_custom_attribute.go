@@ -19,6 +19,8 @@ var _ = registerResource("gitlab_user_custom_attribute", func() *schema.Resource
 		},
 		`The `+"`gitlab_user_custom_attribute`"+` resource allows to manage custom attributes for a user.
 
+-> Requires administrator privileges.
+
 **Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/custom_attributes.html)`,
 	)
 })
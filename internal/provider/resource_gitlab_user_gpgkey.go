@@ -61,7 +61,7 @@ var _ = registerResource("gitlab_user_gpgkey", func() *schema.Resource {
 })
 
 func resourceGitlabUserGPGKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.AddGPGKeyOptions{
 		Key: gitlab.String(strings.TrimSpace(d.Get("key").(string))),
@@ -98,7 +98,7 @@ func resourceGitlabUserGPGKeyCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceGitlabUserGPGKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userID, keyID, err := resourceGitlabUserGPGKeyParseID(d.Id())
 	if err != nil {
@@ -130,7 +130,7 @@ func resourceGitlabUserGPGKeyRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabUserGPGKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	var isAdmin bool
 	_, keyID, err := resourceGitlabUserGPGKeyParseID(d.Id())
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var _ = registerResource("gitlab_user_impersonation_token", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_user_impersonation_token`" + ` resource allows to manage the lifecycle of an impersonation token of a user.
+
+-> This resource requires administration privileges.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#create-an-impersonation-token)`,
+
+		CreateContext: resourceGitlabUserImpersonationTokenCreate,
+		ReadContext:   resourceGitlabUserImpersonationTokenRead,
+		DeleteContext: resourceGitlabUserImpersonationTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the impersonation token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scopes": {
+				Description: fmt.Sprintf("The scopes of the impersonation token. Valid values are: %s.", renderValueListForDocs(validPersonalAccessTokenScopes)),
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(validPersonalAccessTokenScopes, false),
+				},
+			},
+			"expires_at": {
+				Description:      "The token expires at midnight UTC on that date. The date must be in the format YYYY-MM-DD.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: isISO6801Date,
+			},
+			"token": {
+				Description: "The impersonation token. This is only populated when creating a new impersonation token. This attribute is not available for imported resources.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_id": {
+				Description: "The ID of the impersonation token.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"active": {
+				Description: "True if the token is active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"created_at": {
+				Description: "Time the token has been created, RFC3339 format.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+})
+
+func resourceGitlabUserImpersonationTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	userID := d.Get("user_id").(int)
+	options := &gitlab.CreateImpersonationTokenOptions{
+		Name:   gitlab.String(d.Get("name").(string)),
+		Scopes: stringSetToStringSlice(d.Get("scopes").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		parsedExpiresAt, err := parseISO8601Date(v.(string))
+		if err != nil {
+			return diag.Errorf("failed to parse expires_at '%s' as ISO8601 formatted date: %v", v.(string), err)
+		}
+		expiresAt := time.Time(*parsedExpiresAt)
+		options.ExpiresAt = &expiresAt
+	}
+
+	log.Printf("[DEBUG] create gitlab impersonation token %s (scopes: %s) for user ID %d", *options.Name, *options.Scopes, userID)
+	token, _, err := client.Users.CreateImpersonationToken(userID, options, gitlab.WithContext(ctx))
+	if err != nil {
+		return impersonationTokenErrorDiagnostics("create", fmt.Sprintf("for user %d", userID), err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%d", userID, token.ID))
+	// NOTE: the token can only be read once after creating it
+	d.Set("token", token.Token)
+
+	return resourceGitlabUserImpersonationTokenRead(ctx, d, meta)
+}
+
+func resourceGitlabUserImpersonationTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	userID, tokenID, err := resourceGitlabUserImpersonationTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] read gitlab impersonation token %d of user ID %d", tokenID, userID)
+	token, _, err := client.Users.GetImpersonationToken(userID, tokenID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab impersonation token %d of user ID %d not found, removing from state", tokenID, userID)
+			d.SetId("")
+			return nil
+		}
+		return impersonationTokenErrorDiagnostics("read", d.Id(), err)
+	}
+	if token.Revoked {
+		log.Printf("[DEBUG] gitlab impersonation token %d of user ID %d is revoked, removing from state", tokenID, userID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userID)
+	d.Set("token_id", token.ID)
+	d.Set("name", token.Name)
+	d.Set("active", token.Active)
+	if token.ExpiresAt != nil {
+		d.Set("expires_at", token.ExpiresAt.String())
+	}
+	if token.CreatedAt != nil {
+		d.Set("created_at", token.CreatedAt.Format(time.RFC3339))
+	}
+	if err := d.Set("scopes", token.Scopes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGitlabUserImpersonationTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+
+	userID, tokenID, err := resourceGitlabUserImpersonationTokenParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] revoke gitlab impersonation token %d of user ID %d", tokenID, userID)
+	if _, err := client.Users.RevokeImpersonationToken(userID, tokenID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return impersonationTokenErrorDiagnostics("revoke", d.Id(), err)
+	}
+
+	return nil
+}
+
+// impersonationTokenErrorDiagnostics returns the diagnostics for a failed impersonation token API call.
+// Impersonation tokens can only be managed by administrators, which is pointed out for 403 responses.
+func impersonationTokenErrorDiagnostics(action string, id string, err error) diag.Diagnostics {
+	if is403(err) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to %s impersonation token %s: forbidden", action, id),
+			Detail:   fmt.Sprintf("Impersonation tokens can only be managed with the token of an administrator: %v", err),
+		}}
+	}
+	return diag.Errorf("failed to %s impersonation token %s: %v", action, id, err)
+}
+
+func resourceGitlabUserImpersonationTokenParseID(id string) (int, int, error) {
+	userID, tokenID, err := parseTwoPartID(id)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	userIID, err := strconv.Atoi(userID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tokenIID, err := strconv.Atoi(tokenID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return userIID, tokenIID, nil
+}
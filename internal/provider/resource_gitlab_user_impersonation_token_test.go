@@ -0,0 +1,77 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabUserImpersonationToken_basic(t *testing.T) {
+	user := testAccCreateUsers(t, 1)[0]
+	expiresAt := time.Now().Add(time.Hour * 48).Format("2006-01-02")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabUserImpersonationTokenDestroy,
+		Steps: []resource.TestStep{
+			// Create an impersonation token
+			{
+				Config: fmt.Sprintf(`
+				resource "gitlab_user_impersonation_token" "this" {
+					user_id    = %d
+					name       = "impersonation"
+					scopes     = ["api", "read_user"]
+					expires_at = %q
+				}
+				`, user.ID, expiresAt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("gitlab_user_impersonation_token.this", "token"),
+					resource.TestCheckResourceAttrSet("gitlab_user_impersonation_token.this", "token_id"),
+					resource.TestCheckResourceAttrSet("gitlab_user_impersonation_token.this", "created_at"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "active", "true"),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "expires_at", expiresAt),
+					resource.TestCheckResourceAttr("gitlab_user_impersonation_token.this", "scopes.#", "2"),
+				),
+			},
+			// Verify upstream resource with an import.
+			{
+				ResourceName:      "gitlab_user_impersonation_token.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The token is only known during creating.
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserImpersonationTokenDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_impersonation_token" {
+			continue
+		}
+
+		userID, tokenID, err := resourceGitlabUserImpersonationTokenParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		token, _, err := testGitlabClient.Users.GetImpersonationToken(userID, tokenID)
+		if err != nil {
+			if is404(err) {
+				continue
+			}
+			return err
+		}
+		if !token.Revoked {
+			return fmt.Errorf("impersonation token %d of user %d is not revoked", tokenID, userID)
+		}
+	}
+	return nil
+}
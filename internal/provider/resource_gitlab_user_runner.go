@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+var userRunnerTypeAllowedValues = []string{
+	"instance_type",
+	"group_type",
+	"project_type",
+}
+
+var _ = registerResource("gitlab_user_runner", func() *schema.Resource {
+	return &schema.Resource{
+		Description: `The ` + "`gitlab_user_runner`" + ` resource allows to create and manage a runner with the runner creation workflow,
+which replaces the registration of runners with a registration token.
+
+-> Creating an ` + "`instance_type`" + ` runner requires administrator privileges, a ` + "`group_type`" + ` runner requires the owner role of the group and a ` + "`project_type`" + ` runner requires at least the maintainer role of the project.
+
+-> Requires at least GitLab 16.0.
+
+**Upstream API**: [GitLab REST API docs](https://docs.gitlab.com/ee/api/users.html#create-a-runner)`,
+
+		CreateContext: resourceGitlabUserRunnerCreate,
+		ReadContext:   resourceGitlabUserRunnerRead,
+		UpdateContext: resourceGitlabUserRunnerUpdate,
+		DeleteContext: resourceGitlabUserRunnerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"runner_type": {
+				Description:  fmt.Sprintf("The scope of the runner. Valid values are: %s.", renderValueListForDocs(userRunnerTypeAllowedValues)),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(userRunnerTypeAllowedValues, false),
+			},
+			"group_id": {
+				Description: "The ID of the group the runner is created in. Required for `group_type` runners.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"project_id": {
+				Description: "The ID of the project the runner is created in. Required for `project_type` runners.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The runner's description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"paused": {
+				Description: "Whether the runner should ignore new jobs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"locked": {
+				Description: "Whether the runner should be locked for the current project.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"run_untagged": {
+				Description: "Whether the runner should handle untagged jobs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tag_list": {
+				Description: "The runner's tags.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"access_level": {
+				Description:  fmt.Sprintf("The access level of the runner. Valid values are: %s.", renderValueListForDocs(runnerAccessLevelAllowedValues)),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(runnerAccessLevelAllowedValues, false),
+			},
+			"maximum_timeout": {
+				Description: "Maximum timeout that limits the amount of time (in seconds) that runners can run jobs.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"token": {
+				Description: "The authentication token of the runner, used to configure the runner with `gitlab-runner register`. This value is only available when the runner is created and is not present when imported.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+})
+
+func resourceGitlabUserRunnerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	runnerType := d.Get("runner_type").(string)
+
+	options := &gitlab.CreateUserRunnerOptions{
+		RunnerType: gitlab.String(runnerType),
+	}
+
+	switch runnerType {
+	case "group_type":
+		groupID, ok := d.GetOk("group_id")
+		if !ok {
+			return diag.Errorf("`group_id` is required for `group_type` runners")
+		}
+		options.GroupID = gitlab.Int(groupID.(int))
+	case "project_type":
+		projectID, ok := d.GetOk("project_id")
+		if !ok {
+			return diag.Errorf("`project_id` is required for `project_type` runners")
+		}
+		options.ProjectID = gitlab.Int(projectID.(int))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		options.Description = gitlab.String(v.(string))
+	}
+
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("paused"); ok {
+		options.Paused = gitlab.Bool(v.(bool))
+	}
+
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("locked"); ok {
+		options.Locked = gitlab.Bool(v.(bool))
+	}
+
+	// nolint:staticcheck // SA1019 ignore deprecated GetOkExists
+	// lintignore: XR001 // TODO: replace with alternative for GetOkExists
+	if v, ok := d.GetOkExists("run_untagged"); ok {
+		options.RunUntagged = gitlab.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("tag_list"); ok {
+		options.TagList = stringSetToStringSlice(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("access_level"); ok {
+		options.AccessLevel = gitlab.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maximum_timeout"); ok {
+		options.MaximumTimeout = gitlab.Int(v.(int))
+	}
+
+	log.Printf("[DEBUG] create gitlab %s runner", runnerType)
+	runner, _, err := client.Users.CreateUserRunner(options, gitlab.WithContext(ctx))
+	if err != nil {
+		return diag.Errorf("failed to create %s runner: %v", runnerType, err)
+	}
+
+	d.SetId(strconv.Itoa(runner.ID))
+	// NOTE: the token is only returned with the direct response from the create API.
+	d.Set("token", runner.Token)
+
+	return resourceGitlabUserRunnerRead(ctx, d, meta)
+}
+
+func resourceGitlabUserRunnerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid runner id %q, expected integer", d.Id())
+	}
+
+	log.Printf("[DEBUG] read gitlab runner %d", runnerID)
+	runner, _, err := client.Runners.GetRunnerDetails(runnerID, gitlab.WithContext(ctx))
+	if err != nil {
+		if is404(err) {
+			log.Printf("[DEBUG] gitlab runner %d not found, removing from state", runnerID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to read runner %d: %v", runnerID, err)
+	}
+
+	d.Set("runner_type", runner.RunnerType)
+	// The group or project the runner has been created in is listed first,
+	// followed by the ones it has been enabled in later on.
+	if runner.RunnerType == "group_type" && len(runner.Groups) > 0 {
+		d.Set("group_id", runner.Groups[0].ID)
+	}
+	if runner.RunnerType == "project_type" && len(runner.Projects) > 0 {
+		d.Set("project_id", runner.Projects[0].ID)
+	}
+	d.Set("description", runner.Description)
+	d.Set("paused", runner.Paused)
+	d.Set("locked", runner.Locked)
+	d.Set("run_untagged", runner.RunUntagged)
+	d.Set("access_level", runner.AccessLevel)
+	d.Set("maximum_timeout", runner.MaximumTimeout)
+	if err := d.Set("tag_list", runner.TagList); err != nil {
+		return diag.Errorf("failed to set tag list of runner %d: %v", runnerID, err)
+	}
+
+	return nil
+}
+
+func resourceGitlabUserRunnerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid runner id %q, expected integer", d.Id())
+	}
+
+	options := &gitlab.UpdateRunnerDetailsOptions{}
+
+	if d.HasChange("description") {
+		options.Description = gitlab.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("paused") {
+		options.Paused = gitlab.Bool(d.Get("paused").(bool))
+	}
+
+	if d.HasChange("locked") {
+		options.Locked = gitlab.Bool(d.Get("locked").(bool))
+	}
+
+	if d.HasChange("run_untagged") {
+		options.RunUntagged = gitlab.Bool(d.Get("run_untagged").(bool))
+	}
+
+	if d.HasChange("tag_list") {
+		options.TagList = stringSetToStringSlice(d.Get("tag_list").(*schema.Set))
+	}
+
+	if d.HasChange("access_level") {
+		options.AccessLevel = gitlab.String(d.Get("access_level").(string))
+	}
+
+	if d.HasChange("maximum_timeout") {
+		options.MaximumTimeout = gitlab.Int(d.Get("maximum_timeout").(int))
+	}
+
+	log.Printf("[DEBUG] update gitlab runner %d", runnerID)
+	if _, _, err := client.Runners.UpdateRunnerDetails(runnerID, options, gitlab.WithContext(ctx)); err != nil {
+		return diag.Errorf("failed to update runner %d: %v", runnerID, err)
+	}
+
+	return resourceGitlabUserRunnerRead(ctx, d, meta)
+}
+
+func resourceGitlabUserRunnerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*ProviderMeta).Client
+	runnerID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid runner id %q, expected integer", d.Id())
+	}
+
+	log.Printf("[DEBUG] delete gitlab runner %d", runnerID)
+	if _, err := client.Runners.DeleteRegisteredRunnerByID(runnerID, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+		return diag.Errorf("failed to delete runner %d: %v", runnerID, err)
+	}
+
+	return nil
+}
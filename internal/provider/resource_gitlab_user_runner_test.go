@@ -0,0 +1,101 @@
+//go:build acceptance
+// +build acceptance
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccGitlabUserRunner_projectType(t *testing.T) {
+	testAccRequiresAtLeast(t, "16.0")
+
+	testProject := testAccCreateProject(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabUserRunnerDestroy,
+		Steps: []resource.TestStep{
+			// Create a project runner with minimal attributes
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_runner" "this" {
+						runner_type = "project_type"
+						project_id  = %d
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "runner_type", "project_type"),
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "project_id", fmt.Sprintf("%d", testProject.ID)),
+					resource.TestCheckResourceAttrSet("gitlab_user_runner.this", "token"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_user_runner.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+			// Update the runner with all attributes
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_runner" "this" {
+						runner_type     = "project_type"
+						project_id      = %d
+						description     = "Test runner"
+						paused          = true
+						locked          = true
+						run_untagged    = false
+						tag_list        = ["docker", "linux"]
+						access_level    = "ref_protected"
+						maximum_timeout = 3600
+					}
+				`, testProject.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "description", "Test runner"),
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "paused", "true"),
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "tag_list.#", "2"),
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "access_level", "ref_protected"),
+					resource.TestCheckResourceAttr("gitlab_user_runner.this", "maximum_timeout", "3600"),
+					resource.TestCheckResourceAttrSet("gitlab_user_runner.this", "token"),
+				),
+			},
+			// Verify import
+			{
+				ResourceName:            "gitlab_user_runner.this",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccCheckGitlabUserRunnerDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "gitlab_user_runner" {
+			continue
+		}
+
+		runnerID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, _, err = testGitlabClient.Runners.GetRunnerDetails(runnerID)
+		if err == nil {
+			return fmt.Errorf("runner %d still exists", runnerID)
+		}
+		if !is404(err) {
+			return err
+		}
+		return nil
+	}
+	return nil
+}
@@ -16,7 +16,9 @@ import (
 
 var _ = registerResource("gitlab_user_sshkey", func() *schema.Resource {
 	return &schema.Resource{
-		Description: `The ` + "`" + `gitlab_user_sshkey` + "`" + ` resource allows to manage the lifecycle of an SSH key assigned to a user.
+		Description: `The ` + "`" + `gitlab_user_sshkey` + "`" + ` resource allows to manage the lifecycle of an SSH key assigned to the current user or a specific user.
+
+-> Managing SSH keys for arbitrary users requires admin privileges.
 
 **Upstream API**: [GitLab API docs](https://docs.gitlab.com/ee/api/users.html#single-ssh-key)`,
 
@@ -29,10 +31,10 @@ var _ = registerResource("gitlab_user_sshkey", func() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"user_id": {
-				Description: "The ID of the user to add the ssh key to.",
+				Description: "The ID of the user to add the ssh key to. If this field is omitted, this resource manages a SSH key for the current user. Otherwise, this resource manages a SSH key for the specified user, and an admin token is required.",
 				Type:        schema.TypeInt,
 				ForceNew:    true,
-				Required:    true,
+				Optional:    true,
 			},
 			"title": {
 				Description: "The title of the ssh key.",
@@ -83,8 +85,7 @@ var _ = registerResource("gitlab_user_sshkey", func() *schema.Resource {
 })
 
 func resourceGitlabUserSSHKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
-	userID := d.Get("user_id").(int)
+	client := meta.(*ProviderMeta).Client
 
 	options := &gitlab.AddSSHKeyOptions{
 		Title: gitlab.String(d.Get("title").(string)),
@@ -100,45 +101,62 @@ func resourceGitlabUserSSHKeyCreate(ctx context.Context, d *schema.ResourceData,
 		options.ExpiresAt = &gitlabExpiresAt
 	}
 
-	key, _, err := client.Users.AddSSHKeyForUser(userID, options, gitlab.WithContext(ctx))
+	var key *gitlab.SSHKey
+	var err error
+	userID, userIDOk := d.GetOk("user_id")
+	if userIDOk {
+		key, _, err = client.Users.AddSSHKeyForUser(userID.(int), options, gitlab.WithContext(ctx))
+	} else {
+		key, _, err = client.Users.AddSSHKey(options, gitlab.WithContext(ctx))
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	userIDForID := fmt.Sprintf("%d", userID)
 	keyIDForID := fmt.Sprintf("%d", key.ID)
-	d.SetId(buildTwoPartID(&userIDForID, &keyIDForID))
+	if userIDOk {
+		userIDForID := fmt.Sprintf("%d", userID.(int))
+		d.SetId(buildTwoPartID(&userIDForID, &keyIDForID))
+	} else {
+		d.SetId(keyIDForID)
+	}
 	return resourceGitlabUserSSHKeyRead(ctx, d, meta)
 }
 
 func resourceGitlabUserSSHKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userID, keyID, err := resourceGitlabUserSSHKeyParseID(d.Id())
 	if err != nil {
 		return diag.Errorf("unable to parse user ssh key resource id: %s: %v", d.Id(), err)
 	}
 
-	options := &gitlab.ListSSHKeysForUserOptions{
-		Page:    1,
-		PerPage: 20,
-	}
-
 	var key *gitlab.SSHKey
-	for options.Page != 0 && key == nil {
-		keys, resp, err := client.Users.ListSSHKeysForUser(userID, options, gitlab.WithContext(ctx))
-		if err != nil {
-			return diag.FromErr(err)
+	if userID != 0 {
+		options := &gitlab.ListSSHKeysForUserOptions{
+			Page: 1,
 		}
 
-		for _, k := range keys {
-			if k.ID == keyID {
-				key = k
-				break
+		for options.Page != 0 && key == nil {
+			keys, resp, err := client.Users.ListSSHKeysForUser(userID, options, gitlab.WithContext(ctx))
+			if err != nil {
+				return diag.FromErr(err)
 			}
-		}
 
-		options.Page = resp.NextPage
+			for _, k := range keys {
+				if k.ID == keyID {
+					key = k
+					break
+				}
+			}
+
+			options.Page = resp.NextPage
+		}
+	} else {
+		key, _, err = client.Users.GetSSHKey(keyID, gitlab.WithContext(ctx))
+		if err != nil && !is404(err) {
+			return diag.FromErr(err)
+		}
 	}
 
 	if key == nil {
@@ -147,7 +165,9 @@ func resourceGitlabUserSSHKeyRead(ctx context.Context, d *schema.ResourceData, m
 		return nil
 	}
 
-	d.Set("user_id", userID)
+	if userID != 0 {
+		d.Set("user_id", userID)
+	}
 	d.Set("key_id", keyID)
 	d.Set("title", key.Title)
 	d.Set("key", key.Key)
@@ -161,24 +181,35 @@ func resourceGitlabUserSSHKeyRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceGitlabUserSSHKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*gitlab.Client)
+	client := meta.(*ProviderMeta).Client
 
 	userID, keyID, err := resourceGitlabUserSSHKeyParseID(d.Id())
 	if err != nil {
 		return diag.Errorf("unable to parse user ssh key resource id: %s: %v", d.Id(), err)
 	}
 
-	if _, err := client.Users.DeleteSSHKeyForUser(userID, keyID, gitlab.WithContext(ctx)); err != nil {
+	if userID != 0 {
+		_, err = client.Users.DeleteSSHKeyForUser(userID, keyID, gitlab.WithContext(ctx))
+	} else {
+		_, err = client.Users.DeleteSSHKey(keyID, gitlab.WithContext(ctx))
+	}
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// resourceGitlabUserSSHKeyParseID parses the `<user-id>:<key-id>` ID of a SSH key of a specific user
+// or the `<key-id>` ID of a SSH key of the current user, in which case the returned user ID is 0.
 func resourceGitlabUserSSHKeyParseID(id string) (int, int, error) {
 	userIDFromID, keyIDFromID, err := parseTwoPartID(id)
 	if err != nil {
-		return 0, 0, err
+		keyID, errKeyID := strconv.Atoi(id)
+		if errKeyID != nil {
+			return 0, 0, err
+		}
+		return 0, keyID, nil
 	}
 	userID, err := strconv.Atoi(userIDFromID)
 	if err != nil {
@@ -18,6 +18,7 @@ var testRSAPubKeyUpdatedComment string = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQ
 var updatedRSAPubKey string = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDStVqW58VZ5afXFphIvu2JahndXslJZMkgWsNiYCNdk/NvrEbc4i7yZVoDPFQsbS9I6Ty1RMW7qy3KxJalMsVHcw8arCQFDxs/ka1NHGCUPl68t5ZxUOl900KRQ0lOzGnDQMqG/UUZdPw4CCmigTr6Z9ZBcD1fXAiUwbXR4tWrr5z9KWXC2HgF4WkIJUTIct7ilY1m9W0y79dI/+K8bZrurn3q2QK83pxqqWkLwvUsCxtlhMpwuyflyzyuz8xPZl2GlZgxeIpr68gsPHIzzWizibwFfbRYKCZO4wD0r7JCDOYs9KjcIPpCG6d3HUqijClgdQSBnLwHTdE04ZtdzO8akvy0hMzRCooI5TSc8IAHos53Gp9aaW92sPA8za+WRP6OSH6UsOW4N+iQc4jyl7/fckMSgIZlJouNqqV+P8iqIFJGs70Tj5L8G/m+P2lc3kcE4Vjmj+Fc0xG5+I/PsSOpcc6DfDfZdVDRe8yklYd/qC1jI89OCeqjxu3XcUGHj9s= terraform@gitlab.com"
 var updatedRSAPubKeyWithoutComment string = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABgQDStVqW58VZ5afXFphIvu2JahndXslJZMkgWsNiYCNdk/NvrEbc4i7yZVoDPFQsbS9I6Ty1RMW7qy3KxJalMsVHcw8arCQFDxs/ka1NHGCUPl68t5ZxUOl900KRQ0lOzGnDQMqG/UUZdPw4CCmigTr6Z9ZBcD1fXAiUwbXR4tWrr5z9KWXC2HgF4WkIJUTIct7ilY1m9W0y79dI/+K8bZrurn3q2QK83pxqqWkLwvUsCxtlhMpwuyflyzyuz8xPZl2GlZgxeIpr68gsPHIzzWizibwFfbRYKCZO4wD0r7JCDOYs9KjcIPpCG6d3HUqijClgdQSBnLwHTdE04ZtdzO8akvy0hMzRCooI5TSc8IAHos53Gp9aaW92sPA8za+WRP6OSH6UsOW4N+iQc4jyl7/fckMSgIZlJouNqqV+P8iqIFJGs70Tj5L8G/m+P2lc3kcE4Vjmj+Fc0xG5+I/PsSOpcc6DfDfZdVDRe8yklYd/qC1jI89OCeqjxu3XcUGHj9s="
 var testKeyWithTrailingNewline string = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIMG5+BWfNRCNE9chUUooEwS/QeNMN5Z1RBdY1GQ0VqMa\n"
+var testEd25519PubKeyForCurrentUser string = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIPOaliDrXqV2UxTkB3kfYzjA6zLDo4NgzR1rTRoRxX+y"
 
 func TestAccGitlabUserSSHKey_basic(t *testing.T) {
 	var key gitlab.SSHKey
@@ -80,6 +81,43 @@ func TestAccGitlabUserSSHKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccGitlabUserSSHKey_currentUser(t *testing.T) {
+	var key gitlab.SSHKey
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGitlabUserSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			// Create a sshkey for the current user
+			{
+				Config: fmt.Sprintf(`
+					resource "gitlab_user_sshkey" "this" {
+						title      = "current-user-key"
+						key        = "%s"
+						expires_at = "3016-01-21T00:00:00Z"
+					}
+				`, testEd25519PubKeyForCurrentUser),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGitlabUserSSHKeyExists("gitlab_user_sshkey.this", &key),
+					testAccCheckGitlabUserSSHKeyAttributes(&key, &testAccGitlabUserSSHKeyExpectedAttributes{
+						Title: "current-user-key",
+						Key:   testEd25519PubKeyForCurrentUser,
+					}),
+					resource.TestCheckNoResourceAttr("gitlab_user_sshkey.this", "user_id"),
+					resource.TestCheckResourceAttrSet("gitlab_user_sshkey.this", "key_id"),
+					resource.TestCheckResourceAttrSet("gitlab_user_sshkey.this", "created_at"),
+				),
+			},
+			// Verify Import
+			{
+				ResourceName:      "gitlab_user_sshkey.this",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccGitlabUserSSHKey_ignoreTrailingWhitespaces(t *testing.T) {
 	testUser := testAccCreateUsers(t, 1)[0]
 
@@ -133,7 +171,7 @@ func testAccCheckGitlabUserSSHKeyDestroy(s *terraform.State) error {
 			return fmt.Errorf("failed to parse user ssh key resource ID: %s", err)
 		}
 
-		keys, _, err := testGitlabClient.Users.ListSSHKeysForUser(userID, &gitlab.ListSSHKeysForUserOptions{})
+		keys, err := testAccListGitlabUserSSHKeys(userID)
 		if err != nil {
 			return err
 		}
@@ -167,7 +205,7 @@ func testAccCheckGitlabUserSSHKeyExists(n string, key *gitlab.SSHKey) resource.T
 			return fmt.Errorf("failed to parse user ssh key resource ID: %s", err)
 		}
 
-		keys, _, err := testGitlabClient.Users.ListSSHKeysForUser(userID, &gitlab.ListSSHKeysForUserOptions{})
+		keys, err := testAccListGitlabUserSSHKeys(userID)
 		if err != nil {
 			return err
 		}
@@ -189,6 +227,16 @@ func testAccCheckGitlabUserSSHKeyExists(n string, key *gitlab.SSHKey) resource.T
 	}
 }
 
+// testAccListGitlabUserSSHKeys lists the ssh keys of the given user or of the current user if the user ID is 0.
+func testAccListGitlabUserSSHKeys(userID int) ([]*gitlab.SSHKey, error) {
+	if userID == 0 {
+		keys, _, err := testGitlabClient.Users.ListSSHKeys(nil)
+		return keys, err
+	}
+	keys, _, err := testGitlabClient.Users.ListSSHKeysForUser(userID, &gitlab.ListSSHKeysForUserOptions{})
+	return keys, err
+}
+
 type testAccGitlabUserSSHKeyExpectedAttributes struct {
 	Title     string
 	Key       string
@@ -116,6 +116,12 @@ func gitlabGroupHookSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Default:     false,
 		},
+		"member_events": {
+			Description: "Invoke the hook for member events.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
 		"enable_ssl_verification": {
 			Description: "Enable ssl verification when invoking the hook.",
 			Type:        schema.TypeBool,
@@ -125,6 +131,12 @@ func gitlabGroupHookSchema() map[string]*schema.Schema {
 	}
 }
 
+func gitlabGroupHookResourceSchema() map[string]*schema.Schema {
+	hookSchema := gitlabGroupHookSchema()
+	hookSchema["custom_headers"] = gitlabHookCustomHeadersSchema()
+	return hookSchema
+}
+
 func gitlabGroupHookToStateMap(group string, hook *gitlab.GroupHook) map[string]interface{} {
 	stateMap := make(map[string]interface{})
 	stateMap["group"] = group
@@ -145,6 +157,7 @@ func gitlabGroupHookToStateMap(group string, hook *gitlab.GroupHook) map[string]
 	stateMap["deployment_events"] = hook.DeploymentEvents
 	stateMap["releases_events"] = hook.ReleasesEvents
 	stateMap["subgroup_events"] = hook.SubGroupEvents
+	stateMap["member_events"] = hook.MemberEvents
 	stateMap["enable_ssl_verification"] = hook.EnableSSLVerification
 	return stateMap
 }
@@ -37,7 +37,18 @@ func gitlabInstanceVariableGetSchema() map[string]*schema.Schema {
 			Default:     false,
 		},
 		"masked": {
-			Description: "If set to `true`, the value of the variable will be hidden in job logs. The value must meet the [masking requirements](https://docs.gitlab.com/ee/ci/variables/#masked-variables). Defaults to `false`.",
+			Description: "If set to `true`, the value of the variable will be hidden in job logs. The value must meet the [masking requirements](https://docs.gitlab.com/ee/ci/variables/#masked-variables). Defaults to `false`, unless the `default_variable_masked` provider attribute is set.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"description": {
+			Description: "The description of the variable. Requires GitLab 16.2 or later.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"raw": {
+			Description: "If set to `true`, the value of the variable is treated as a raw string and variable references (`$VAR`) in it are not expanded. Requires GitLab 15.7 or later. Defaults to `false`.",
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
@@ -52,5 +63,7 @@ func gitlabInstanceVariableToStateMap(variable *gitlab.InstanceVariable) map[str
 	stateMap["variable_type"] = variable.VariableType
 	stateMap["protected"] = variable.Protected
 	stateMap["masked"] = variable.Masked
+	stateMap["raw"] = variable.Raw
+	stateMap["description"] = variable.Description
 	return stateMap
 }
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/xanzy/go-gitlab"
 )
@@ -116,9 +118,31 @@ func gitlabProjectHookSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Default:     true,
 		},
+		"created_at": {
+			Description: "The ISO8601 datetime when the hook was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
 	}
 }
 
+func gitlabProjectHookResourceSchema() map[string]*schema.Schema {
+	hookSchema := gitlabProjectHookSchema()
+	hookSchema["test_on_create"] = &schema.Schema{
+		Description: "Trigger a test of the hook with a push event after it has been created. A failing test results in a warning, but does not fail the apply.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	}
+	hookSchema["custom_headers"] = gitlabHookCustomHeadersSchema()
+	hookSchema["last_test_status"] = &schema.Schema{
+		Description: "The result of the last test of the hook triggered by `test_on_create`. Either `succeeded` or `failed`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	return hookSchema
+}
+
 func gitlabProjectHookToStateMap(project string, hook *gitlab.ProjectHook) map[string]interface{} {
 	stateMap := make(map[string]interface{})
 	stateMap["project"] = project
@@ -139,5 +163,10 @@ func gitlabProjectHookToStateMap(project string, hook *gitlab.ProjectHook) map[s
 	stateMap["deployment_events"] = hook.DeploymentEvents
 	stateMap["releases_events"] = hook.ReleasesEvents
 	stateMap["enable_ssl_verification"] = hook.EnableSSLVerification
+	if hook.CreatedAt != nil {
+		stateMap["created_at"] = hook.CreatedAt.Format(time.RFC3339)
+	} else {
+		stateMap["created_at"] = nil
+	}
 	return stateMap
 }
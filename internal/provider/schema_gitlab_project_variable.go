@@ -45,10 +45,10 @@ func gitlabProjectVariableGetSchema() map[string]*schema.Schema {
 			Default:     false,
 		},
 		"masked": {
-			Description: "If set to `true`, the value of the variable will be hidden in job logs. The value must meet the [masking requirements](https://docs.gitlab.com/ee/ci/variables/#masked-variables). Defaults to `false`.",
+			Description: "If set to `true`, the value of the variable will be hidden in job logs. The value must meet the [masking requirements](https://docs.gitlab.com/ee/ci/variables/#masked-variables). Defaults to `false`, unless the `default_variable_masked` provider attribute is set.",
 			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     false,
+			Computed:    true,
 		},
 		"environment_scope": {
 			Description: "The environment scope of the variable. Defaults to all environment (`*`). Note that in Community Editions of Gitlab, values other than `*` will cause inconsistent plans.",
@@ -58,6 +58,11 @@ func gitlabProjectVariableGetSchema() map[string]*schema.Schema {
 			// Versions of GitLab prior to 13.4 cannot update environment_scope.
 			ForceNew: true,
 		},
+		"description": {
+			Description: "The description of the variable. Requires GitLab 16.2 or later.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 	}
 }
 
@@ -70,5 +75,6 @@ func gitlabProjectVariableToStateMap(project string, variable *gitlab.ProjectVar
 	stateMap["protected"] = variable.Protected
 	stateMap["masked"] = variable.Masked
 	stateMap["environment_scope"] = variable.EnvironmentScope
+	stateMap["description"] = variable.Description
 	return stateMap
 }
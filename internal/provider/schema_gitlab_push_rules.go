@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// gitlabPushRulesGetSchema returns the push rule attributes which are shared by the `push_rules` block
+// of the `gitlab_project` resource and the push rules resources.
+func gitlabPushRulesGetSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"author_email_regex": {
+			Description: "All commit author emails must match this regex, e.g. `@my-company.com$`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"branch_name_regex": {
+			Description: "All branch names must match this regex, e.g. `(feature|hotfix)\\/*`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"commit_message_regex": {
+			Description: "All commit messages must match this regex, e.g. `Fixed \\d+\\..*`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"commit_message_negative_regex": {
+			Description: "No commit message is allowed to match this regex, for example `ssh\\:\\/\\/`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"file_name_regex": {
+			Description: "All commited filenames must not match this regex, e.g. `(jar|exe)$`.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"commit_committer_check": {
+			Description: "Users can only push commits to this repository that were committed with one of their own verified emails.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"deny_delete_tag": {
+			Description: "Deny deleting a tag.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"member_check": {
+			Description: "Restrict commits by author (email) to existing GitLab users.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"prevent_secrets": {
+			Description: "GitLab will reject any files that are likely to contain secrets.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"reject_unsigned_commits": {
+			Description: "Reject commit when it’s not signed through GPG.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+		},
+		"max_file_size": {
+			Description:  "Maximum file size (MB).",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+// gitlabPushRulesResourceGetSchema returns the attributes of the push rules resources,
+// without the attribute which identifies the project or group.
+func gitlabPushRulesResourceGetSchema() map[string]*schema.Schema {
+	pushRulesSchema := gitlabPushRulesGetSchema()
+	pushRulesSchema["commit_committer_name_check"] = &schema.Schema{
+		Description: "Users can only push commits to this repository if the commit author name is consistent with their GitLab account name.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+	pushRulesSchema["reject_non_dco_commits"] = &schema.Schema{
+		Description: "Reject commit when it’s not DCO certified.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+	return pushRulesSchema
+}
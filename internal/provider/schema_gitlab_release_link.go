@@ -2,7 +2,6 @@ package provider
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -10,8 +9,6 @@ import (
 )
 
 func gitlabReleaseLinkGetSchema() map[string]*schema.Schema {
-	validLinkTypes := []string{"other", "runbook", "image", "package"}
-
 	return map[string]*schema.Schema{
 		"project": {
 			Description: "The ID or [URL-encoded path of the project](https://docs.gitlab.com/ee/api/index.html#namespaced-path-encoding).",
@@ -22,6 +19,7 @@ func gitlabReleaseLinkGetSchema() map[string]*schema.Schema {
 		"tag_name": {
 			Description: "The tag associated with the Release.",
 			Type:        schema.TypeString,
+			ForceNew:    true,
 			Required:    true,
 		},
 		"name": {
@@ -40,11 +38,11 @@ func gitlabReleaseLinkGetSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"link_type": {
-			Description:      fmt.Sprintf("The type of the link. Valid values are %s. Defaults to %s.", renderValueListForDocs(validLinkTypes), validLinkTypes[0]),
+			Description:      fmt.Sprintf("The type of the link. Valid values are %s. Defaults to %s.", renderValueListForDocs(validReleaseLinkTypes), validReleaseLinkTypes[0]),
 			Type:             schema.TypeString,
 			Optional:         true,
-			Default:          validLinkTypes[0],
-			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validLinkTypes, false)),
+			Default:          validReleaseLinkTypes[0],
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(validReleaseLinkTypes, false)),
 		},
 		"link_id": {
 			Description: "The ID of the link.",
@@ -70,12 +68,7 @@ func gitlabReleaseLinkToStateMap(project string, tagName string, releaseLink *gi
 	stateMap["tag_name"] = tagName
 	stateMap["name"] = releaseLink.Name
 	stateMap["url"] = releaseLink.URL
-	directAssetLinkArray := strings.SplitN(releaseLink.DirectAssetURL, "downloads", 2)
-	if len(directAssetLinkArray) > 1 {
-		stateMap["filepath"] = directAssetLinkArray[1]
-	} else {
-		stateMap["filepath"] = ""
-	}
+	stateMap["filepath"] = gitlabReleaseLinkFilePath(releaseLink.DirectAssetURL)
 	stateMap["link_type"] = releaseLink.LinkType
 	stateMap["link_id"] = releaseLink.ID
 	stateMap["direct_asset_url"] = releaseLink.DirectAssetURL
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gitlab "github.com/xanzy/go-gitlab"
+)
+
+// gitlabServiceSetFunc applies the settings of a project integration from the resource data.
+type gitlabServiceSetFunc func(ctx context.Context, client *gitlab.Client, project string, d *schema.ResourceData) error
+
+// gitlabServiceDeleteFunc is the signature of the go-gitlab methods deleting a project integration,
+// for example `(*gitlab.ServicesService).DeleteSlackService`.
+type gitlabServiceDeleteFunc func(s *gitlab.ServicesService, pid interface{}, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error)
+
+// isMaskedServiceValue reports whether a secret property returned by the integrations API
+// has been masked by GitLab, in which case it only consists of asterisks.
+func isMaskedServiceValue(value string) bool {
+	return value != "" && strings.Trim(value, "*") == ""
+}
+
+// resourceGitlabServiceSet returns a function to be used as both CreateContext and UpdateContext of
+// a project integration resource. GitLab creates and updates integrations with the same request,
+// so both operations apply all settings with setService and read the integration back afterwards.
+// The resource ID is the project the integration belongs to.
+func resourceGitlabServiceSet(name string, setService gitlabServiceSetFunc, read schema.ReadContextFunc) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*ProviderMeta).Client
+		project := d.Get("project").(string)
+
+		log.Printf("[DEBUG] set gitlab %s service for project %s", name, project)
+		if err := setService(ctx, client, project, d); err != nil {
+			return diag.Errorf("failed to set %s service for project %s: %v", name, project, err)
+		}
+
+		d.SetId(project)
+		return read(ctx, d, meta)
+	}
+}
+
+// resourceGitlabServiceDelete returns the DeleteContext function of a project integration resource.
+// An integration which does not exist anymore is not considered an error.
+func resourceGitlabServiceDelete(name string, deleteService gitlabServiceDeleteFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*ProviderMeta).Client
+		project := d.Get("project").(string)
+
+		log.Printf("[DEBUG] delete gitlab %s service for project %s", name, project)
+		if _, err := deleteService(client.Services, project, gitlab.WithContext(ctx)); err != nil && !is404(err) {
+			return diag.Errorf("failed to delete %s service for project %s: %v", name, project, err)
+		}
+		return nil
+	}
+}
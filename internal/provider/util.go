@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/mail"
 	"net/url"
 	"regexp"
 	"strconv"
@@ -47,6 +49,45 @@ var validateDateFunc = func(v interface{}, k string) (we []string, errors []erro
 	return
 }
 
+// validateMembershipExpiresAtFunc accepts a date in the `YYYY-MM-DD` format or a RFC3339 timestamp.
+var validateMembershipExpiresAtFunc = func(v interface{}, k string) (we []string, errors []error) {
+	value := v.(string)
+	if _, err := normalizeMembershipExpiresAt(value); err != nil {
+		errors = append(errors, fmt.Errorf("%s is not valid for format YYYY-MM-DD", value))
+	}
+	return
+}
+
+// normalizeMembershipExpiresAt converts the given date in the `YYYY-MM-DD` format or RFC3339 timestamp
+// into the `YYYY-MM-DD` format GitLab uses for membership expiration dates.
+func normalizeMembershipExpiresAt(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+	if date, err := time.Parse(iso8601, v); err == nil {
+		return date.Format(iso8601), nil
+	}
+	date, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return "", err
+	}
+	return date.Format(iso8601), nil
+}
+
+// suppressEquivalentMembershipExpiresAt suppresses the diff of two membership expiration dates which
+// refer to the same day, e.g. `2025-12-31` and `2025-12-31T00:00:00Z`.
+func suppressEquivalentMembershipExpiresAt(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, err := normalizeMembershipExpiresAt(old)
+	if err != nil {
+		return false
+	}
+	newDate, err := normalizeMembershipExpiresAt(new)
+	if err != nil {
+		return false
+	}
+	return oldDate == newDate
+}
+
 var validateURLFunc = func(v interface{}, k string) (s []string, errors []error) {
 	value := v.(string)
 	url, err := url.Parse(value)
@@ -59,6 +100,36 @@ var validateURLFunc = func(v interface{}, k string) (s []string, errors []error)
 	return
 }
 
+// validateEmailFunc accepts a plain email address, like `user@example.com`, without a display name.
+var validateEmailFunc = func(v interface{}, k string) (s []string, errors []error) {
+	value := v.(string)
+	address, err := mail.ParseAddress(value)
+
+	if err != nil || address.Address != value {
+		errors = append(errors, fmt.Errorf("%s is not a valid email address", value))
+		return
+	}
+
+	return
+}
+
+// validateCronExpressionFunc only sanity checks a cron expression, like `0 23 * * 5`, by the number of its fields.
+// GitLab parses cron expressions with Fugit, which supports more than the standard syntax, e.g. `@weekly`,
+// `L` for the last day of the month or `5#2` for the second Friday of the month, thus the fields are not validated.
+var validateCronExpressionFunc = func(v interface{}, k string) (s []string, errors []error) {
+	value := v.(string)
+	if strings.HasPrefix(value, "@") {
+		return
+	}
+
+	// NOTE: Fugit optionally accepts a leading field for the seconds.
+	if fields := strings.Fields(value); len(fields) != 5 && len(fields) != 6 {
+		errors = append(errors, fmt.Errorf("%s is not a valid cron expression: expected 5 fields, or 6 with seconds, got %d", value, len(fields)))
+	}
+
+	return
+}
+
 func stringToVisibilityLevel(s string) *gitlab.VisibilityValue {
 	lookup := map[string]gitlab.VisibilityValue{
 		"private":  gitlab.PrivateVisibility,
@@ -283,65 +354,32 @@ func fromIntegerMap(value interface{}) map[string]int {
 	return integerMap
 }
 
-// isGitLabVersionLessThan is a SkipFunc that returns true if the provided version is lower then
-// the current version of GitLab. It only checks the major and minor version numbers, not the patch.
-func isGitLabVersionLessThan(ctx context.Context, client *gitlab.Client, version string) func() (bool, error) {
-	return func() (bool, error) {
-		isAtLeast, err := isGitLabVersionAtLeast(ctx, client, version)()
-		return !isAtLeast, err
+func is404(err error) bool {
+	// NOTE: the client returns `gitlab.ErrNotFound` instead of an `ErrorResponse` for 404 responses.
+	if errors.Is(err, gitlab.ErrNotFound) {
+		return true
 	}
-}
-
-// isGitLabVersionAtLeast is a SkipFunc that checks that the version of GitLab is at least the
-// provided wantVersion. It only checks the major and minor version numbers, not the patch.
-func isGitLabVersionAtLeast(ctx context.Context, client *gitlab.Client, wantVersion string) func() (bool, error) {
-	return func() (bool, error) {
-		wantMajor, wantMinor, err := parseVersionMajorMinor(wantVersion)
-		if err != nil {
-			return false, fmt.Errorf("failed to parse wanted version %q: %w", wantVersion, err)
-		}
-
-		actualVersion, _, err := client.Version.GetVersion(gitlab.WithContext(ctx))
-		if err != nil {
-			return false, err
-		}
-
-		actualMajor, actualMinor, err := parseVersionMajorMinor(actualVersion.Version)
-		if err != nil {
-			return false, fmt.Errorf("failed to parse actual version %q: %w", actualVersion.Version, err)
-		}
-
-		if actualMajor == wantMajor {
-			return actualMinor >= wantMinor, nil
-		}
-
-		return actualMajor > wantMajor, nil
+	if errResponse, ok := err.(*gitlab.ErrorResponse); ok &&
+		errResponse.Response != nil &&
+		errResponse.Response.StatusCode == 404 {
+		return true
 	}
+	return false
 }
 
-func parseVersionMajorMinor(version string) (int, int, error) {
-	parts := strings.SplitN(version, ".", 3)
-	if len(parts) < 2 {
-		return 0, 0, fmt.Errorf("need at least 2 parts (was %d)", len(parts))
-	}
-
-	major, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return 0, 0, err
-	}
-
-	minor, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return 0, 0, err
+func is401(err error) bool {
+	if errResponse, ok := err.(*gitlab.ErrorResponse); ok &&
+		errResponse.Response != nil &&
+		errResponse.Response.StatusCode == 401 {
+		return true
 	}
-
-	return major, minor, nil
+	return false
 }
 
-func is404(err error) bool {
+func is403(err error) bool {
 	if errResponse, ok := err.(*gitlab.ErrorResponse); ok &&
 		errResponse.Response != nil &&
-		errResponse.Response.StatusCode == 404 {
+		errResponse.Response.StatusCode == 403 {
 		return true
 	}
 	return false
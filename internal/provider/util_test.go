@@ -1,6 +1,10 @@
 package provider
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	gitlab "github.com/xanzy/go-gitlab"
@@ -119,3 +123,199 @@ func TestValidateURLFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateEmailFunc(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "invalid_email",
+			ErrCount: 1,
+		},
+		{
+			Value:    "invalid@",
+			ErrCount: 1,
+		},
+		{
+			Value:    "User <user@example.com>",
+			ErrCount: 1,
+		},
+		{
+			Value:    "user@example.com",
+			ErrCount: 0,
+		},
+		{
+			Value:    "first.last+tag@mail.example.com",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateEmailFunc(tc.Value, "test_arg")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidateCronExpressionFunc(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "0 23 * * 5",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*/15 8-18 1,15 jan-jun MON-FRI",
+			ErrCount: 0,
+		},
+		{
+			Value:    "@weekly",
+			ErrCount: 0,
+		},
+		{
+			Value:    "@daily",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0 7 L * *",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0 7 * * 5#2",
+			ErrCount: 0,
+		},
+		{
+			Value:    "30 0 7 * * 1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0 7 * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateCronExpressionFunc(tc.Value, "test_arg")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestGitlab_normalizeMembershipExpiresAt(t *testing.T) {
+	cases := []struct {
+		Value    string
+		Expected string
+		Valid    bool
+	}{
+		{
+			Value:    "",
+			Expected: "",
+			Valid:    true,
+		},
+		{
+			Value:    "2025-12-31",
+			Expected: "2025-12-31",
+			Valid:    true,
+		},
+		{
+			Value:    "2025-12-31T00:00:00Z",
+			Expected: "2025-12-31",
+			Valid:    true,
+		},
+		{
+			Value:    "2025-12-31T10:00:00+02:00",
+			Expected: "2025-12-31",
+			Valid:    true,
+		},
+		{
+			Value: "31.12.2025",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		normalized, err := normalizeMembershipExpiresAt(tc.Value)
+		if tc.Valid != (err == nil) {
+			t.Fatalf("got error %v for value %q, expected valid %t", err, tc.Value, tc.Valid)
+		}
+		if normalized != tc.Expected {
+			t.Fatalf("got %q expected %q for value %q", normalized, tc.Expected, tc.Value)
+		}
+	}
+}
+
+func TestGitlab_suppressEquivalentMembershipExpiresAt(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "2025-12-31",
+			New:      "2025-12-31T00:00:00Z",
+			Suppress: true,
+		},
+		{
+			Old:      "",
+			New:      "",
+			Suppress: true,
+		},
+		{
+			Old:      "2025-12-31",
+			New:      "2026-01-01T00:00:00Z",
+			Suppress: false,
+		},
+		{
+			Old:      "",
+			New:      "2025-12-31T00:00:00Z",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if suppress := suppressEquivalentMembershipExpiresAt("expires_at", tc.Old, tc.New, nil); suppress != tc.Suppress {
+			t.Fatalf("got %t expected %t for %q and %q", suppress, tc.Suppress, tc.Old, tc.New)
+		}
+	}
+}
+
+func TestGitlab_is404(t *testing.T) {
+	client := newTestGitlabClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "404 Project Not Found"}`))
+	}))
+
+	_, _, err := client.Projects.GetProject(42, nil)
+	if !is404(err) {
+		t.Fatalf("expected a 404 error, got %v", err)
+	}
+	if is404(fmt.Errorf("failed: %w", errors.New("500 Internal Server Error"))) {
+		t.Fatal("expected no 404 error")
+	}
+}
+
+// newTestGitlabClient returns a GitLab client for unit tests which sends its requests to the given handler.
+func newTestGitlabClient(t *testing.T, handler http.Handler) *gitlab.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient("glpat-test", gitlab.WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client
+}
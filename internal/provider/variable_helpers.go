@@ -1,9 +1,12 @@
 package provider
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -11,12 +14,61 @@ import (
 	"github.com/xanzy/go-gitlab"
 )
 
+const invalidMaskedVariableValueMessage = "Invalid value for a masked variable. Check the masked variable requirements: https://docs.gitlab.com/ee/ci/variables/#masked-variable-requirements"
+
+// maskedVariableValueRegexp and maskedRawVariableValueRegexp mirror the regular expressions GitLab uses
+// to decide if a value can be masked. Raw variables aren't expanded, thus any characters but whitespace are allowed.
+// see https://gitlab.com/gitlab-org/gitlab/-/blob/master/app/models/concerns/ci/maskable.rb
+var (
+	maskedVariableValueRegexp    = regexp.MustCompile(`^[a-zA-Z0-9_+=/@:.~-]{8,}$`)
+	maskedRawVariableValueRegexp = regexp.MustCompile(`^\S{8,}$`)
+)
+
+// isValidMaskedVariableValue returns true if the value meets the masked variable requirements of GitLab.
+func isValidMaskedVariableValue(value string, raw bool) bool {
+	if raw {
+		return maskedRawVariableValueRegexp.MatchString(value)
+	}
+	return maskedVariableValueRegexp.MatchString(value)
+}
+
+// validateMaskedVariableValue is a `CustomizeDiff` function which validates the value of a masked variable
+// during plan instead of waiting for the GitLab API to reject it during apply.
+func validateMaskedVariableValue(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.Get("masked").(bool) || !d.NewValueKnown("value") {
+		return nil
+	}
+
+	if !isValidMaskedVariableValue(d.Get("value").(string), d.Get("raw").(bool)) {
+		return errors.New(invalidMaskedVariableValueMessage)
+	}
+	return nil
+}
+
+// setDefaultVariableMasked is a `CustomizeDiff` function which applies the `default_variable_masked` provider attribute
+// to variables which do not set the `masked` attribute explicitly.
+func setDefaultVariableMasked(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("masked").IsNull() {
+		return nil
+	}
+
+	return d.SetNew("masked", meta.(*ProviderMeta).Settings.DefaultVariableMasked)
+}
+
 func augmentVariableClientError(d *schema.ResourceData, err error) diag.Diagnostics {
 	// Masked values will commonly error due to their strict requirements, and the error message from the GitLab API is not very informative,
 	// so we return a custom error message in this case.
 	if d.Get("masked").(bool) && isInvalidValueError(err) {
 		log.Printf("[ERROR] %v", err)
-		return diag.Errorf("Invalid value for a masked variable. Check the masked variable requirements: https://docs.gitlab.com/ee/ci/variables/#masked-variable-requirements")
+		return diag.Errorf(invalidMaskedVariableValueMessage)
+	}
+
+	// Creating a variable with a key (and environment scope) that already exists is most likely caused by
+	// a variable that is not yet managed by Terraform, so we point the user to the import instead.
+	if isDuplicateKeyError(err) {
+		log.Printf("[ERROR] %v", err)
+		return diag.Errorf("A variable with the key %q already exists. Import it with the ID %q to manage it with Terraform: %v", d.Get("key").(string), variableImportID(d), err)
 	}
 
 	if err != nil {
@@ -26,6 +78,31 @@ func augmentVariableClientError(d *schema.ResourceData, err error) diag.Diagnost
 	return nil
 }
 
+// variableImportID returns the ID which can be used to import the variable represented by the given resource data.
+// The ID format depends on the variable resource, see the import examples of the resources.
+func variableImportID(d *schema.ResourceData) string {
+	key := d.Get("key").(string)
+	environmentScope := "*"
+	if v, ok := d.GetOk("environment_scope"); ok {
+		environmentScope = v.(string)
+	}
+
+	if v, ok := d.GetOk("project"); ok {
+		return fmt.Sprintf("%s:%s:%s", v.(string), key, environmentScope)
+	}
+	if v, ok := d.GetOk("group"); ok {
+		return fmt.Sprintf("%s:%s:%s", v.(string), key, environmentScope)
+	}
+	return key
+}
+
+func isDuplicateKeyError(err error) bool {
+	var httpErr *gitlab.ErrorResponse
+	return errors.As(err, &httpErr) &&
+		httpErr.Response.StatusCode == http.StatusBadRequest &&
+		strings.Contains(httpErr.Message, "has already been taken")
+}
+
 func isInvalidValueError(err error) bool {
 	var httpErr *gitlab.ErrorResponse
 	return errors.As(err, &httpErr) &&
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/xanzy/go-gitlab"
+)
+
+func TestGitlab_isValidMaskedVariableValue(t *testing.T) {
+	cases := []struct {
+		Value string
+		Raw   bool
+		Valid bool
+	}{
+		{
+			Value: "1234567",
+			Valid: false,
+		},
+		{
+			Value: "12345678",
+			Valid: true,
+		},
+		{
+			Value: "value with space",
+			Valid: false,
+		},
+		{
+			Value: "multi\nline-value",
+			Valid: false,
+		},
+		{
+			Value: "trailing-newline\n",
+			Valid: false,
+		},
+		{
+			Value: "dGVzdC12YWx1ZQ==",
+			Valid: true,
+		},
+		{
+			Value: "user@example.com:~/path_+-.",
+			Valid: true,
+		},
+		{
+			Value: "pa$$word-value",
+			Valid: false,
+		},
+		{
+			Value: "",
+			Valid: false,
+		},
+		{
+			Value: "pa$$word-value",
+			Raw:   true,
+			Valid: true,
+		},
+		{
+			Value: "value with space",
+			Raw:   true,
+			Valid: false,
+		},
+		{
+			Value: "1234567",
+			Raw:   true,
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if valid := isValidMaskedVariableValue(tc.Value, tc.Raw); valid != tc.Valid {
+			t.Fatalf("got %t expected %t for value %q (raw: %t)", valid, tc.Valid, tc.Value, tc.Raw)
+		}
+	}
+}
+
+func TestGitlab_augmentVariableClientError_duplicateKey(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Schema           map[string]*schema.Schema
+		Raw              map[string]interface{}
+		ExpectedImportID string
+	}{
+		{
+			Name:             "instance variable",
+			Schema:           gitlabInstanceVariableGetSchema(),
+			Raw:              map[string]interface{}{"key": "my_key", "value": "my-value"},
+			ExpectedImportID: "my_key",
+		},
+		{
+			Name:             "project variable",
+			Schema:           gitlabProjectVariableGetSchema(),
+			Raw:              map[string]interface{}{"project": "42", "key": "my_key", "value": "my-value", "environment_scope": "production"},
+			ExpectedImportID: "42:my_key:production",
+		},
+		{
+			Name:             "group variable",
+			Schema:           gitlabGroupVariableGetSchema(),
+			Raw:              map[string]interface{}{"group": "my-group", "key": "my_key", "value": "my-value"},
+			ExpectedImportID: "my-group:my_key:*",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tc.Schema, tc.Raw)
+			err := testVariableErrorResponse(http.StatusBadRequest, "{message: {key: [(my_key) has already been taken]}}")
+
+			diags := augmentVariableClientError(d, err)
+			if len(diags) != 1 {
+				t.Fatalf("expected exactly one diagnostic, got %d", len(diags))
+			}
+			if !strings.Contains(diags[0].Summary, `A variable with the key "my_key" already exists`) {
+				t.Fatalf("expected duplicate key diagnostic, got %q", diags[0].Summary)
+			}
+			if !strings.Contains(diags[0].Summary, `"`+tc.ExpectedImportID+`"`) {
+				t.Fatalf("expected import ID %q in diagnostic, got %q", tc.ExpectedImportID, diags[0].Summary)
+			}
+		})
+	}
+}
+
+func TestGitlab_augmentVariableClientError_otherErrors(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, gitlabInstanceVariableGetSchema(), map[string]interface{}{"key": "my_key", "value": "my-value"})
+
+	diags := augmentVariableClientError(d, testVariableErrorResponse(http.StatusBadRequest, "{message: {key: [is invalid]}}"))
+	if len(diags) != 1 || strings.Contains(diags[0].Summary, "already exists") {
+		t.Fatalf("expected the original error, got %v", diags)
+	}
+
+	if diags := augmentVariableClientError(d, nil); diags != nil {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestGitlab_setDefaultVariableMasked(t *testing.T) {
+	cases := []struct {
+		Name                  string
+		DefaultVariableMasked bool
+		Masked                cty.Value
+		ExpectedMasked        string
+	}{
+		{
+			Name:                  "no provider default and not set",
+			DefaultVariableMasked: false,
+			Masked:                cty.NullVal(cty.Bool),
+			ExpectedMasked:        "false",
+		},
+		{
+			Name:                  "provider default and not set",
+			DefaultVariableMasked: true,
+			Masked:                cty.NullVal(cty.Bool),
+			ExpectedMasked:        "true",
+		},
+		{
+			Name:                  "provider default and explicitly disabled",
+			DefaultVariableMasked: true,
+			Masked:                cty.False,
+			ExpectedMasked:        "false",
+		},
+		{
+			Name:                  "no provider default and explicitly enabled",
+			DefaultVariableMasked: false,
+			Masked:                cty.True,
+			ExpectedMasked:        "true",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			meta := &ProviderMeta{Settings: ProviderSettings{DefaultVariableMasked: tc.DefaultVariableMasked}}
+
+			r := allResources["gitlab_project_variable"]()
+			coreSchema := r.CoreConfigSchema()
+			attributes := map[string]cty.Value{}
+			for name, attribute := range coreSchema.Attributes {
+				attributes[name] = cty.NullVal(attribute.Type)
+			}
+			attributes["project"] = cty.StringVal("42")
+			attributes["key"] = cty.StringVal("my_key")
+			attributes["value"] = cty.StringVal("my-value")
+			attributes["masked"] = tc.Masked
+			rawConfig := cty.ObjectVal(attributes)
+
+			diff, err := r.SimpleDiff(context.Background(), &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigShimmed(rawConfig, coreSchema), meta)
+			if err != nil {
+				t.Fatalf("failed to diff: %v", err)
+			}
+
+			masked, ok := diff.Attributes["masked"]
+			if !ok {
+				t.Fatal("expected a diff for the masked attribute")
+			}
+			if masked.New != tc.ExpectedMasked {
+				t.Fatalf("got masked %q, expected %q", masked.New, tc.ExpectedMasked)
+			}
+		})
+	}
+}
+
+func testVariableErrorResponse(statusCode int, message string) error {
+	return &gitlab.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: statusCode,
+			Request: &http.Request{
+				Method: http.MethodPost,
+				URL:    &url.URL{Scheme: "https", Host: "gitlab.example.com", Path: "/api/v4/admin/ci/variables"},
+			},
+		},
+		Message: message,
+	}
+}
+
+func TestGitlab_validateMaskedVariableValue_raw(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Raw           cty.Value
+		ExpectedError bool
+	}{
+		{
+			Name:          "not raw",
+			Raw:           cty.False,
+			ExpectedError: true,
+		},
+		{
+			Name:          "raw",
+			Raw:           cty.True,
+			ExpectedError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			r := allResources["gitlab_instance_variable"]()
+			coreSchema := r.CoreConfigSchema()
+			attributes := map[string]cty.Value{}
+			for name, attribute := range coreSchema.Attributes {
+				attributes[name] = cty.NullVal(attribute.Type)
+			}
+			attributes["key"] = cty.StringVal("my_key")
+			attributes["value"] = cty.StringVal("pa$$word-value")
+			attributes["masked"] = cty.True
+			attributes["raw"] = tc.Raw
+			rawConfig := cty.ObjectVal(attributes)
+
+			_, err := r.SimpleDiff(context.Background(), &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigShimmed(rawConfig, coreSchema), &ProviderMeta{})
+			if tc.ExpectedError && err == nil {
+				t.Fatal("expected an error for the masked value")
+			}
+			if !tc.ExpectedError && err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/xanzy/go-gitlab"
+)
+
+// getGitLabVersion requests the version of the GitLab instance of the given client, e.g. `15.4.0-ee`.
+func getGitLabVersion(ctx context.Context, client *gitlab.Client) (string, error) {
+	version, _, err := client.Version.GetVersion(gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("[DEBUG] detected GitLab version %s (revision %s)", version.Version, version.Revision)
+	return version.Version, nil
+}
+
+// gitLabVersion returns the version of the GitLab instance of the provider.
+// The version is only requested once per provider, usually already when the provider is configured.
+func (m *ProviderMeta) gitLabVersion(ctx context.Context) (string, error) {
+	m.versionMu.Lock()
+	defer m.versionMu.Unlock()
+
+	if m.version == "" {
+		version, err := getGitLabVersion(ctx, m.Client)
+		if err != nil {
+			return "", err
+		}
+		m.version = version
+	}
+	return m.version, nil
+}
+
+// supportsFeature reports whether the GitLab instance of the provider is at least minVersion,
+// e.g. to decide whether to send an attribute which older versions don't support.
+// It only checks the major and minor version numbers, not the patch.
+func (m *ProviderMeta) supportsFeature(ctx context.Context, minVersion string) (bool, error) {
+	version, err := m.gitLabVersion(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to detect the GitLab version: %w", err)
+	}
+
+	return isVersionAtLeast(version, minVersion)
+}
+
+// isGitLabVersionLessThan is a SkipFunc that returns true if the provided version is lower then
+// the current version of GitLab. It only checks the major and minor version numbers, not the patch.
+func isGitLabVersionLessThan(ctx context.Context, client *gitlab.Client, version string) func() (bool, error) {
+	return func() (bool, error) {
+		isAtLeast, err := isGitLabVersionAtLeast(ctx, client, version)()
+		return !isAtLeast, err
+	}
+}
+
+// isGitLabVersionAtLeast is a SkipFunc that checks that the version of GitLab is at least the
+// provided wantVersion. It only checks the major and minor version numbers, not the patch.
+func isGitLabVersionAtLeast(ctx context.Context, client *gitlab.Client, wantVersion string) func() (bool, error) {
+	return func() (bool, error) {
+		actualVersion, err := getGitLabVersion(ctx, client)
+		if err != nil {
+			return false, err
+		}
+
+		return isVersionAtLeast(actualVersion, wantVersion)
+	}
+}
+
+// isVersionAtLeast checks that the actual version is at least the wanted version.
+// It only compares the major and minor version numbers, so a release candidate like
+// `16.0.0-rc42-ee` is considered to be version 16.0.
+func isVersionAtLeast(actualVersion, wantVersion string) (bool, error) {
+	wantMajor, wantMinor, err := parseVersionMajorMinor(wantVersion)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse wanted version %q: %w", wantVersion, err)
+	}
+
+	actualMajor, actualMinor, err := parseVersionMajorMinor(actualVersion)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse actual version %q: %w", actualVersion, err)
+	}
+
+	if actualMajor == wantMajor {
+		return actualMinor >= wantMinor, nil
+	}
+
+	return actualMajor > wantMajor, nil
+}
+
+// parseVersionMajorMinor parses the major and minor version numbers of a GitLab version,
+// ignoring suffixes like `-ee`, `-ce`, `-pre` or `-rc1`.
+func parseVersionMajorMinor(version string) (int, int, error) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("need at least 2 parts (was %d)", len(parts))
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// The minor version may carry a suffix if the version has no patch number, e.g. `17.0-pre`.
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return major, minor, nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGitlab_isVersionAtLeast(t *testing.T) {
+	cases := []struct {
+		ActualVersion string
+		WantVersion   string
+		IsAtLeast     bool
+	}{
+		{ActualVersion: "15.4.0", WantVersion: "15.4", IsAtLeast: true},
+		{ActualVersion: "15.4.0-ee", WantVersion: "15.4", IsAtLeast: true},
+		{ActualVersion: "15.4.2-ce", WantVersion: "15.5", IsAtLeast: false},
+		{ActualVersion: "16.0.0-rc42-ee", WantVersion: "16.0", IsAtLeast: true},
+		{ActualVersion: "16.0.0-rc42-ee", WantVersion: "16.1", IsAtLeast: false},
+		{ActualVersion: "17.0-pre", WantVersion: "16.11", IsAtLeast: true},
+		{ActualVersion: "16.11.1-ee", WantVersion: "16.2", IsAtLeast: true},
+		{ActualVersion: "14.10.0-ee", WantVersion: "15.0", IsAtLeast: false},
+	}
+
+	for _, tc := range cases {
+		isAtLeast, err := isVersionAtLeast(tc.ActualVersion, tc.WantVersion)
+		if err != nil {
+			t.Fatalf("unexpected error for %q and %q: %v", tc.ActualVersion, tc.WantVersion, err)
+		}
+		if isAtLeast != tc.IsAtLeast {
+			t.Fatalf("got %t for %q at least %q, expected %t", isAtLeast, tc.ActualVersion, tc.WantVersion, tc.IsAtLeast)
+		}
+	}
+}
+
+func TestGitlab_isVersionAtLeast_invalidVersion(t *testing.T) {
+	for _, version := range []string{"", "16", "sixteen.one", "16.x"} {
+		if _, err := isVersionAtLeast(version, "16.0"); err == nil {
+			t.Fatalf("expected an error for version %q", version)
+		}
+	}
+}
+
+func TestGitlab_supportsFeature_cachesVersion(t *testing.T) {
+	requests := 0
+	client := newTestGitlabClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version": "15.4.0-ee", "revision": "abcdef"}`))
+	}))
+
+	meta := &ProviderMeta{Client: client}
+	for minVersion, want := range map[string]bool{"15.0": true, "15.4": true, "15.5": false, "16.0": false} {
+		supported, err := meta.supportsFeature(context.Background(), minVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if supported != want {
+			t.Fatalf("got %t for minimum version %q, expected %t", supported, minVersion, want)
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("got %d version requests, expected 1", requests)
+	}
+
+	// The version is cached per provider, another provider requests it again.
+	if _, err := (&ProviderMeta{Client: client}).supportsFeature(context.Background(), "15.0"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("got %d version requests, expected 2", requests)
+	}
+}